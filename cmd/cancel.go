@@ -15,13 +15,16 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/pulumi/pulumi/pkg/util/result"
 
 	"github.com/spf13/cobra"
 
+	"github.com/pulumi/pulumi/pkg/backend"
 	"github.com/pulumi/pulumi/pkg/backend/display"
+	"github.com/pulumi/pulumi/pkg/backend/filestate"
 	"github.com/pulumi/pulumi/pkg/backend/httpstate"
 	"github.com/pulumi/pulumi/pkg/diag/colors"
 	"github.com/pulumi/pulumi/pkg/util/cmdutil"
@@ -37,6 +40,8 @@ func newCancelCmd() *cobra.Command {
 		Long: "Cancel a stack's currently running update, if any.\n" +
 			"\n" +
 			"This command cancels the update currently being applied to a stack if any exists.\n" +
+			"For self-managed backends, this breaks the stack's lock, even if the process that\n" +
+			"holds it is still running.\n" +
 			"Note that this operation is _very dangerous_, and may leave the stack in an\n" +
 			"inconsistent state if a resource operation was pending when the update was canceled.\n" +
 			"\n" +
@@ -61,10 +66,15 @@ func newCancelCmd() *cobra.Command {
 				return result.FromError(err)
 			}
 
-			// Ensure that we are targeting the Pulumi cloud.
-			backend, ok := s.Backend().(httpstate.Backend)
-			if !ok {
-				return result.Error("the `cancel` command is not supported for local stacks")
+			// The Pulumi service cancels the running update; self-managed backends break the stack's locks instead.
+			var cancel func(ctx context.Context, stackRef backend.StackReference) error
+			switch b := s.Backend().(type) {
+			case httpstate.Backend:
+				cancel = b.CancelCurrentUpdate
+			case filestate.Backend:
+				cancel = b.CancelCurrentUpdate
+			default:
+				return result.Error("the `cancel` command is not supported for this backend")
 			}
 
 			// Ensure the user really wants to do this.
@@ -76,7 +86,7 @@ func newCancelCmd() *cobra.Command {
 			}
 
 			// Cancel the update.
-			if err := backend.CancelCurrentUpdate(commandContext(), s.Ref()); err != nil {
+			if err := cancel(commandContext(), s.Ref()); err != nil {
 				return result.FromError(err)
 			}
 
@@ -53,6 +53,10 @@ func getStackSecretsManager(s backend.Stack) (secrets.Manager, error) {
 	}
 
 	sm, err := func() (secrets.Manager, error) {
+		if isAgeSecretsProvider(ps.SecretsProvider) {
+			return newAgeSecretsManager(s.Ref().Name(), stackConfigFile, ps.SecretsProvider)
+		}
+
 		if ps.SecretsProvider != passphrase.Type && ps.SecretsProvider != "default" && ps.SecretsProvider != "" {
 			return newCloudSecretsManager(s.Ref().Name(), stackConfigFile, ps.SecretsProvider)
 		}
@@ -78,7 +82,7 @@ func getStackSecretsManager(s backend.Stack) (secrets.Manager, error) {
 
 func validateSecretsProvider(typ string) error {
 	kind := strings.SplitN(typ, ":", 2)[0]
-	supportedKinds := []string{"default", "passphrase", "awskms", "azurekeyvault", "gcpkms", "hashivault"}
+	supportedKinds := []string{"default", "passphrase", "age", "awskms", "azurekeyvault", "gcpkms", "hashivault"}
 	for _, supportedKind := range supportedKinds {
 		if kind == supportedKind {
 			return nil
@@ -0,0 +1,92 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/secrets"
+	"github.com/pulumi/pulumi/pkg/secrets/age"
+	"github.com/pulumi/pulumi/pkg/tokens"
+	"github.com/pulumi/pulumi/pkg/util/contract"
+	"github.com/pulumi/pulumi/pkg/workspace"
+)
+
+// isAgeSecretsProvider returns true if the given secrets provider names the age secrets provider.
+func isAgeSecretsProvider(secretsProvider string) bool {
+	return secretsProvider == age.Type || strings.HasPrefix(secretsProvider, age.Type+"://")
+}
+
+func newAgeSecretsManager(stackName tokens.QName, configFile, secretsProvider string) (secrets.Manager, error) {
+	contract.Assertf(stackName != "", "stackName %s", "!= \"\"")
+
+	if configFile == "" {
+		f, err := workspace.DetectProjectStackPath(stackName)
+		if err != nil {
+			return nil, err
+		}
+		configFile = f
+	}
+
+	info, err := workspace.LoadProjectStack(configFile)
+	if err != nil {
+		return nil, err
+	}
+
+	sm, err := createAgeSecretsManager(info, secretsProvider)
+	if err != nil {
+		return nil, err
+	}
+	if err = info.Save(configFile); err != nil {
+		return nil, err
+	}
+	return sm, nil
+}
+
+// createAgeSecretsManager creates a secrets manager for the given age secrets provider, generating a new data key
+// encrypted for the provider's recipients if the given stack settings do not already have one. If the settings have
+// a data key that is not encrypted for exactly the provider's recipients (for example, because a recipient was added
+// to or removed from the provider URL), the data key is re-encrypted for them. The provider and key are recorded in
+// the settings, which are not saved.
+func createAgeSecretsManager(info *workspace.ProjectStack, secretsProvider string) (secrets.Manager, error) {
+	if info.EncryptedKey == "" {
+		dataKey, err := age.GenerateNewDataKey(secretsProvider)
+		if err != nil {
+			return nil, err
+		}
+		info.EncryptedKey = base64.StdEncoding.EncodeToString(dataKey)
+	}
+	info.SecretsProvider = secretsProvider
+
+	dataKey, err := base64.StdEncoding.DecodeString(info.EncryptedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	changed, err := age.RecipientsChanged(secretsProvider, dataKey)
+	if err != nil {
+		return nil, err
+	}
+	if changed {
+		if dataKey, err = age.RewrapDataKey(secretsProvider, dataKey); err != nil {
+			return nil, errors.Wrap(err, "re-encrypting the data key for the secrets provider's recipients")
+		}
+		info.EncryptedKey = base64.StdEncoding.EncodeToString(dataKey)
+	}
+	return age.NewAgeSecretsManager(secretsProvider, dataKey)
+}
@@ -0,0 +1,89 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/secrets/age"
+	"github.com/pulumi/pulumi/pkg/workspace"
+)
+
+func TestCreateAgeSecretsManagerRecipientChanges(t *testing.T) {
+	dir, err := ioutil.TempDir("", "age")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	alice, err := age.GenerateIdentity()
+	assert.NoError(t, err)
+	bob, err := age.GenerateIdentity()
+	assert.NoError(t, err)
+
+	identityFile := filepath.Join(dir, "keys.txt")
+	defer os.Unsetenv(age.IdentityFileEnvVar)
+	assert.NoError(t, os.Setenv(age.IdentityFileEnvVar, identityFile))
+	useIdentity := func(i age.Identity) {
+		assert.NoError(t, ioutil.WriteFile(identityFile, []byte(i.String()+"\n"), 0600))
+	}
+
+	aliceURL := "age://?recipient=" + alice.Recipient().String()
+	bothURL := aliceURL + "&recipient=" + bob.Recipient().String()
+
+	// Create a stack encrypted for alice and encrypt a secret with it.
+	useIdentity(alice)
+	info := &workspace.ProjectStack{}
+	sm, err := createAgeSecretsManager(info, aliceURL)
+	assert.NoError(t, err)
+	enc, err := sm.Encrypter()
+	assert.NoError(t, err)
+	ciphertext, err := enc.EncryptValue("hunter2")
+	assert.NoError(t, err)
+	originalKey := info.EncryptedKey
+
+	// Reopening the stack with the same recipients leaves its data key alone.
+	_, err = createAgeSecretsManager(info, aliceURL)
+	assert.NoError(t, err)
+	assert.Equal(t, originalKey, info.EncryptedKey)
+
+	// Adding bob to the provider URL re-encrypts the data key for him, so he can decrypt the existing secret.
+	_, err = createAgeSecretsManager(info, bothURL)
+	assert.NoError(t, err)
+	assert.NotEqual(t, originalKey, info.EncryptedKey)
+	assert.Equal(t, bothURL, info.SecretsProvider)
+	for _, i := range []age.Identity{alice, bob} {
+		useIdentity(i)
+		sm, err = createAgeSecretsManager(info, bothURL)
+		assert.NoError(t, err)
+		dec, err := sm.Decrypter()
+		assert.NoError(t, err)
+		plaintext, err := dec.DecryptValue(ciphertext)
+		assert.NoError(t, err)
+		assert.Equal(t, "hunter2", plaintext)
+	}
+
+	// Removing alice re-encrypts the data key for bob alone.
+	bobURL := "age://?recipient=" + bob.Recipient().String()
+	useIdentity(bob)
+	_, err = createAgeSecretsManager(info, bobURL)
+	assert.NoError(t, err)
+	useIdentity(alice)
+	_, err = createAgeSecretsManager(info, bobURL)
+	assert.Error(t, err)
+}
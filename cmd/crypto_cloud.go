@@ -40,7 +40,20 @@ func newCloudSecretsManager(stackName tokens.QName, configFile, secretsProvider
 		return nil, err
 	}
 
-	var secretsManager *cloud.Manager
+	sm, err := createCloudSecretsManager(info, secretsProvider)
+	if err != nil {
+		return nil, err
+	}
+	if err = info.Save(configFile); err != nil {
+		return nil, err
+	}
+	return sm, nil
+}
+
+// createCloudSecretsManager creates a secrets manager for the given cloud secrets provider, generating a new data key
+// if the given stack settings do not already have one. The provider and key are recorded in the settings, which are
+// not saved.
+func createCloudSecretsManager(info *workspace.ProjectStack, secretsProvider string) (secrets.Manager, error) {
 	if info.EncryptedKey == "" {
 		dataKey, err := cloud.GenerateNewDataKey(secretsProvider)
 		if err != nil {
@@ -49,18 +62,10 @@ func newCloudSecretsManager(stackName tokens.QName, configFile, secretsProvider
 		info.EncryptedKey = base64.StdEncoding.EncodeToString(dataKey)
 	}
 	info.SecretsProvider = secretsProvider
-	if err = info.Save(configFile); err != nil {
-		return nil, err
-	}
 
 	dataKey, err := base64.StdEncoding.DecodeString(info.EncryptedKey)
 	if err != nil {
 		return nil, err
 	}
-	secretsManager, err = cloud.NewCloudSecretsManager(secretsProvider, dataKey)
-	if err != nil {
-		return nil, err
-	}
-
-	return secretsManager, nil
+	return cloud.NewCloudSecretsManager(secretsProvider, dataKey)
 }
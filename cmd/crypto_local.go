@@ -17,10 +17,11 @@ package cmd
 import (
 	cryptorand "crypto/rand"
 	"encoding/base64"
-	"errors"
 	"fmt"
 	"os"
 
+	"github.com/pkg/errors"
+
 	"github.com/pulumi/pulumi/pkg/diag"
 	"github.com/pulumi/pulumi/pkg/resource/config"
 	"github.com/pulumi/pulumi/pkg/secrets"
@@ -31,12 +32,25 @@ import (
 	"github.com/pulumi/pulumi/pkg/workspace"
 )
 
+const (
+	// passphraseEnvVar is the environment variable that holds the passphrase for a stack's secrets.
+	passphraseEnvVar = "PULUMI_CONFIG_PASSPHRASE"
+	// newPassphraseEnvVar is the environment variable that holds the new passphrase when changing it.
+	newPassphraseEnvVar = "PULUMI_NEW_CONFIG_PASSPHRASE"
+)
+
 func readPassphrase(prompt string) (string, error) {
-	if phrase, ok := os.LookupEnv("PULUMI_CONFIG_PASSPHRASE"); ok {
+	return readPassphraseFromEnv(passphraseEnvVar, prompt)
+}
+
+// readPassphraseFromEnv reads a passphrase from the given environment variable, or prompts the user for it if the
+// variable is not set.
+func readPassphraseFromEnv(envVar, prompt string) (string, error) {
+	if phrase, ok := os.LookupEnv(envVar); ok {
 		return phrase, nil
 	}
 	if !cmdutil.Interactive() {
-		return "", errors.New("passphrase must be set with PULUMI_CONFIG_PASSPHRASE environment variable")
+		return "", errors.Errorf("passphrase must be set with %s environment variable", envVar)
 	}
 	return cmdutil.ReadConsoleNoEcho(prompt)
 }
@@ -79,44 +93,59 @@ func newPassphraseSecretsManager(stackName tokens.QName, configFile string) (sec
 		}
 	}
 
-	var phrase string
+	phrase, err := readNewPassphrase(passphraseEnvVar, "Enter your passphrase to protect config/secrets")
+	if err != nil {
+		return nil, err
+	}
 
-	// Get a the passphrase from the user, ensuring that they match.
+	sm, err := createPassphraseSecretsManager(info, phrase)
+	if err != nil {
+		return nil, err
+	}
+	if err = info.Save(configFile); err != nil {
+		return nil, err
+	}
+	return sm, nil
+}
+
+// readNewPassphrase reads a new passphrase from the given environment variable or from the user. When prompting, the
+// user is asked to enter the passphrase twice to make sure that it is what they intended.
+func readNewPassphrase(envVar, prompt string) (string, error) {
+	if phrase, ok := os.LookupEnv(envVar); ok {
+		return phrase, nil
+	}
 	for {
-		// Here, the stack does not have an EncryptionSalt, so we will get a passphrase and create one
-		first, err := readPassphrase("Enter your passphrase to protect config/secrets")
+		first, err := readPassphraseFromEnv(envVar, prompt)
 		if err != nil {
-			return nil, err
+			return "", err
 		}
-		second, err := readPassphrase("Re-enter your passphrase to confirm")
+		second, err := readPassphraseFromEnv(envVar, "Re-enter your passphrase to confirm")
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 
 		if first == second {
-			phrase = first
-			break
+			return first, nil
 		}
 		// If they didn't match, print an error and try again
 		cmdutil.Diag().Errorf(diag.Message("", "passphrases do not match"))
 	}
+}
 
+// createPassphraseSecretsManager creates a secrets manager for the given passphrase using a freshly generated salt,
+// which is recorded in the given stack settings. The settings are not saved.
+func createPassphraseSecretsManager(info *workspace.ProjectStack, phrase string) (secrets.Manager, error) {
 	// Produce a new salt.
 	salt := make([]byte, 8)
-	_, err = cryptorand.Read(salt)
+	_, err := cryptorand.Read(salt)
 	contract.Assertf(err == nil, "could not read from system random")
 
 	// Encrypt a message and store it with the salt so we can test if the password is correct later.
 	crypter := config.NewSymmetricCrypterFromPassphrase(phrase, salt)
 	msg, err := crypter.EncryptValue("pulumi")
 	contract.AssertNoError(err)
-
-	// Now store the result and save it.
 	info.EncryptionSalt = fmt.Sprintf("v1:%s:%s", base64.StdEncoding.EncodeToString(salt), msg)
-	if err = info.Save(configFile); err != nil {
-		return nil, err
-	}
 
-	// Finally, build the full secrets manager from the state we just saved
+	// Finally, build the full secrets manager from the new salt.
 	return passphrase.NewPassphaseSecretsManager(phrase, info.EncryptionSalt)
 }
@@ -0,0 +1,185 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/backend"
+	"github.com/pulumi/pulumi/pkg/backend/display"
+	"github.com/pulumi/pulumi/pkg/engine"
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/util/cmdutil"
+	"github.com/pulumi/pulumi/pkg/util/result"
+)
+
+func newDriftCmd() *cobra.Command {
+	var debug bool
+	var stack string
+
+	// Flags for engine.UpdateOptions.
+	var diffDisplay bool
+	var eventLogPath string
+	var jsonDisplay bool
+	var parallel int
+	var parallelPerProvider int
+	var readTimeout time.Duration
+	var showSames bool
+	var suppressOutputs bool
+	var targets []string
+
+	var cmd = &cobra.Command{
+		Use:   "drift",
+		Short: "Detect drift between a stack's state and its resources' actual state",
+		Long: "Detect drift between a stack's state and its resources' actual state.\n" +
+			"\n" +
+			"This command reads the current state of each of the stack's resources from its provider, just\n" +
+			"like `pulumi refresh`, and reports any differences from the state recorded in the stack. Unlike\n" +
+			"`pulumi refresh`, the stack's state is never modified.\n" +
+			"\n" +
+			"The command exits with a non-zero exit code if any resource has drifted, which makes it suitable\n" +
+			"for scheduled checks. Use `--json` to print the differences in a machine-readable form.",
+		Args: cmdutil.NoArgs,
+		Run: cmdutil.RunResultFunc(func(cmd *cobra.Command, args []string) result.Result {
+			var displayType = display.DisplayProgress
+			if diffDisplay {
+				displayType = display.DisplayDiff
+			}
+
+			opts := backend.UpdateOptions{
+				PreviewOnly: true,
+				Display: display.Options{
+					Color:             cmdutil.GetGlobalColorization(),
+					ShowSameResources: showSames,
+					SuppressOutputs:   suppressOutputs,
+					IsInteractive:     cmdutil.Interactive(),
+					Type:              displayType,
+					JSONDisplay:       jsonDisplay,
+					EventLogPath:      eventLogPath,
+					Debug:             debug,
+				},
+			}
+
+			s, err := requireStack(stack, false, opts.Display, true /*setCurrent*/)
+			if err != nil {
+				return result.FromError(err)
+			}
+
+			proj, root, err := readProject()
+			if err != nil {
+				return result.FromError(err)
+			}
+
+			m, err := getUpdateMetadata("", root)
+			if err != nil {
+				return result.FromError(errors.Wrap(err, "gathering environment metadata"))
+			}
+
+			sm, err := getStackSecretsManager(s)
+			if err != nil {
+				return result.FromError(errors.Wrap(err, "getting secrets manager"))
+			}
+
+			cfg, err := getStackConfiguration(s, sm)
+			if err != nil {
+				return result.FromError(errors.Wrap(err, "getting stack configuration"))
+			}
+
+			targetUrns := []resource.URN{}
+			for _, t := range targets {
+				targetUrns = append(targetUrns, resource.URN(t))
+			}
+
+			opts.Engine = engine.UpdateOptions{
+				Parallel:        parallel,
+				RefreshParallel: parallelPerProvider,
+				RefreshTimeout:  readTimeout,
+				Debug:           debug,
+				UseLegacyDiff:   useLegacyDiff(),
+				RefreshTargets:  targetUrns,
+			}
+
+			changes, res := s.Refresh(commandContext(), backend.UpdateOperation{
+				Proj:               proj,
+				Root:               root,
+				M:                  m,
+				Opts:               opts,
+				StackConfiguration: cfg,
+				SecretsManager:     sm,
+				Scopes:             cancellationScopes,
+			})
+
+			switch {
+			case res != nil && res.Error() == context.Canceled:
+				return result.FromError(errors.New("drift detection cancelled"))
+			case res != nil:
+				return PrintEngineResult(res)
+			case changes.HasChanges():
+				return result.Errorf("drift detected: %d resource(s) changed and %d resource(s) deleted outside of Pulumi",
+					changes[deploy.OpUpdate], changes[deploy.OpDelete])
+			default:
+				return nil
+			}
+		}),
+	}
+
+	cmd.PersistentFlags().BoolVarP(
+		&debug, "debug", "d", false,
+		"Print detailed debugging output during resource operations")
+	cmd.PersistentFlags().StringVarP(
+		&stack, "stack", "s", "",
+		"The name of the stack to operate on. Defaults to the current stack")
+	cmd.PersistentFlags().StringVar(
+		&stackConfigFile, "config-file", "",
+		"Use the configuration values in the specified file rather than detecting the file name")
+	cmd.PersistentFlags().StringArrayVarP(
+		&targets, "target", "t", []string{},
+		"Specify a single resource URN to check. Multiple resources can be specified using: --target urn1 --target urn2")
+
+	// Flags for engine.UpdateOptions.
+	cmd.PersistentFlags().BoolVar(
+		&diffDisplay, "diff", false,
+		"Display operation as a rich diff showing the overall change")
+	cmd.Flags().BoolVarP(
+		&jsonDisplay, "json", "j", false,
+		"Serialize the drifted resources and their differences as JSON")
+	cmd.PersistentFlags().IntVarP(
+		&parallel, "parallel", "p", defaultParallel,
+		"Allow P resource operations to run in parallel at once (1 for no parallelism). Defaults to unbounded.")
+	cmd.PersistentFlags().IntVar(
+		&parallelPerProvider, "parallel-per-provider", 0,
+		"Allow at most P resources to be read from each provider at once. Defaults to unbounded.")
+	cmd.PersistentFlags().DurationVar(
+		&readTimeout, "read-timeout", 0,
+		"Cancel the read of any resource that takes longer than this duration (e.g. 5m). Defaults to no timeout.")
+	cmd.PersistentFlags().BoolVar(
+		&showSames, "show-sames", false,
+		"Show resources that haven't drifted, alongside those that have")
+	cmd.PersistentFlags().BoolVar(
+		&suppressOutputs, "suppress-outputs", false,
+		"Suppress display of stack outputs (in case they contain sensitive values)")
+
+	if hasDebugCommands() {
+		cmd.PersistentFlags().StringVar(
+			&eventLogPath, "event-log", "",
+			"Log events to a file at this path")
+	}
+	return cmd
+}
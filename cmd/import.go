@@ -0,0 +1,368 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/backend"
+	"github.com/pulumi/pulumi/pkg/backend/display"
+	"github.com/pulumi/pulumi/pkg/codegen/importer"
+	"github.com/pulumi/pulumi/pkg/engine"
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/tokens"
+	"github.com/pulumi/pulumi/pkg/util/cmdutil"
+	"github.com/pulumi/pulumi/pkg/util/result"
+)
+
+// importFile is the format of the file accepted by `pulumi import --file`.
+type importFile struct {
+	Resources []importSpec `json:"resources"`
+}
+
+// importSpec describes a single resource to import.
+type importSpec struct {
+	Type     string `json:"type"`
+	Name     string `json:"name"`
+	ID       string `json:"id"`
+	Parent   string `json:"parent,omitempty"`
+	Provider string `json:"provider,omitempty"`
+	Version  string `json:"version,omitempty"`
+	Protect  *bool  `json:"protect,omitempty"`
+}
+
+func (spec importSpec) toImport(protect bool) (deploy.Import, error) {
+	if spec.Type == "" || spec.Name == "" || spec.ID == "" {
+		return deploy.Import{}, errors.New("each resource must specify a type, name, and ID")
+	}
+	if !tokens.IsQName(spec.Name) {
+		return deploy.Import{}, errors.Errorf("'%v' is not a valid resource name", spec.Name)
+	}
+
+	imp := deploy.Import{
+		Type:     tokens.Type(spec.Type),
+		Name:     tokens.QName(spec.Name),
+		ID:       resource.ID(spec.ID),
+		Parent:   resource.URN(spec.Parent),
+		Provider: resource.URN(spec.Provider),
+		Protect:  protect,
+	}
+	if spec.Protect != nil {
+		imp.Protect = *spec.Protect
+	}
+	if spec.Version != "" {
+		v, err := semver.ParseTolerant(spec.Version)
+		if err != nil {
+			return deploy.Import{}, errors.Wrapf(err, "could not parse version '%v' for resource '%v'",
+				spec.Version, spec.Name)
+		}
+		imp.Version = &v
+	}
+	return imp, nil
+}
+
+// readImportFile reads the list of resources to import from the given JSON file.
+func readImportFile(path string, protect bool) ([]deploy.Import, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading import file")
+	}
+	var file importFile
+	if err = json.Unmarshal(b, &file); err != nil {
+		return nil, errors.Wrap(err, "decoding import file")
+	}
+	if len(file.Resources) == 0 {
+		return nil, errors.New("the import file does not list any resources")
+	}
+
+	imports := make([]deploy.Import, len(file.Resources))
+	for i, spec := range file.Resources {
+		if imports[i], err = spec.toImport(protect); err != nil {
+			return nil, errors.Wrapf(err, "resource %d", i)
+		}
+	}
+	return imports, nil
+}
+
+func newImportCmd() *cobra.Command {
+	var debug bool
+	var message string
+	var stack string
+	var importFilePath string
+	var parent string
+	var provider string
+	var protect bool
+	var skipCodegen bool
+
+	// Flags for engine.UpdateOptions.
+	var diffDisplay bool
+	var eventLogPath string
+	var parallel int
+	var showConfig bool
+	var skipPreview bool
+	var suppressOutputs bool
+	var yes bool
+
+	var cmd = &cobra.Command{
+		Use:   "import [type] [name] [id]",
+		Short: "Import resources into an existing stack",
+		Long: "Import resources into an existing stack.\n" +
+			"\n" +
+			"Resources that are not managed by Pulumi can be imported into a Pulumi stack\n" +
+			"using this command. A definition for each resource will be printed to stdout\n" +
+			"in the language used by the project associated with the stack; these definitions\n" +
+			"should be added to the Pulumi program. The resources are protected from deletion\n" +
+			"by default.\n" +
+			"\n" +
+			"Should you want to import your resource(s) without protection, you can pass\n" +
+			"`--protect=false` as an argument to the command.\n" +
+			"\n" +
+			"A single resource may be specified in the command line arguments, or a set of\n" +
+			"resources may be specified by a JSON file. The JSON file has the format:\n" +
+			"\n" +
+			"    {\n" +
+			"        \"resources\": [\n" +
+			"            {\n" +
+			"                \"type\": \"aws:ec2/vpc:Vpc\",\n" +
+			"                \"name\": \"application-vpc\",\n" +
+			"                \"id\": \"vpc-0ad77710973388316\"\n" +
+			"            },\n" +
+			"            ...\n" +
+			"        ]\n" +
+			"    }\n" +
+			"\n" +
+			"Each resource may additionally specify a `parent` URN, a `provider` URN, a\n" +
+			"provider `version`, and whether or not it should be `protect`ed.",
+		Args: cmdutil.MaximumNArgs(3),
+		Run: cmdutil.RunResultFunc(func(cmd *cobra.Command, args []string) result.Result {
+			var imports []deploy.Import
+			switch {
+			case len(args) != 0:
+				if importFilePath != "" {
+					return result.Error("an inline resource may not be specified in conjunction with an import file")
+				}
+				if len(args) != 3 {
+					return result.Error("an inline resource must be specified using a type, name, and ID")
+				}
+				imp, err := importSpec{
+					Type:     args[0],
+					Name:     args[1],
+					ID:       args[2],
+					Parent:   parent,
+					Provider: provider,
+				}.toImport(protect)
+				if err != nil {
+					return result.FromError(err)
+				}
+				imports = []deploy.Import{imp}
+			case importFilePath != "":
+				if parent != "" || provider != "" {
+					return result.Error("--parent and --provider may not be used with an import file")
+				}
+				var err error
+				if imports, err = readImportFile(importFilePath, protect); err != nil {
+					return result.FromError(err)
+				}
+			default:
+				return result.Error("a resource or an import file must be specified")
+			}
+
+			interactive := cmdutil.Interactive()
+			if !interactive {
+				yes = true // auto-approve changes, since we cannot prompt.
+			}
+
+			opts, err := updateFlagsToOptions(interactive, skipPreview, yes)
+			if err != nil {
+				return result.FromError(err)
+			}
+
+			var displayType = display.DisplayProgress
+			if diffDisplay {
+				displayType = display.DisplayDiff
+			}
+
+			opts.Display = display.Options{
+				Color:           cmdutil.GetGlobalColorization(),
+				ShowConfig:      showConfig,
+				SuppressOutputs: suppressOutputs,
+				IsInteractive:   interactive,
+				Type:            displayType,
+				EventLogPath:    eventLogPath,
+				Debug:           debug,
+			}
+
+			s, err := requireStack(stack, true, opts.Display, true /*setCurrent*/)
+			if err != nil {
+				return result.FromError(err)
+			}
+
+			proj, root, err := readProject()
+			if err != nil {
+				return result.FromError(err)
+			}
+
+			m, err := getUpdateMetadata(message, root)
+			if err != nil {
+				return result.FromError(errors.Wrap(err, "gathering environment metadata"))
+			}
+
+			sm, err := getStackSecretsManager(s)
+			if err != nil {
+				return result.FromError(errors.Wrap(err, "getting secrets manager"))
+			}
+
+			cfg, err := getStackConfiguration(s, sm)
+			if err != nil {
+				return result.FromError(errors.Wrap(err, "getting stack configuration"))
+			}
+
+			opts.Engine = engine.UpdateOptions{
+				Parallel:      parallel,
+				Debug:         debug,
+				UseLegacyDiff: useLegacyDiff(),
+			}
+
+			_, res := s.Import(commandContext(), backend.UpdateOperation{
+				Proj:               proj,
+				Root:               root,
+				M:                  m,
+				Opts:               opts,
+				StackConfiguration: cfg,
+				SecretsManager:     sm,
+				Scopes:             cancellationScopes,
+				Imports:            imports,
+			})
+
+			switch {
+			case res != nil && res.Error() == context.Canceled:
+				return result.FromError(errors.New("import cancelled"))
+			case res != nil:
+				return PrintEngineResult(res)
+			}
+
+			if skipCodegen {
+				return nil
+			}
+			if err = printImportedResources(s, proj.Runtime.Name(), imports); err != nil {
+				return result.FromError(err)
+			}
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().BoolVarP(
+		&debug, "debug", "d", false,
+		"Print detailed debugging output during resource operations")
+	cmd.PersistentFlags().StringVarP(
+		&stack, "stack", "s", "",
+		"The name of the stack to operate on. Defaults to the current stack")
+	cmd.PersistentFlags().StringVar(
+		&stackConfigFile, "config-file", "",
+		"Use the configuration values in the specified file rather than detecting the file name")
+	cmd.PersistentFlags().StringVarP(
+		&importFilePath, "file", "f", "",
+		"The path to a JSON-encoded file containing a list of resources to import")
+	cmd.PersistentFlags().StringVar(
+		&parent, "parent", "",
+		"The URN of the parent resource for the imported resource. Defaults to the stack")
+	cmd.PersistentFlags().StringVar(
+		&provider, "provider", "",
+		"The URN of the provider to use for the imported resource. Defaults to the default provider")
+	cmd.PersistentFlags().BoolVar(
+		&protect, "protect", true,
+		"Allow resources to be imported with protection from deletion enabled")
+	cmd.PersistentFlags().BoolVar(
+		&skipCodegen, "skip-codegen", false,
+		"Do not print code for the imported resources")
+
+	cmd.PersistentFlags().StringVarP(
+		&message, "message", "m", "",
+		"Optional message to associate with the update operation")
+
+	// Flags for engine.UpdateOptions.
+	cmd.PersistentFlags().BoolVar(
+		&diffDisplay, "diff", false,
+		"Display operation as a rich diff showing the overall change")
+	cmd.PersistentFlags().IntVarP(
+		&parallel, "parallel", "p", defaultParallel,
+		"Allow P resource operations to run in parallel at once (1 for no parallelism). Defaults to unbounded.")
+	cmd.PersistentFlags().BoolVar(
+		&showConfig, "show-config", false,
+		"Show configuration keys and variables")
+	cmd.PersistentFlags().BoolVar(
+		&skipPreview, "skip-preview", false,
+		"Do not perform a preview before performing the import")
+	cmd.PersistentFlags().BoolVar(
+		&suppressOutputs, "suppress-outputs", false,
+		"Suppress display of stack outputs (in case they contain sensitive values)")
+	cmd.PersistentFlags().BoolVarP(
+		&yes, "yes", "y", false,
+		"Automatically approve and perform the import after previewing it")
+
+	if hasDebugCommands() {
+		cmd.PersistentFlags().StringVar(
+			&eventLogPath, "event-log", "",
+			"Log events to a file at this path")
+	}
+	return cmd
+}
+
+// printImportedResources prints the program code necessary to declare the imported resources.
+func printImportedResources(s backend.Stack, runtime string, imports []deploy.Import) error {
+	if !importer.IsLanguageSupported(runtime) {
+		fmt.Printf("\nCode generation is not yet supported for the %v runtime; please add the imported resources "+
+			"to your program manually.\n", runtime)
+		return nil
+	}
+
+	snap, err := s.Snapshot(commandContext())
+	if err != nil {
+		return err
+	}
+	if snap == nil {
+		return nil
+	}
+
+	// Find the imported resources in the new snapshot, in the order in which they were requested.
+	byTypeAndName := make(map[string]*resource.State)
+	for _, res := range snap.Resources {
+		if !res.Delete {
+			byTypeAndName[string(res.Type)+"::"+string(res.URN.Name())] = res
+		}
+	}
+	var resources []*resource.State
+	for _, imp := range imports {
+		if res, ok := byTypeAndName[string(imp.Type)+"::"+string(imp.Name)]; ok && res.ID == imp.ID {
+			resources = append(resources, res)
+		}
+	}
+	if len(resources) == 0 {
+		return nil
+	}
+
+	fmt.Printf("\nPlease copy the following code into your Pulumi application. Not doing so\n" +
+		"will cause Pulumi to report that an update will happen on the next update command.\n\n")
+	return importer.GenerateProgram(os.Stdout, runtime, resources)
+}
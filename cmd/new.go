@@ -343,7 +343,12 @@ func newNewCmd() *cobra.Command {
 			"* `pulumi new --secrets-provider=\"awskms://1234abcd-12ab-34cd-56ef-1234567890ab?region=us-east-1\"`\n" +
 			"* `pulumi new --secrets-provider=\"azurekeyvault://mykeyvaultname.vault.azure.net/keys/mykeyname\"`\n" +
 			"* `pulumi new --secrets-provider=\"gcpkms://projects/p/locations/l/keyRings/r/cryptoKeys/k\"`\n" +
-			"* `pulumi new --secrets-provider=\"hashivault://mykey\"`",
+			"* `pulumi new --secrets-provider=\"hashivault://mykey\"`\n" +
+			"\n" +
+			"To use age keys instead of a cloud key management service, use one of the following:\n" +
+			"* `pulumi new --secrets-provider=age`\n" +
+			"* `pulumi new --secrets-provider=\"age:///path/to/keys.txt\"`\n" +
+			"* `pulumi new --secrets-provider=\"age://?recipient=age1...&recipient=age1...\"`",
 		Args: cmdutil.MaximumNArgs(1),
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, cliArgs []string) error {
 			if len(cliArgs) > 0 {
@@ -416,7 +421,7 @@ func newNewCmd() *cobra.Command {
 		"Skip prompts and proceed with default values")
 	cmd.PersistentFlags().StringVar(
 		&args.secretsProvider, "secrets-provider", "default", "The type of the provider that should be used to encrypt and "+
-			"decrypt secrets (possible choices: default, passphrase, awskms, azurekeyvault, gcpkms, hashivault)")
+			"decrypt secrets (possible choices: default, passphrase, age, awskms, azurekeyvault, gcpkms, hashivault)")
 
 	return cmd
 }
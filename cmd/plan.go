@@ -0,0 +1,63 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/apitype"
+	"github.com/pulumi/pulumi/pkg/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/resource/stack"
+	"github.com/pulumi/pulumi/pkg/secrets"
+)
+
+// writeUpdatePlan writes the given update plan to a file, encrypting any secrets it contains using the given stack
+// secrets manager.
+func writeUpdatePlan(path string, plan *deploy.UpdatePlan, sm secrets.Manager) error {
+	enc, err := sm.Encrypter()
+	if err != nil {
+		return err
+	}
+	serialized, err := stack.SerializeUpdatePlan(plan, enc)
+	if err != nil {
+		return errors.Wrap(err, "serializing update plan")
+	}
+	bytes, err := json.MarshalIndent(serialized, "", "    ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, bytes, 0600)
+}
+
+// readUpdatePlan reads an update plan from a file, decrypting any secrets it contains using the given stack secrets
+// manager.
+func readUpdatePlan(path string, sm secrets.Manager) (*deploy.UpdatePlan, error) {
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var serialized apitype.UpdatePlanV1
+	if err = json.Unmarshal(bytes, &serialized); err != nil {
+		return nil, errors.Wrapf(err, "could not parse update plan %s", path)
+	}
+	dec, err := sm.Decrypter()
+	if err != nil {
+		return nil, err
+	}
+	return stack.DeserializeUpdatePlan(serialized, dec)
+}
@@ -0,0 +1,89 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/resource/plugin"
+	"github.com/pulumi/pulumi/pkg/tokens"
+	"github.com/pulumi/pulumi/pkg/util/cmdutil"
+	"github.com/pulumi/pulumi/pkg/util/contract"
+)
+
+func newPluginSchemaCmd() *cobra.Command {
+	var schemaVersion int
+	var cmd = &cobra.Command{
+		Use:   "schema NAME [VERSION]",
+		Args:  cmdutil.RangeArgs(1, 2),
+		Short: "Print the schema of a resource provider plugin",
+		Long: "Print the schema of a resource provider plugin.\n" +
+			"\n" +
+			"The schema describes the resources, functions, and configuration offered by the\n" +
+			"provider's package, and is printed as JSON.  If VERSION is not specified, the\n" +
+			"latest installed version of the plugin is used.",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			var version *semver.Version
+			if len(args) > 1 {
+				v, err := semver.ParseTolerant(args[1])
+				if err != nil {
+					return errors.Wrap(err, "invalid plugin semver")
+				}
+				version = &v
+			}
+
+			pwd, err := os.Getwd()
+			if err != nil {
+				return err
+			}
+			ctx, err := plugin.NewContext(cmdutil.Diag(), cmdutil.Diag(), nil, nil, pwd, nil, nil)
+			if err != nil {
+				return err
+			}
+			defer contract.IgnoreClose(ctx)
+
+			prov, err := ctx.Host.Provider(tokens.Package(name), version)
+			if err != nil {
+				return errors.Wrapf(err, "loading resource plugin %s", name)
+			}
+
+			schema, err := prov.GetSchema(schemaVersion)
+			if err != nil {
+				return errors.Wrapf(err, "fetching schema for %s", name)
+			}
+
+			var out bytes.Buffer
+			if err = json.Indent(&out, schema, "", "  "); err != nil {
+				return errors.Wrapf(err, "resource plugin %s returned an invalid schema", name)
+			}
+			fmt.Println(out.String())
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().IntVar(
+		&schemaVersion, "schema-version", 0,
+		"The version of the schema format to request from the plugin")
+
+	return cmd
+}
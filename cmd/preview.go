@@ -21,6 +21,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/backend"
 	"github.com/pulumi/pulumi/pkg/backend/display"
 	"github.com/pulumi/pulumi/pkg/engine"
+	"github.com/pulumi/pulumi/pkg/resource/deploy"
 	"github.com/pulumi/pulumi/pkg/util/cmdutil"
 	"github.com/pulumi/pulumi/pkg/util/result"
 )
@@ -32,6 +33,7 @@ func newPreviewCmd() *cobra.Command {
 	var stack string
 	var configArray []string
 	var configPath bool
+	var planFilePath string
 
 	// Flags for engine.UpdateOptions.
 	var policyPackPaths []string
@@ -60,7 +62,10 @@ func newPreviewCmd() *cobra.Command {
 			"actually take place.\n" +
 			"\n" +
 			"The program to run is loaded from the project in the current directory. Use the `-C` or\n" +
-			"`--cwd` flag to use a different directory.",
+			"`--cwd` flag to use a different directory.\n" +
+			"\n" +
+			"Use the `--save-plan` flag to save the steps that the preview plans to perform to a file. Passing\n" +
+			"that file to `pulumi up --plan` constrains the update to the steps that were previewed.",
 		Args: cmdutil.NoArgs,
 		Run: cmdutil.RunResultFunc(func(cmd *cobra.Command, args []string) result.Result {
 			var displayType = display.DisplayProgress
@@ -120,6 +125,10 @@ func newPreviewCmd() *cobra.Command {
 				return result.FromError(errors.Wrap(err, "getting stack configuration"))
 			}
 
+			if planFilePath != "" {
+				opts.Engine.RecordPlan = deploy.NewUpdatePlan()
+			}
+
 			changes, res := s.Preview(commandContext(), backend.UpdateOperation{
 				Proj:               proj,
 				Root:               root,
@@ -135,6 +144,11 @@ func newPreviewCmd() *cobra.Command {
 				return PrintEngineResult(res)
 			case expectNop && changes != nil && changes.HasChanges():
 				return result.FromError(errors.New("error: no changes were expected but changes were proposed"))
+			case planFilePath != "":
+				if err = writeUpdatePlan(planFilePath, opts.Engine.RecordPlan, sm); err != nil {
+					return result.FromError(errors.Wrap(err, "saving update plan"))
+				}
+				return nil
 			default:
 				return nil
 			}
@@ -163,6 +177,9 @@ func newPreviewCmd() *cobra.Command {
 	cmd.PersistentFlags().StringVarP(
 		&message, "message", "m", "",
 		"Optional message to associate with the preview operation")
+	cmd.PersistentFlags().StringVar(
+		&planFilePath, "save-plan", "",
+		"Save the steps this preview plans to perform to the given file, for use with `pulumi up --plan`")
 
 	// Flags for engine.UpdateOptions.
 	if hasDebugCommands() || hasExperimentalCommands() {
@@ -16,6 +16,7 @@ package cmd
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -38,6 +39,8 @@ func newRefreshCmd() *cobra.Command {
 	var diffDisplay bool
 	var eventLogPath string
 	var parallel int
+	var parallelPerProvider int
+	var readTimeout time.Duration
 	var showConfig bool
 	var showReplacementSteps bool
 	var showSames bool
@@ -118,10 +121,12 @@ func newRefreshCmd() *cobra.Command {
 			}
 
 			opts.Engine = engine.UpdateOptions{
-				Parallel:       parallel,
-				Debug:          debug,
-				UseLegacyDiff:  useLegacyDiff(),
-				RefreshTargets: targetUrns,
+				Parallel:        parallel,
+				RefreshParallel: parallelPerProvider,
+				RefreshTimeout:  readTimeout,
+				Debug:           debug,
+				UseLegacyDiff:   useLegacyDiff(),
+				RefreshTargets:  targetUrns,
 			}
 
 			changes, res := s.Refresh(commandContext(), backend.UpdateOperation{
@@ -175,6 +180,12 @@ func newRefreshCmd() *cobra.Command {
 	cmd.PersistentFlags().IntVarP(
 		&parallel, "parallel", "p", defaultParallel,
 		"Allow P resource operations to run in parallel at once (1 for no parallelism). Defaults to unbounded.")
+	cmd.PersistentFlags().IntVar(
+		&parallelPerProvider, "parallel-per-provider", 0,
+		"Allow at most P resources to be read from each provider at once. Defaults to unbounded.")
+	cmd.PersistentFlags().DurationVar(
+		&readTimeout, "read-timeout", 0,
+		"Cancel the read of any resource that takes longer than this duration (e.g. 5m). Defaults to no timeout.")
 	cmd.PersistentFlags().BoolVar(
 		&showReplacementSteps, "show-replacement-steps", false,
 		"Show detailed resource replacement creates and deletes instead of a single step")
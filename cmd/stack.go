@@ -162,6 +162,8 @@ func newStackCmd() *cobra.Command {
 	cmd.AddCommand(newStackSelectCmd())
 	cmd.AddCommand(newStackTagCmd())
 	cmd.AddCommand(newStackRenameCmd())
+	cmd.AddCommand(newStackChangeSecretsProviderCmd())
+	cmd.AddCommand(newStackChangePassphraseCmd())
 
 	return cmd
 }
@@ -0,0 +1,101 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/backend/display"
+	"github.com/pulumi/pulumi/pkg/secrets/passphrase"
+	"github.com/pulumi/pulumi/pkg/util/cmdutil"
+	"github.com/pulumi/pulumi/pkg/workspace"
+)
+
+func newStackChangePassphraseCmd() *cobra.Command {
+	var stack string
+	var cmd = &cobra.Command{
+		Use:   "change-passphrase",
+		Args:  cmdutil.NoArgs,
+		Short: "Change the passphrase that protects the current stack's secrets",
+		Long: "Change the passphrase that protects the current stack's secrets.\n" +
+			"\n" +
+			"This command only applies to stacks that use the passphrase secrets provider. The current passphrase\n" +
+			"is verified, and then every secret in the stack's configuration and checkpoint is re-encrypted using a\n" +
+			"key derived from the new passphrase and a freshly generated salt.\n" +
+			"\n" +
+			"The current passphrase is read from the `" + passphraseEnvVar + "` environment variable and the new\n" +
+			"one from the `" + newPassphraseEnvVar + "` environment variable. If either is not set, you\n" +
+			"will be prompted for it. Once the passphrase has been changed, set `" + passphraseEnvVar + "`\n" +
+			"to the new passphrase for future operations on the stack.",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			opts := display.Options{
+				Color: cmdutil.GetGlobalColorization(),
+			}
+
+			s, err := requireStack(stack, false, opts, true /*setCurrent*/)
+			if err != nil {
+				return err
+			}
+
+			ps, err := loadProjectStack(s)
+			if err != nil {
+				return err
+			}
+			if ps.EncryptionSalt == "" || (ps.SecretsProvider != "" && ps.SecretsProvider != passphrase.Type &&
+				ps.SecretsProvider != "default") {
+				return errors.Errorf("stack %s does not use the passphrase secrets provider", s.Ref())
+			}
+
+			// Verify the current passphrase before doing anything else.
+			oldPhrase, err := readPassphrase("Enter your current passphrase")
+			if err != nil {
+				return err
+			}
+			oldSM, err := passphrase.NewPassphaseSecretsManager(oldPhrase, ps.EncryptionSalt)
+			if err != nil {
+				return err
+			}
+
+			newPhrase, err := readNewPassphrase(newPassphraseEnvVar, "Enter your new passphrase")
+			if err != nil {
+				return err
+			}
+			if newPhrase == oldPhrase {
+				return errors.New("the new passphrase must differ from the current one")
+			}
+
+			info := &workspace.ProjectStack{SecretsProvider: ps.SecretsProvider}
+			sm, err := createPassphraseSecretsManager(info, newPhrase)
+			if err != nil {
+				return err
+			}
+			if err = changeStackSecretsManager(s, oldSM, info, sm); err != nil {
+				return err
+			}
+
+			fmt.Printf("Changed the passphrase for stack %s; set %s to the new passphrase for future operations\n",
+				s.Ref(), passphraseEnvVar)
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().StringVarP(
+		&stack, "stack", "s", "",
+		"The name of the stack to operate on. Defaults to the current stack")
+	return cmd
+}
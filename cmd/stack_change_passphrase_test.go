@@ -0,0 +1,109 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/apitype"
+	"github.com/pulumi/pulumi/pkg/backend"
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/config"
+	"github.com/pulumi/pulumi/pkg/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/resource/stack"
+	"github.com/pulumi/pulumi/pkg/workspace"
+)
+
+func TestChangeStackPassphraseWithSecretCheckpoint(t *testing.T) {
+	dir, err := ioutil.TempDir("", "passphrase")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// The current passphrase is only known from the prompt.
+	if phrase, ok := os.LookupEnv(passphraseEnvVar); ok {
+		defer os.Setenv(passphraseEnvVar, phrase)
+		assert.NoError(t, os.Unsetenv(passphraseEnvVar))
+	}
+
+	oldStackConfigFile := stackConfigFile
+	defer func() { stackConfigFile = oldStackConfigFile }()
+	stackConfigFile = filepath.Join(dir, "Pulumi.dev.yaml")
+
+	// Create a stack whose configuration and checkpoint both hold a secret encrypted with the old passphrase.
+	ps := &workspace.ProjectStack{}
+	oldSM, err := createPassphraseSecretsManager(ps, "old passphrase")
+	assert.NoError(t, err)
+	oldEncrypter, err := oldSM.Encrypter()
+	assert.NoError(t, err)
+	ciphertext, err := oldEncrypter.EncryptValue("hunter2")
+	assert.NoError(t, err)
+	ps.Config = config.Map{config.MustMakeKey("test", "password"): config.NewSecureValue(ciphertext)}
+	assert.NoError(t, ps.Save(stackConfigFile))
+
+	urn := resource.NewURN("dev", "test", "", "test:index:Resource", "res")
+	outputs := resource.PropertyMap{"password": resource.MakeSecret(resource.NewStringProperty("hunter2"))}
+	res := resource.NewState("test:index:Resource", urn, true, false, "id", resource.PropertyMap{}, outputs, "",
+		false, false, nil, nil, "", nil, false, nil, nil, nil, false)
+	sdep, err := stack.SerializeDeployment(deploy.NewSnapshot(deploy.Manifest{}, oldSM, []*resource.State{res}, nil),
+		oldSM)
+	assert.NoError(t, err)
+	bytes, err := json.Marshal(sdep)
+	assert.NoError(t, err)
+
+	// The stack does not support loading its snapshot directly, as that would use the passphrase from the
+	// environment.
+	var imported *apitype.UntypedDeployment
+	s := &backend.MockStack{
+		ExportDeploymentF: func(ctx context.Context) (*apitype.UntypedDeployment, error) {
+			return &apitype.UntypedDeployment{Version: apitype.DeploymentSchemaVersionCurrent, Deployment: bytes}, nil
+		},
+		ImportDeploymentF: func(ctx context.Context, deployment *apitype.UntypedDeployment) error {
+			imported = deployment
+			return nil
+		},
+	}
+
+	info := &workspace.ProjectStack{}
+	sm, err := createPassphraseSecretsManager(info, "new passphrase")
+	assert.NoError(t, err)
+	assert.NoError(t, changeStackSecretsManager(s, oldSM, info, sm))
+
+	// The configuration is re-encrypted with the new passphrase and saved with its salt.
+	saved, err := workspace.LoadProjectStack(stackConfigFile)
+	assert.NoError(t, err)
+	assert.Equal(t, info.EncryptionSalt, saved.EncryptionSalt)
+	decrypter, err := sm.Decrypter()
+	assert.NoError(t, err)
+	plaintext, err := saved.Config[config.MustMakeKey("test", "password")].Value(decrypter)
+	assert.NoError(t, err)
+	assert.Equal(t, "hunter2", plaintext)
+
+	// So is the checkpoint.
+	if assert.NotNil(t, imported) {
+		snap, err := stack.DeserializeUntypedDeployment(imported, checkpointSecretsProvider{sm: sm})
+		assert.NoError(t, err)
+		assert.Equal(t, sm.State(), snap.SecretsManager.State())
+		if assert.Len(t, snap.Resources, 1) {
+			assert.Equal(t, outputs, snap.Resources[0].Outputs)
+		}
+	}
+}
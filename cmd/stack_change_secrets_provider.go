@@ -0,0 +1,211 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/backend"
+	"github.com/pulumi/pulumi/pkg/backend/display"
+	"github.com/pulumi/pulumi/pkg/backend/filestate"
+	"github.com/pulumi/pulumi/pkg/backend/httpstate"
+	"github.com/pulumi/pulumi/pkg/resource/stack"
+	"github.com/pulumi/pulumi/pkg/secrets"
+	"github.com/pulumi/pulumi/pkg/secrets/passphrase"
+	"github.com/pulumi/pulumi/pkg/util/cmdutil"
+	"github.com/pulumi/pulumi/pkg/workspace"
+)
+
+func newStackChangeSecretsProviderCmd() *cobra.Command {
+	var stack string
+	var cmd = &cobra.Command{
+		Use:   "change-secrets-provider <new-secrets-provider>",
+		Args:  cmdutil.ExactArgs(1),
+		Short: "Change the secrets provider for the current stack",
+		Long: "Change the secrets provider for the current stack.\n" +
+			"\n" +
+			"Every secret in the stack's configuration and checkpoint is decrypted using the stack's current\n" +
+			"secrets provider and re-encrypted using the new one.\n" +
+			"\n" +
+			"Valid secret providers types are `default`, `passphrase`, `age`, `awskms`, `azurekeyvault`,\n" +
+			"`gcpkms`, and `hashivault`.\n" +
+			"\n" +
+			"To change to using the Pulumi Default Secrets Provider, use the following:\n" +
+			"\n" +
+			"pulumi stack change-secrets-provider default\n" +
+			"\n" +
+			"To change the stack to use a cloud secrets backend, use one of the following:\n" +
+			"\n" +
+			"* `pulumi stack change-secrets-provider \"awskms://alias/ExampleAlias?region=us-east-1\"`\n" +
+			"* `pulumi stack change-secrets-provider " +
+			"\"azurekeyvault://mykeyvaultname.vault.azure.net/keys/mykeyname\"`\n" +
+			"* `pulumi stack change-secrets-provider " +
+			"\"gcpkms://projects/<p>/locations/<l>/keyRings/<r>/cryptoKeys/<k>\"`\n" +
+			"* `pulumi stack change-secrets-provider \"hashivault://mykey\"`\n" +
+			"\n" +
+			"To change the stack to use age keys, use one of the following:\n" +
+			"\n" +
+			"* `pulumi stack change-secrets-provider age`\n" +
+			"* `pulumi stack change-secrets-provider \"age:///path/to/keys.txt\"`\n" +
+			"* `pulumi stack change-secrets-provider \"age://?recipient=age1...&recipient=age1...\"`\n" +
+			"\n" +
+			"Running this command with a new list of age recipients adds or removes recipients, re-encrypting\n" +
+			"every secret so that only the new recipients can decrypt them.",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			opts := display.Options{
+				Color: cmdutil.GetGlobalColorization(),
+			}
+
+			secretsProvider := args[0]
+			if err := validateSecretsProvider(secretsProvider); err != nil {
+				return err
+			}
+
+			s, err := requireStack(stack, false, opts, true /*setCurrent*/)
+			if err != nil {
+				return err
+			}
+
+			oldSM, err := getStackSecretsManager(s)
+			if err != nil {
+				return errors.Wrap(err, "getting current secrets manager")
+			}
+
+			// Build the new secrets manager from a clean set of stack settings, so that none of the old provider's
+			// settings linger.
+			info := &workspace.ProjectStack{}
+			sm, err := createStackSecretsManager(s, info, secretsProvider)
+			if err != nil {
+				return err
+			}
+			if err = changeStackSecretsManager(s, oldSM, info, sm); err != nil {
+				return err
+			}
+
+			fmt.Printf("Changed the secrets provider for stack %s\n", s.Ref())
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().StringVarP(
+		&stack, "stack", "s", "",
+		"The name of the stack to operate on. Defaults to the current stack")
+	return cmd
+}
+
+// createStackSecretsManager creates a new secrets manager of the given type for the given stack, recording its
+// settings in the given stack settings. The settings are not saved.
+func createStackSecretsManager(s backend.Stack, info *workspace.ProjectStack,
+	secretsProvider string) (secrets.Manager, error) {
+
+	isDefaultSecretsProvider := secretsProvider == "" || secretsProvider == "default"
+	if _, ok := s.(filestate.Stack); ok && isDefaultSecretsProvider {
+		// The default when using the filestate backend is the passphrase secrets provider
+		secretsProvider = passphrase.Type
+	}
+
+	switch {
+	case secretsProvider == passphrase.Type:
+		phrase, err := readNewPassphrase(passphraseEnvVar, "Enter your new passphrase to protect config/secrets")
+		if err != nil {
+			return nil, err
+		}
+		return createPassphraseSecretsManager(info, phrase)
+	case isAgeSecretsProvider(secretsProvider):
+		return createAgeSecretsManager(info, secretsProvider)
+	case isDefaultSecretsProvider:
+		httpStack, ok := s.(httpstate.Stack)
+		if !ok {
+			return nil, errors.Errorf("the default secrets provider is not supported for stack %s", s.Ref())
+		}
+		return newServiceSecretsManager(httpStack)
+	default:
+		return createCloudSecretsManager(info, secretsProvider)
+	}
+}
+
+// changeStackSecretsManager re-encrypts every secret in the given stack's configuration and checkpoint, decrypting
+// them with the stack's current secrets manager and encrypting them with the new one. The new secrets manager's
+// settings are taken from the given stack settings, which replace the stack's existing ones once its secrets have
+// been re-encrypted.
+func changeStackSecretsManager(s backend.Stack, oldSM secrets.Manager, info *workspace.ProjectStack,
+	sm secrets.Manager) error {
+
+	ps, err := loadProjectStack(s)
+	if err != nil {
+		return err
+	}
+	decrypter, err := oldSM.Decrypter()
+	if err != nil {
+		return err
+	}
+	encrypter, err := sm.Encrypter()
+	if err != nil {
+		return err
+	}
+
+	// Re-encrypt the configuration first, so that nothing is written unless every secret can be decrypted.
+	if info.Config, err = ps.Config.Copy(decrypter, encrypter); err != nil {
+		return errors.Wrap(err, "re-encrypting configuration")
+	}
+
+	// The checkpoint is decrypted with the old secrets manager rather than one rebuilt from the checkpoint's own
+	// settings, which may lack credentials that were prompted for (such as the current passphrase). It holds its
+	// secrets in plaintext once loaded, so saving it with the new secrets manager is enough to re-encrypt them. The
+	// checkpoint records the settings of the secrets manager that encrypted it, so it remains readable even if saving
+	// the stack settings below fails.
+	deployment, err := s.ExportDeployment(commandContext())
+	if err != nil {
+		return err
+	}
+	snap, err := stack.DeserializeUntypedDeployment(deployment, checkpointSecretsProvider{sm: oldSM})
+	if err != nil {
+		return errors.Wrap(err, "decrypting checkpoint")
+	}
+	if len(snap.Resources) != 0 || len(snap.PendingOperations) != 0 {
+		snap.SecretsManager = sm
+		if res := saveSnapshot(s, snap); res != nil {
+			return errors.Wrap(res.Error(), "re-encrypting checkpoint")
+		}
+	}
+
+	// Finally, replace the stack's secrets settings and configuration in a single write.
+	return saveProjectStack(s, info)
+}
+
+// checkpointSecretsProvider is a stack.SecretsProvider that returns the given secrets manager for checkpoints that
+// were encrypted by it, and otherwise falls back to the default secrets provider.
+type checkpointSecretsProvider struct {
+	sm secrets.Manager
+}
+
+func (p checkpointSecretsProvider) OfType(ty string, state json.RawMessage) (secrets.Manager, error) {
+	if ty == p.sm.Type() {
+		smState, err := json.Marshal(p.sm.State())
+		if err != nil {
+			return nil, err
+		}
+		var compacted bytes.Buffer
+		if err = json.Compact(&compacted, state); err == nil && bytes.Equal(compacted.Bytes(), smState) {
+			return p.sm, nil
+		}
+	}
+	return stack.DefaultSecretsProvider.OfType(ty, state)
+}
@@ -56,7 +56,13 @@ func newStackInitCmd() *cobra.Command {
 			"* `pulumi stack init --secrets-provider=\"awskms://1234abcd-12ab-34cd-56ef-1234567890ab?region=us-east-1\"`\n" +
 			"* `pulumi stack init --secrets-provider=\"azurekeyvault://mykeyvaultname.vault.azure.net/keys/mykeyname\"`\n" +
 			"* `pulumi stack init --secrets-provider=\"gcpkms://projects/<p>/locations/<l>/keyRings/<r>/cryptoKeys/<k>\"`\n" +
-			"* `pulumi stack init --secrets-provider=\"hashivault://mykey\"`",
+			"* `pulumi stack init --secrets-provider=\"hashivault://mykey\"`\n" +
+			"\n" +
+			"To use age keys instead of a cloud key management service, use one of the following:\n" +
+			"\n" +
+			"* `pulumi stack init --secrets-provider=age`\n" +
+			"* `pulumi stack init --secrets-provider=\"age:///path/to/keys.txt\"`\n" +
+			"* `pulumi stack init --secrets-provider=\"age://?recipient=age1...&recipient=age1...\"`",
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
 			opts := display.Options{
 				Color: cmdutil.GetGlobalColorization(),
@@ -116,6 +122,6 @@ func newStackInitCmd() *cobra.Command {
 		&stackName, "stack", "s", "", "The name of the stack to create")
 	cmd.PersistentFlags().StringVar(
 		&secretsProvider, "secrets-provider", "default", "The type of the provider that should be used to encrypt and "+
-			"decrypt secrets (possible choices: default, passphrase, awskms, azurekeyvault, gcpkms, hashivault)")
+			"decrypt secrets (possible choices: default, passphrase, age, awskms, azurekeyvault, gcpkms, hashivault)")
 	return cmd
 }
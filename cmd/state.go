@@ -24,6 +24,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/pulumi/pulumi/pkg/apitype"
+	"github.com/pulumi/pulumi/pkg/backend"
 	"github.com/pulumi/pulumi/pkg/backend/display"
 	"github.com/pulumi/pulumi/pkg/diag/colors"
 	"github.com/pulumi/pulumi/pkg/resource"
@@ -49,6 +50,9 @@ troubleshooting a stack or when performing specific edits that otherwise would r
 
 	cmd.AddCommand(newStateDeleteCommand())
 	cmd.AddCommand(newStateUnprotectCommand())
+	cmd.AddCommand(newStateMoveCommand())
+	cmd.AddCommand(newStateRenameCommand())
+	cmd.AddCommand(newStateSetParentCommand())
 	return cmd
 }
 
@@ -139,19 +143,8 @@ func runTotalStateEdit(
 		return result.FromError(err)
 	}
 
-	if showPrompt && cmdutil.Interactive() {
-		confirm := false
-		surveycore.DisableColor = true
-		surveycore.QuestionIcon = ""
-		surveycore.SelectFocusIcon = opts.Color.Colorize(colors.BrightGreen + ">" + colors.Reset)
-		prompt := opts.Color.Colorize(colors.Yellow + "warning" + colors.Reset + ": ")
-		prompt += "This command will edit your stack's state directly. Confirm?"
-		if err = survey.AskOne(&survey.Confirm{
-			Message: prompt,
-		}, &confirm, nil); err != nil || !confirm {
-			fmt.Println("confirmation declined")
-			return result.Bail()
-		}
+	if showPrompt && !confirmStateEdit(opts, "This command will edit your stack's state directly. Confirm?") {
+		return result.Bail()
 	}
 
 	// The `operation` callback will mutate `snap` in-place. In order to validate the correctness of the transformation
@@ -167,19 +160,53 @@ func runTotalStateEdit(
 		contract.AssertNoErrorf(snap.VerifyIntegrity(), "state edit produced an invalid snapshot")
 	}
 
+	// Once we've mutated the snapshot, import it back into the backend so that it can be persisted.
+	return saveSnapshot(s, snap)
+}
+
+// confirmStateEdit asks the user to confirm a direct edit of a stack's state, if we are running interactively.
+func confirmStateEdit(opts display.Options, message string) bool {
+	if !cmdutil.Interactive() {
+		return true
+	}
+
+	confirm := false
+	surveycore.DisableColor = true
+	surveycore.QuestionIcon = ""
+	surveycore.SelectFocusIcon = opts.Color.Colorize(colors.BrightGreen + ">" + colors.Reset)
+	prompt := opts.Color.Colorize(colors.Yellow + "warning" + colors.Reset + ": ")
+	prompt += message
+	if err := survey.AskOne(&survey.Confirm{
+		Message: prompt,
+	}, &confirm, nil); err != nil || !confirm {
+		fmt.Println("confirmation declined")
+		return false
+	}
+	return true
+}
+
+// saveSnapshot serializes the given snapshot using its secrets manager and imports it into the given stack.
+func saveSnapshot(s backend.Stack, snap *deploy.Snapshot) result.Result {
+	dep, err := serializeSnapshot(snap)
+	if err != nil {
+		return result.FromError(err)
+	}
+	return result.WrapIfNonNil(s.ImportDeployment(commandContext(), dep))
+}
+
+// serializeSnapshot serializes the given snapshot using its secrets manager.
+func serializeSnapshot(snap *deploy.Snapshot) (*apitype.UntypedDeployment, error) {
 	sdep, err := stack.SerializeDeployment(snap, snap.SecretsManager)
 	if err != nil {
-		return result.FromError(errors.Wrap(err, "serializing deployment"))
+		return nil, errors.Wrap(err, "serializing deployment")
 	}
 
-	// Once we've mutated the snapshot, import it back into the backend so that it can be persisted.
 	bytes, err := json.Marshal(sdep)
 	if err != nil {
-		return result.FromError(err)
+		return nil, err
 	}
-	dep := apitype.UntypedDeployment{
+	return &apitype.UntypedDeployment{
 		Version:    apitype.DeploymentSchemaVersionCurrent,
 		Deployment: bytes,
-	}
-	return result.WrapIfNonNil(s.ImportDeployment(commandContext(), &dep))
+	}, nil
 }
@@ -0,0 +1,223 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/backend"
+	"github.com/pulumi/pulumi/pkg/backend/display"
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/resource/edit"
+	"github.com/pulumi/pulumi/pkg/tokens"
+	"github.com/pulumi/pulumi/pkg/util/cmdutil"
+	"github.com/pulumi/pulumi/pkg/util/contract"
+	"github.com/pulumi/pulumi/pkg/util/result"
+	"github.com/pulumi/pulumi/pkg/version"
+)
+
+func newStateMoveCommand() *cobra.Command {
+	var stackName string
+	var destName string
+	var includeChildren bool
+	var includeDependents bool
+	var yes bool
+
+	cmd := &cobra.Command{
+		Use:   "move <resource URN>...",
+		Short: "Moves resources from one stack's state to another's",
+		Long: `Moves resources from one stack's state to another's
+
+This command moves resources, specified by their Pulumi URNs (use 'pulumi stack --show-urns' to get them), from
+the state of one stack to the state of another. This is useful when splitting a stack into several smaller ones.
+The moved resources' URNs are rewritten for the destination stack, providers they use are copied along with them,
+and their secrets are re-encrypted using the destination stack's secrets provider.
+
+Resources can't be moved if doing so would leave a resource in either stack depending on or parented to a
+resource in the other. Use --include-children and --include-dependents to move such resources as well.
+
+The resources must also be moved from the source program to the destination program, or the next update of either
+stack will delete or recreate them.
+
+The source stack's state is written before the destination's. If writing the destination fails, the moved resources
+are left in neither stack; its new state is kept in a local file, and the error gives the 'pulumi stack import'
+command that finishes the move.
+
+Make sure that URNs are single-quoted to avoid having characters unexpectedly interpreted by the shell.
+
+Example:
+pulumi state move --dest networking 'urn:pulumi:monolith::demo::aws:ec2/vpc:Vpc::main-vpc' --include-children
+`,
+		Args: cmdutil.MinimumNArgs(1),
+		Run: cmdutil.RunResultFunc(func(cmd *cobra.Command, args []string) result.Result {
+			opts := display.Options{
+				Color: cmdutil.GetGlobalColorization(),
+			}
+
+			if destName == "" {
+				return result.Error("a destination stack must be specified using --dest")
+			}
+			source, err := requireStack(stackName, false, opts, false /*setCurrent*/)
+			if err != nil {
+				return result.FromError(err)
+			}
+			dest, err := requireStack(destName, false, opts, false /*setCurrent*/)
+			if err != nil {
+				return result.FromError(err)
+			}
+			if source.Ref().String() == dest.Ref().String() {
+				return result.Error("the source and destination stacks must be different")
+			}
+
+			sourceSnap, err := source.Snapshot(commandContext())
+			if err != nil {
+				return result.FromError(err)
+			}
+			if sourceSnap == nil || len(sourceSnap.Resources) == 0 {
+				return result.Errorf("stack %v has no resources to move", source.Ref())
+			}
+
+			destSnap, err := dest.Snapshot(commandContext())
+			if err != nil {
+				return result.FromError(err)
+			}
+			destSecrets, err := getStackSecretsManager(dest)
+			if err != nil {
+				return result.FromError(errors.Wrap(err, "getting secrets manager"))
+			}
+			if destSnap == nil {
+				destSnap = deploy.NewSnapshot(deploy.Manifest{
+					Time:    time.Now(),
+					Version: version.Version,
+				}, destSecrets, nil, nil)
+			}
+			// Serializing the destination with its own secrets manager re-encrypts the moved resources' secrets.
+			destSnap.SecretsManager = destSecrets
+
+			urns := make([]resource.URN, len(args))
+			for i, arg := range args {
+				urns[i] = resource.URN(arg)
+			}
+
+			if !yes && !confirmStateEdit(opts, fmt.Sprintf(
+				"This command will edit the state of stacks %v and %v directly. Confirm?", source.Ref(), dest.Ref())) {
+				return result.Bail()
+			}
+
+			moved, err := edit.MoveResources(sourceSnap, destSnap, urns, dest.Ref().Name(),
+				stackProject(destSnap, sourceSnap), edit.MoveOptions{
+					IncludeChildren:   includeChildren,
+					IncludeDependents: includeDependents,
+				})
+			if err != nil {
+				if e, ok := err.(edit.ResourcesHaveDanglingReferencesError); ok {
+					message := "These resources can't be moved because the following references would be left dangling:\n"
+					for _, ref := range e.References {
+						message += fmt.Sprintf(" * %s\n   -> %s\n", ref.From, ref.To)
+					}
+					message += "\nUse --include-children or --include-dependents to move the referring resources as well."
+					return result.Error(message)
+				}
+				return result.FromError(err)
+			}
+
+			if err := saveMovedResources(source, dest, sourceSnap, destSnap); err != nil {
+				return result.FromError(err)
+			}
+
+			fmt.Printf("Moved %d resource(s) from %v to %v:\n", len(moved), source.Ref(), dest.Ref())
+			for _, res := range moved {
+				fmt.Printf(" * %s\n", res.URN)
+			}
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().StringVarP(
+		&stackName, "stack", "s", "",
+		"The name of the stack to move resources from. Defaults to the current stack")
+	cmd.PersistentFlags().StringVar(
+		&destName, "dest", "",
+		"The name of the stack to move resources to")
+	cmd.Flags().BoolVar(&includeChildren, "include-children", false,
+		"Move the descendants of the given resources as well")
+	cmd.Flags().BoolVar(&includeDependents, "include-dependents", false,
+		"Move the resources that depend on the given resources as well")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Skip confirmation prompts")
+	return cmd
+}
+
+// saveMovedResources saves the source and destination stacks' snapshots after a move.
+//
+// The source is written first: if the destination then can't be written, the moved resources are left in neither
+// stack, and so are orphaned rather than managed by both stacks at once, which would let an update or destroy of
+// either stack delete resources the other still uses. To make that recoverable, the destination's new deployment is
+// staged in a local file before anything is written, and the error explains how to finish the move by importing it
+// with `pulumi stack import`.
+func saveMovedResources(source, dest backend.Stack, sourceSnap, destSnap *deploy.Snapshot) error {
+	sourceDep, err := serializeSnapshot(sourceSnap)
+	if err != nil {
+		return err
+	}
+	destDep, err := serializeSnapshot(destSnap)
+	if err != nil {
+		return err
+	}
+
+	staged, err := ioutil.TempFile("", "pulumi-state-move-")
+	if err != nil {
+		return errors.Wrap(err, "staging destination state")
+	}
+	stagedPath := staged.Name()
+	err = json.NewEncoder(staged).Encode(destDep)
+	if closeErr := staged.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		contract.IgnoreError(os.Remove(stagedPath))
+		return errors.Wrap(err, "staging destination state")
+	}
+
+	if err = source.ImportDeployment(commandContext(), sourceDep); err != nil {
+		contract.IgnoreError(os.Remove(stagedPath))
+		return errors.Wrapf(err, "saving stack %v; neither stack was modified", source.Ref())
+	}
+	if err = dest.ImportDeployment(commandContext(), destDep); err != nil {
+		return errors.Wrapf(err, "saving stack %v; the resources were removed from stack %v but not added to it. "+
+			"To finish the move, run `pulumi stack import --stack %v --file %s`",
+			dest.Ref(), source.Ref(), dest.Ref(), stagedPath)
+	}
+
+	contract.IgnoreError(os.Remove(stagedPath))
+	return nil
+}
+
+// stackProject returns the project that the resources in the first snapshot with any resources belong to.
+func stackProject(snaps ...*deploy.Snapshot) tokens.PackageName {
+	for _, snap := range snaps {
+		if len(snap.Resources) != 0 {
+			return snap.Resources[0].URN.Project()
+		}
+	}
+	return ""
+}
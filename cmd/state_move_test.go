@@ -0,0 +1,111 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/apitype"
+	"github.com/pulumi/pulumi/pkg/backend"
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/tokens"
+)
+
+type testStackReference string
+
+func (r testStackReference) String() string     { return string(r) }
+func (r testStackReference) Name() tokens.QName { return tokens.QName(r) }
+
+func newMoveTestStack(name string, saved *[]string, err error) (*backend.MockStack, *apitype.UntypedDeployment) {
+	imported := &apitype.UntypedDeployment{}
+	return &backend.MockStack{
+		RefF: func() backend.StackReference { return testStackReference(name) },
+		ImportDeploymentF: func(ctx context.Context, deployment *apitype.UntypedDeployment) error {
+			*saved = append(*saved, name)
+			if err != nil {
+				return err
+			}
+			*imported = *deployment
+			return nil
+		},
+	}, imported
+}
+
+func newMoveTestSnapshot(stack tokens.QName, names ...string) *deploy.Snapshot {
+	var resources []*resource.State
+	for _, name := range names {
+		urn := resource.NewURN(stack, "proj", "", "pkg:m:typ", tokens.QName(name))
+		resources = append(resources, &resource.State{URN: urn, Type: "pkg:m:typ", Custom: true, ID: "id"})
+	}
+	return deploy.NewSnapshot(deploy.Manifest{}, nil, resources, nil)
+}
+
+func TestSaveMovedResources(t *testing.T) {
+	var saved []string
+	source, sourceImported := newMoveTestStack("source", &saved, nil)
+	dest, destImported := newMoveTestStack("dest", &saved, nil)
+
+	err := saveMovedResources(source, dest, newMoveTestSnapshot("source", "a"), newMoveTestSnapshot("dest", "b"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"source", "dest"}, saved)
+	assert.Contains(t, string(sourceImported.Deployment), "::a")
+	assert.Contains(t, string(destImported.Deployment), "::b")
+}
+
+func TestSaveMovedResourcesSourceFailure(t *testing.T) {
+	var saved []string
+	source, _ := newMoveTestStack("source", &saved, errors.New("injected failure"))
+	dest, _ := newMoveTestStack("dest", &saved, nil)
+
+	err := saveMovedResources(source, dest, newMoveTestSnapshot("source", "a"), newMoveTestSnapshot("dest", "b"))
+	assert.EqualError(t, err, "saving stack source; neither stack was modified: injected failure")
+	assert.Equal(t, []string{"source"}, saved)
+}
+
+func TestSaveMovedResourcesDestFailure(t *testing.T) {
+	var saved []string
+	source, _ := newMoveTestStack("source", &saved, nil)
+	dest, _ := newMoveTestStack("dest", &saved, errors.New("injected failure"))
+
+	destSnap := newMoveTestSnapshot("dest", "b")
+	err := saveMovedResources(source, dest, newMoveTestSnapshot("source", "a"), destSnap)
+	assert.Error(t, err)
+	assert.Equal(t, []string{"source", "dest"}, saved)
+
+	// The error must explain how to finish the move, and the staged file must hold the destination's new state.
+	m := regexp.MustCompile("`pulumi stack import --stack dest --file (.*)`").FindStringSubmatch(err.Error())
+	if !assert.Len(t, m, 2, err.Error()) {
+		return
+	}
+	defer func() { _ = os.Remove(m[1]) }()
+
+	bytes, err := ioutil.ReadFile(m[1])
+	assert.NoError(t, err)
+	var staged apitype.UntypedDeployment
+	assert.NoError(t, json.Unmarshal(bytes, &staged))
+	expected, err := serializeSnapshot(destSnap)
+	assert.NoError(t, err)
+	assert.Equal(t, expected.Version, staged.Version)
+	assert.JSONEq(t, string(expected.Deployment), string(staged.Deployment))
+}
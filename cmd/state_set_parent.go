@@ -0,0 +1,77 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/resource/edit"
+	"github.com/pulumi/pulumi/pkg/util/cmdutil"
+	"github.com/pulumi/pulumi/pkg/util/result"
+)
+
+func newStateSetParentCommand() *cobra.Command {
+	var stack string
+	var yes bool
+
+	cmd := &cobra.Command{
+		Use:   "set-parent <resource URN> [parent URN]",
+		Short: "Changes the parent of a resource in a stack's state",
+		Long: `Changes the parent of a resource in a stack's state
+
+This command changes the parent of a resource in a stack's state. Because a resource's URN includes the types of
+its parents, the URNs of the resource and all of its descendants change as well; every reference to them held by
+other resources in the stack is updated accordingly. If no parent is given, the resource is parented to the stack.
+
+This is useful when a resource has been moved into or out of a component in a program, and can be used instead of
+adding an alias to it.
+
+Make sure that URNs are single-quoted to avoid having characters unexpectedly interpreted by the shell.
+
+Example:
+pulumi state set-parent 'urn:pulumi:stage::demo::aws:s3/bucket:Bucket::logs' \
+    'urn:pulumi:stage::demo::my:index:Logging::logging'
+`,
+		Args: cmdutil.RangeArgs(1, 2),
+		Run: cmdutil.RunResultFunc(func(cmd *cobra.Command, args []string) result.Result {
+			urn := resource.URN(args[0])
+			var parent resource.URN
+			if len(args) > 1 {
+				parent = resource.URN(args[1])
+			}
+			// Show the confirmation prompt if the user didn't pass the --yes parameter to skip it.
+			showPrompt := !yes
+
+			res := runStateEdit(stack, showPrompt, urn, func(snap *deploy.Snapshot, res *resource.State) error {
+				return edit.SetParent(snap, res, parent)
+			})
+			if res != nil {
+				return res
+			}
+			fmt.Println("Resource parent changed successfully")
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().StringVarP(
+		&stack, "stack", "s", "",
+		"The name of the stack to operate on. Defaults to the current stack")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Skip confirmation prompts")
+	return cmd
+}
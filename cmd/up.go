@@ -71,6 +71,7 @@ func newUpCmd() *cobra.Command {
 	var replaces []string
 	var targetReplaces []string
 	var targetDependents bool
+	var planFilePath string
 
 	// up implementation used when the source of the Pulumi program is in the current working directory.
 	upWorkingDirectory := func(opts backend.UpdateOptions) result.Result {
@@ -119,6 +120,13 @@ func newUpCmd() *cobra.Command {
 			replaceURNs = append(replaceURNs, resource.URN(tr))
 		}
 
+		var plan *deploy.UpdatePlan
+		if planFilePath != "" {
+			if plan, err = readUpdatePlan(planFilePath, sm); err != nil {
+				return result.FromError(errors.Wrap(err, "reading update plan"))
+			}
+		}
+
 		opts.Engine = engine.UpdateOptions{
 			LocalPolicyPackPaths: policyPackPaths,
 			Parallel:             parallel,
@@ -128,6 +136,7 @@ func newUpCmd() *cobra.Command {
 			UseLegacyDiff:        useLegacyDiff(),
 			UpdateTargets:        targetURNs,
 			TargetDependents:     targetDependents,
+			Plan:                 plan,
 		}
 
 		changes, res := s.Update(commandContext(), backend.UpdateOperation{
@@ -328,7 +337,11 @@ func newUpCmd() *cobra.Command {
 			"afterwards so that the stack may be updated incrementally again later on.\n" +
 			"\n" +
 			"The program to run is loaded from the project in the current directory by default. Use the `-C` or\n" +
-			"`--cwd` flag to use a different directory.",
+			"`--cwd` flag to use a different directory.\n" +
+			"\n" +
+			"Use the `--plan` flag to constrain the update to the steps saved by `pulumi preview --save-plan`.\n" +
+			"The update fails without performing any step that the plan does not permit, such as changing a\n" +
+			"resource that the plan left alone or setting an input to a value other than the planned one.",
 		Args: cmdutil.MaximumNArgs(1),
 		Run: cmdutil.RunResultFunc(func(cmd *cobra.Command, args []string) result.Result {
 			interactive := cmdutil.Interactive()
@@ -360,6 +373,9 @@ func newUpCmd() *cobra.Command {
 			}
 
 			if len(args) > 0 {
+				if planFilePath != "" {
+					return result.Error("an update plan cannot be used when creating a stack from a template")
+				}
 				return upTemplateNameOrURL(args[0], opts)
 			}
 
@@ -387,7 +403,7 @@ func newUpCmd() *cobra.Command {
 		"Config keys contain a path to a property in a map or list to set")
 	cmd.PersistentFlags().StringVar(
 		&secretsProvider, "secrets-provider", "default", "The type of the provider that should be used to encrypt and "+
-			"decrypt secrets (possible choices: default, passphrase, awskms, azurekeyvault, gcpkms, hashivault). Only"+
+			"decrypt secrets (possible choices: default, passphrase, age, awskms, azurekeyvault, gcpkms, hashivault). Only"+
 			"used when creating a new stack from an existing template")
 
 	cmd.PersistentFlags().StringVarP(
@@ -408,6 +424,9 @@ func newUpCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(
 		&targetDependents, "target-dependents", false,
 		"Allows updating of dependent targets discovered but not specified in --target list")
+	cmd.PersistentFlags().StringVar(
+		&planFilePath, "plan", "",
+		"Only perform the steps permitted by the update plan in the given file, saved by `pulumi preview --save-plan`")
 
 	// Flags for engine.UpdateOptions.
 	if hasDebugCommands() || hasExperimentalCommands() {
@@ -121,6 +121,10 @@ func createStack(
 		if _, pharseErr := newPassphraseSecretsManager(stackRef.Name(), stackConfigFile); pharseErr != nil {
 			return nil, pharseErr
 		}
+	} else if isAgeSecretsProvider(secretsProvider) {
+		if _, ageErr := newAgeSecretsManager(stackRef.Name(), stackConfigFile, secretsProvider); ageErr != nil {
+			return nil, ageErr
+		}
 	} else if !isDefaultSecretsProvider {
 		// All other non-default secrets providers are handled by the cloud secrets provider which
 		// uses a URL schema to identify the provider
@@ -58,6 +58,19 @@ type PolicyEvent struct {
 	EnforcementLevel string `json:"enforcementLevel"`
 }
 
+// PolicyRemediationEvent is emitted whenever a Policy transforms a resource's inputs.
+type PolicyRemediationEvent struct {
+	ResourceURN       string `json:"resourceUrn,omitempty"`
+	Message           string `json:"message"`
+	Color             string `json:"color"`
+	PolicyName        string `json:"policyName"`
+	PolicyPackName    string `json:"policyPackName"`
+	PolicyPackVersion string `json:"policyPackVersion"`
+
+	// Keys are the top-level properties that the remediation changed.
+	Keys []string `json:"keys,omitempty"`
+}
+
 // PreludeEvent is emitted at the start of an update.
 type PreludeEvent struct {
 	// Config contains the keys and values for the update.
@@ -193,15 +206,16 @@ type EngineEvent struct {
 	// Timestamp is a Unix timestamp (seconds) of when the event was emitted.
 	Timestamp int `json:"timestamp"`
 
-	CancelEvent      *CancelEvent       `json:"cancelEvent,omitempty"`
-	StdoutEvent      *StdoutEngineEvent `json:"stdoutEvent,omitempty"`
-	DiagnosticEvent  *DiagnosticEvent   `json:"diagnosticEvent,omitempty"`
-	PreludeEvent     *PreludeEvent      `json:"preludeEvent,omitempty"`
-	SummaryEvent     *SummaryEvent      `json:"summaryEvent,omitempty"`
-	ResourcePreEvent *ResourcePreEvent  `json:"resourcePreEvent,omitempty"`
-	ResOutputsEvent  *ResOutputsEvent   `json:"resOutputsEvent,omitempty"`
-	ResOpFailedEvent *ResOpFailedEvent  `json:"resOpFailedEvent,omitempty"`
-	PolicyEvent      *PolicyEvent       `json:"policyEvent,omitempty"`
+	CancelEvent            *CancelEvent            `json:"cancelEvent,omitempty"`
+	StdoutEvent            *StdoutEngineEvent      `json:"stdoutEvent,omitempty"`
+	DiagnosticEvent        *DiagnosticEvent        `json:"diagnosticEvent,omitempty"`
+	PreludeEvent           *PreludeEvent           `json:"preludeEvent,omitempty"`
+	SummaryEvent           *SummaryEvent           `json:"summaryEvent,omitempty"`
+	ResourcePreEvent       *ResourcePreEvent       `json:"resourcePreEvent,omitempty"`
+	ResOutputsEvent        *ResOutputsEvent        `json:"resOutputsEvent,omitempty"`
+	ResOpFailedEvent       *ResOpFailedEvent       `json:"resOpFailedEvent,omitempty"`
+	PolicyEvent            *PolicyEvent            `json:"policyEvent,omitempty"`
+	PolicyRemediationEvent *PolicyRemediationEvent `json:"policyRemediationEvent,omitempty"`
 }
 
 // EngineEventBatch is a group of engine events.
@@ -33,6 +33,8 @@ const (
 	DestroyUpdate UpdateKind = "destroy"
 	// ImportUpdate is an update that entails importing a raw checkpoint file.
 	ImportUpdate UpdateKind = "import"
+	// ResourceImportUpdate is an update that adopts existing cloud resources into a stack.
+	ResourceImportUpdate UpdateKind = "resource-import"
 )
 
 // UpdateResult is an enum for the result of the update.
@@ -0,0 +1,47 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apitype
+
+import (
+	"github.com/pulumi/pulumi/pkg/resource"
+)
+
+const (
+	// UpdatePlanSchemaVersionCurrent is the current version of the `UpdatePlan` schema.
+	// Any update plans newer than this version will be rejected.
+	UpdatePlanSchemaVersionCurrent = 1
+)
+
+// UpdatePlanV1 is the serialized form of an update plan: the steps that a preview planned to perform, which constrain
+// the steps that a later update may perform.
+type UpdatePlanV1 struct {
+	// Version is the version of the update plan schema.
+	Version int `json:"version"`
+	// ResourcePlans contains the planned steps for each resource, keyed by URN.
+	ResourcePlans map[resource.URN]ResourcePlanV1 `json:"resourcePlans,omitempty"`
+}
+
+// ResourcePlanV1 is the serialized form of the steps that an update plan permits for a single resource.
+type ResourcePlanV1 struct {
+	// Ops contains the operations planned for the resource, in order.
+	Ops []OpType `json:"ops"`
+	// Olds contains the resource's inputs before the update, if it already existed.
+	Olds map[string]interface{} `json:"olds"`
+	// News contains the resource's planned inputs, if it is not being deleted. Unknown values are recorded using
+	// the same placeholder as in deployments.
+	News map[string]interface{} `json:"news"`
+	// Diffs contains the keys of the inputs that the plan expects to change.
+	Diffs []resource.PropertyKey `json:"diffs,omitempty"`
+}
@@ -62,11 +62,12 @@ var updateTextMap = map[apitype.UpdateKind]struct {
 	previewText string
 	text        string
 }{
-	apitype.PreviewUpdate: {"update", "Previewing"},
-	apitype.UpdateUpdate:  {"update", "Updating"},
-	apitype.RefreshUpdate: {"refresh", "Refreshing"},
-	apitype.DestroyUpdate: {"destroy", "Destroying"},
-	apitype.ImportUpdate:  {"import", "Importing"},
+	apitype.PreviewUpdate:        {"update", "Previewing"},
+	apitype.UpdateUpdate:         {"update", "Updating"},
+	apitype.RefreshUpdate:        {"refresh", "Refreshing"},
+	apitype.DestroyUpdate:        {"destroy", "Destroying"},
+	apitype.ImportUpdate:         {"import", "Importing"},
+	apitype.ResourceImportUpdate: {"import", "Importing"},
 }
 
 type response string
@@ -117,7 +118,7 @@ func PreviewThenPrompt(ctx context.Context, kind apitype.UpdateKind, stack Stack
 	}
 
 	// If there are no changes, or we're auto-approving or just previewing, we can skip the confirmation prompt.
-	if op.Opts.AutoApprove || kind == apitype.PreviewUpdate {
+	if op.Opts.AutoApprove || op.Opts.PreviewOnly || kind == apitype.PreviewUpdate {
 		close(eventsChannel)
 		return changes, nil
 	}
@@ -194,7 +195,7 @@ func PreviewThenPromptThenExecute(ctx context.Context, kind apitype.UpdateKind,
 
 	if !op.Opts.SkipPreview {
 		changes, res := PreviewThenPrompt(ctx, kind, stack, op, apply)
-		if res != nil || kind == apitype.PreviewUpdate {
+		if res != nil || op.Opts.PreviewOnly || kind == apitype.PreviewUpdate {
 			return changes, res
 		}
 	}
@@ -150,6 +150,8 @@ type Backend interface {
 	Refresh(ctx context.Context, stack Stack, op UpdateOperation) (engine.ResourceChanges, result.Result)
 	// Destroy destroys all of this stack's resources.
 	Destroy(ctx context.Context, stack Stack, op UpdateOperation) (engine.ResourceChanges, result.Result)
+	// Import adopts the existing cloud resources listed in the operation's Imports into the stack.
+	Import(ctx context.Context, stack Stack, op UpdateOperation) (engine.ResourceChanges, result.Result)
 	// Watch watches the project's working directory for changes and automatically updates the active stack.
 	Watch(ctx context.Context, stack Stack, op UpdateOperation) result.Result
 
@@ -180,7 +182,7 @@ type Backend interface {
 	CurrentUser() (string, error)
 }
 
-// UpdateOperation is a complete stack update operation (preview, update, refresh, or destroy).
+// UpdateOperation is a complete stack update operation (preview, update, refresh, destroy, or import).
 type UpdateOperation struct {
 	Proj               *workspace.Project
 	Root               string
@@ -189,6 +191,7 @@ type UpdateOperation struct {
 	SecretsManager     secrets.Manager
 	StackConfiguration StackConfiguration
 	Scopes             CancellationScopeSource
+	Imports            []deploy.Import // the resources to import, for import operations.
 }
 
 // QueryOperation configures a query operation.
@@ -218,6 +221,8 @@ type UpdateOptions struct {
 	AutoApprove bool
 	// SkipPreview, when true, causes the preview step to be skipped.
 	SkipPreview bool
+	// PreviewOnly, when true, causes only the preview step to be performed.
+	PreviewOnly bool
 }
 
 // QueryOptions configures a query to operate against a backend and the engine.
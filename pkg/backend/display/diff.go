@@ -115,6 +115,8 @@ func RenderDiffEvent(action apitype.UpdateKind, event engine.Event,
 		return renderDiffDiagEvent(event.Payload.(engine.DiagEventPayload), opts)
 	case engine.PolicyViolationEvent:
 		return renderDiffPolicyViolationEvent(event.Payload.(engine.PolicyViolationEventPayload), opts)
+	case engine.PolicyRemediationEvent:
+		return renderDiffPolicyRemediationEvent(event.Payload.(engine.PolicyRemediationEventPayload), opts)
 
 	default:
 		contract.Failf("unknown event type '%s'", event.Type)
@@ -133,6 +135,10 @@ func renderDiffPolicyViolationEvent(payload engine.PolicyViolationEventPayload,
 	return opts.Color.Colorize(payload.Prefix + payload.Message)
 }
 
+func renderDiffPolicyRemediationEvent(payload engine.PolicyRemediationEventPayload, opts Options) string {
+	return opts.Color.Colorize(payload.Prefix + payload.Message)
+}
+
 func renderStdoutColorEvent(payload engine.StdoutEventPayload, opts Options) string {
 	return opts.Color.Colorize(payload.Message)
 }
@@ -60,6 +60,25 @@ func ConvertEngineEvent(e engine.Event) (apitype.EngineEvent, error) {
 			EnforcementLevel:  string(p.EnforcementLevel),
 		}
 
+	case engine.PolicyRemediationEvent:
+		p, ok := e.Payload.(engine.PolicyRemediationEventPayload)
+		if !ok {
+			return apiEvent, eventTypePayloadMismatch
+		}
+		keys := make([]string, len(p.Keys))
+		for i, k := range p.Keys {
+			keys[i] = string(k)
+		}
+		apiEvent.PolicyRemediationEvent = &apitype.PolicyRemediationEvent{
+			ResourceURN:       string(p.ResourceURN),
+			Message:           p.Message,
+			Color:             string(p.Color),
+			PolicyName:        p.PolicyName,
+			PolicyPackName:    p.PolicyPackName,
+			PolicyPackVersion: p.PolicyPackVersion,
+			Keys:              keys,
+		}
+
 	case engine.PreludeEvent:
 		p, ok := e.Payload.(engine.PreludeEventPayload)
 		if !ok {
@@ -17,6 +17,7 @@ package display
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/pulumi/pulumi/pkg/apitype"
@@ -26,6 +27,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/resource"
 	"github.com/pulumi/pulumi/pkg/resource/config"
 	"github.com/pulumi/pulumi/pkg/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/resource/plugin"
 	"github.com/pulumi/pulumi/pkg/resource/stack"
 	"github.com/pulumi/pulumi/pkg/util/contract"
 	"github.com/pulumi/pulumi/pkg/util/logging"
@@ -85,7 +87,8 @@ func stateForJSONOutput(s *resource.State, opts Options) *resource.State {
 
 	return resource.NewState(s.Type, s.URN, s.Custom, s.Delete, s.ID, inputs,
 		outputs, s.Parent, s.Protect, s.External, s.Dependencies, s.InitErrors, s.Provider,
-		s.PropertyDependencies, s.PendingReplacement, s.AdditionalSecretOutputs, s.Aliases, &s.CustomTimeouts)
+		s.PropertyDependencies, s.PendingReplacement, s.AdditionalSecretOutputs, s.Aliases, &s.CustomTimeouts,
+		s.RetainOnDelete)
 }
 
 // ShowJSONEvents renders engine events from a preview into a well-formed JSON document. Note that this does not
@@ -122,6 +125,14 @@ func ShowJSONEvents(op string, action apitype.UpdateKind, events <-chan engine.E
 					Severity: p.Severity,
 				})
 			}
+		case engine.PolicyRemediationEvent:
+			// Report remediations as informational messages, so previews show which policies changed which inputs.
+			p := e.Payload.(engine.PolicyRemediationEventPayload)
+			digest.Diagnostics = append(digest.Diagnostics, previewDiagnostic{
+				URN:      p.ResourceURN,
+				Message:  colors.Never.Colorize(p.Prefix + p.Message),
+				Severity: diag.Info,
+			})
 		case engine.StdoutColorEvent:
 			// Append stdout events as informational messages, and elide all colorization.
 			p := e.Payload.(engine.StdoutEventPayload)
@@ -131,8 +142,10 @@ func ShowJSONEvents(op string, action apitype.UpdateKind, events <-chan engine.E
 			})
 		case engine.ResourcePreEvent:
 			// Create the detailed metadata for this step and the initial state of its resource. Later,
-			// if new outputs arrive, we'll search for and swap in those new values.
-			if m := e.Payload.(engine.ResourcePreEventPayload).Metadata; shouldShow(m, opts) || isRootStack(m) {
+			// if new outputs arrive, we'll search for and swap in those new values. Refresh steps are
+			// recorded once their outputs arrive, as only then is the difference they found known.
+			m := e.Payload.(engine.ResourcePreEventPayload).Metadata
+			if m.Op != deploy.OpRefresh && (shouldShow(m, opts) || isRootStack(m)) {
 				var detailedDiff map[string]propertyDiff
 				if m.DetailedDiff != nil {
 					detailedDiff = make(map[string]propertyDiff)
@@ -143,41 +156,30 @@ func ShowJSONEvents(op string, action apitype.UpdateKind, events <-chan engine.E
 						}
 					}
 				}
-
-				step := &previewStep{
-					Op:             m.Op,
-					URN:            m.URN,
-					Provider:       m.Provider,
-					DiffReasons:    m.Diffs,
-					ReplaceReasons: m.Keys,
-					DetailedDiff:   detailedDiff,
-				}
-
-				if m.Old != nil {
-					oldState := stateForJSONOutput(m.Old.State, opts)
-					res, err := stack.SerializeResource(oldState, config.NewPanicCrypter())
-					if err == nil {
-						step.OldState = &res
-					} else {
-						logging.V(7).Infof("not adding old state as there was an error serialzing: %s", err)
-					}
-				}
-				if m.New != nil {
-					newState := stateForJSONOutput(m.New.State, opts)
-					res, err := stack.SerializeResource(newState, config.NewPanicCrypter())
-					if err == nil {
-						step.NewState = &res
-					} else {
-						logging.V(7).Infof("not adding new state as there was an error serialzing: %s", err)
+				digest.Steps = append(digest.Steps, newPreviewStep(m, m.Diffs, detailedDiff, opts))
+			}
+		case engine.ResourceOutputsEvent:
+			// Because we are only JSON serializing previews, we don't need to worry about outputs resolving,
+			// except for refreshes, whose outputs record the resource's live state. In that case, the step's
+			// operation reflects the difference between the recorded and live states, and the detailed diff
+			// describes the outputs that differ.
+			m := e.Payload.(engine.ResourceOutputsEventPayload).Metadata
+			if action == apitype.RefreshUpdate && (m.Op != deploy.OpSame || opts.ShowSameResources) {
+				var diffs []resource.PropertyKey
+				var detailedDiff map[string]propertyDiff
+				if m.Old != nil && m.New != nil {
+					if diff := m.Old.Outputs.Diff(m.New.Outputs); diff != nil {
+						diffs = diff.Keys()
+						detailedDiff = make(map[string]propertyDiff)
+						addObjectDiffs(detailedDiff, "", diff)
 					}
 				}
-
-				digest.Steps = append(digest.Steps, step)
+				digest.Steps = append(digest.Steps, newPreviewStep(m, diffs, detailedDiff, opts))
 			}
-		case engine.ResourceOutputsEvent, engine.ResourceOperationFailed:
-			// Because we are only JSON serializing previews, we don't need to worry about outputs
-			// resolving or operations failing. In the future, if we serialize actual deployments, we will
-			// need to come up with a scheme for matching the failure to the associated step.
+		case engine.ResourceOperationFailed:
+			// Because we are only JSON serializing previews, we don't need to worry about operations failing.
+			// In the future, if we serialize actual deployments, we will need to come up with a scheme for
+			// matching the failure to the associated step.
 
 		// Events ocurring late:
 		case engine.SummaryEvent:
@@ -197,6 +199,94 @@ func ShowJSONEvents(op string, action apitype.UpdateKind, events <-chan engine.E
 	fmt.Println(string(out))
 }
 
+// newPreviewStep creates the JSON-serializable form of the given step.
+func newPreviewStep(m engine.StepEventMetadata, diffs []resource.PropertyKey,
+	detailedDiff map[string]propertyDiff, opts Options) *previewStep {
+
+	step := &previewStep{
+		Op:             m.Op,
+		URN:            m.URN,
+		Provider:       m.Provider,
+		DiffReasons:    diffs,
+		ReplaceReasons: m.Keys,
+		DetailedDiff:   detailedDiff,
+	}
+
+	if m.Old != nil {
+		oldState := stateForJSONOutput(m.Old.State, opts)
+		res, err := stack.SerializeResource(oldState, config.NewPanicCrypter())
+		if err == nil {
+			step.OldState = &res
+		} else {
+			logging.V(7).Infof("not adding old state as there was an error serialzing: %s", err)
+		}
+	}
+	if m.New != nil {
+		newState := stateForJSONOutput(m.New.State, opts)
+		res, err := stack.SerializeResource(newState, config.NewPanicCrypter())
+		if err == nil {
+			step.NewState = &res
+		} else {
+			logging.V(7).Infof("not adding new state as there was an error serialzing: %s", err)
+		}
+	}
+
+	return step
+}
+
+// addObjectDiffs adds an entry to the given detailed diff for each property that differs in the given object diff,
+// using the same property paths as provider detailed diffs.
+func addObjectDiffs(detailedDiff map[string]propertyDiff, prefix string, diff *resource.ObjectDiff) {
+	for k := range diff.Adds {
+		detailedDiff[propertyPath(prefix, string(k))] = propertyDiff{Kind: plugin.DiffAdd.String()}
+	}
+	for k := range diff.Deletes {
+		detailedDiff[propertyPath(prefix, string(k))] = propertyDiff{Kind: plugin.DiffDelete.String()}
+	}
+	for k, update := range diff.Updates {
+		addValueDiffs(detailedDiff, propertyPath(prefix, string(k)), update)
+	}
+}
+
+// addArrayDiffs adds an entry to the given detailed diff for each element that differs in the given array diff.
+func addArrayDiffs(detailedDiff map[string]propertyDiff, prefix string, diff *resource.ArrayDiff) {
+	for i := range diff.Adds {
+		detailedDiff[fmt.Sprintf("%s[%d]", prefix, i)] = propertyDiff{Kind: plugin.DiffAdd.String()}
+	}
+	for i := range diff.Deletes {
+		detailedDiff[fmt.Sprintf("%s[%d]", prefix, i)] = propertyDiff{Kind: plugin.DiffDelete.String()}
+	}
+	for i, update := range diff.Updates {
+		addValueDiffs(detailedDiff, fmt.Sprintf("%s[%d]", prefix, i), update)
+	}
+}
+
+// addValueDiffs adds the differences within the given value diff to the given detailed diff, recursing into objects
+// and arrays.
+func addValueDiffs(detailedDiff map[string]propertyDiff, path string, diff resource.ValueDiff) {
+	switch {
+	case diff.Object != nil:
+		addObjectDiffs(detailedDiff, path, diff.Object)
+	case diff.Array != nil:
+		addArrayDiffs(detailedDiff, path, diff.Array)
+	default:
+		detailedDiff[path] = propertyDiff{Kind: plugin.DiffUpdate.String()}
+	}
+}
+
+// propertyPath appends the given key to the given property path, quoting it if it is not a simple name.
+func propertyPath(prefix, key string) string {
+	if !simplePropertyKeyRegexp.MatchString(key) {
+		return fmt.Sprintf("%s[%q]", prefix, key)
+	}
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
+
+var simplePropertyKeyRegexp = regexp.MustCompile(`^[a-zA-Z_$][a-zA-Z0-9_$]*$`)
+
 // previewDigest is a JSON-serializable overview of a preview operation.
 type previewDigest struct {
 	// Config contains a map of configuration keys/values used during the preview. Any secrets will be blinded.
@@ -0,0 +1,63 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package display
+
+import (
+	"testing"
+
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefreshDetailedDiff(t *testing.T) {
+	olds := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"same":    "value",
+		"changed": "old",
+		"deleted": 1,
+		"nested": map[string]interface{}{
+			"a":       "old",
+			"b-quote": "old",
+		},
+		"list": []interface{}{"x", "y"},
+	})
+	news := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"same":    "value",
+		"changed": "new",
+		"added":   true,
+		"nested": map[string]interface{}{
+			"a":       "new",
+			"b-quote": "new",
+		},
+		"list": []interface{}{"x", "z", "w"},
+	})
+
+	detailedDiff := make(map[string]propertyDiff)
+	addObjectDiffs(detailedDiff, "", olds.Diff(news))
+	assert.Equal(t, map[string]propertyDiff{
+		"changed":           {Kind: "update"},
+		"deleted":           {Kind: "delete"},
+		"added":             {Kind: "add"},
+		"nested.a":          {Kind: "update"},
+		`nested["b-quote"]`: {Kind: "update"},
+		"list[1]":           {Kind: "update"},
+		"list[2]":           {Kind: "add"},
+	}, detailedDiff)
+
+	// Every path must be parseable in the same way as the paths in provider detailed diffs.
+	for path := range detailedDiff {
+		_, err := resource.ParsePropertyPath(path)
+		assert.NoError(t, err, path)
+	}
+}
@@ -192,6 +192,8 @@ func getEventUrnAndMetadata(event engine.Event) (resource.URN, *engine.StepEvent
 		return event.Payload.(engine.DiagEventPayload).URN, nil
 	} else if event.Type == engine.PolicyViolationEvent {
 		return event.Payload.(engine.PolicyViolationEventPayload).ResourceURN, nil
+	} else if event.Type == engine.PolicyRemediationEvent {
+		return event.Payload.(engine.PolicyRemediationEventPayload).ResourceURN, nil
 	}
 
 	return "", nil
@@ -957,6 +959,9 @@ func (display *ProgressDisplay) processNormalEvent(event engine.Event) {
 	} else if event.Type == engine.PolicyViolationEvent {
 		// also record this policy violation so we print it at the end.
 		row.RecordPolicyViolationEvent(event)
+	} else if event.Type == engine.PolicyRemediationEvent {
+		// also record this policy remediation so we print it at the end.
+		row.RecordPolicyRemediationEvent(event)
 	} else {
 		contract.Failf("Unhandled event type '%s'", event.Type)
 	}
@@ -1078,7 +1083,7 @@ func (display *ProgressDisplay) getStepDoneDescription(step engine.StepEventMeta
 				return "reading failed"
 			case deploy.OpRefresh:
 				return "refreshing failed"
-			case deploy.OpReadDiscard, deploy.OpDiscardReplaced:
+			case deploy.OpReadDiscard, deploy.OpDiscardReplaced, deploy.OpRetainDiscard:
 				return "discarding failed"
 			case deploy.OpImport, deploy.OpImportReplacement:
 				return "importing failed"
@@ -1106,7 +1111,7 @@ func (display *ProgressDisplay) getStepDoneDescription(step engine.StepEventMeta
 				return "read for replacement"
 			case deploy.OpRefresh:
 				return "refresh"
-			case deploy.OpReadDiscard:
+			case deploy.OpReadDiscard, deploy.OpRetainDiscard:
 				return "discarded"
 			case deploy.OpDiscardReplaced:
 				return "discarded original"
@@ -1151,7 +1156,7 @@ func (display *ProgressDisplay) getPreviewText(step engine.StepEventMetadata) st
 		return "read for replacement"
 	case deploy.OpRefresh:
 		return "refreshing"
-	case deploy.OpReadDiscard:
+	case deploy.OpReadDiscard, deploy.OpRetainDiscard:
 		return "discard"
 	case deploy.OpDiscardReplaced:
 		return "discard original"
@@ -1185,7 +1190,7 @@ func (display *ProgressDisplay) getPreviewDoneText(step engine.StepEventMetadata
 		return "read"
 	case deploy.OpRefresh:
 		return "refresh"
-	case deploy.OpReadDiscard:
+	case deploy.OpReadDiscard, deploy.OpRetainDiscard:
 		return "discard"
 	case deploy.OpImport, deploy.OpImportReplacement:
 		return "import"
@@ -1259,7 +1264,7 @@ func (display *ProgressDisplay) getStepInProgressDescription(step engine.StepEve
 			return "reading for replacement"
 		case deploy.OpRefresh:
 			return "refreshing"
-		case deploy.OpReadDiscard:
+		case deploy.OpReadDiscard, deploy.OpRetainDiscard:
 			return "discarding"
 		case deploy.OpDiscardReplaced:
 			return "discarding original"
@@ -60,6 +60,7 @@ type ResourceRow interface {
 	DiagInfo() *DiagInfo
 	RecordDiagEvent(diagEvent engine.Event)
 	RecordPolicyViolationEvent(diagEvent engine.Event)
+	RecordPolicyRemediationEvent(diagEvent engine.Event)
 }
 
 // Implementation of a Row, used for the header of the grid.
@@ -242,6 +243,19 @@ func (data *resourceRowData) RecordPolicyViolationEvent(event engine.Event) {
 	data.recordDiagEventPayload(payload)
 }
 
+func (data *resourceRowData) RecordPolicyRemediationEvent(event engine.Event) {
+	// As with policy violations, convert the remediation into an informational DiagEvent so it can be displayed.
+	prPayload := event.Payload.(engine.PolicyRemediationEventPayload)
+
+	data.recordDiagEventPayload(engine.DiagEventPayload{
+		URN:      prPayload.ResourceURN,
+		Prefix:   prPayload.Prefix,
+		Message:  prPayload.Message,
+		Color:    prPayload.Color,
+		Severity: diag.Info,
+	})
+}
+
 type column int
 
 const (
@@ -59,6 +59,10 @@ func ShowWatchEvents(op string, action apitype.UpdateKind, events <-chan engine.
 			}
 			PrintfWithWatchPrefix(time.Now(), resourceName,
 				"%s", renderDiffDiagEvent(p, opts))
+		case engine.PolicyRemediationEvent:
+			p := e.Payload.(engine.PolicyRemediationEventPayload)
+			PrintfWithWatchPrefix(time.Now(), string(p.ResourceURN.Name()),
+				"%s", renderDiffPolicyRemediationEvent(p, opts))
 		case engine.ResourcePreEvent:
 			p := e.Payload.(engine.ResourcePreEventPayload)
 			if shouldShow(p.Metadata, opts) {
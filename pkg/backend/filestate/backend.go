@@ -24,9 +24,11 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
 	"gocloud.dev/blob"
 	_ "gocloud.dev/blob/azureblob" // driver for azblob://
 	_ "gocloud.dev/blob/fileblob"  // driver for file://
@@ -58,6 +60,9 @@ import (
 type Backend interface {
 	backend.Backend
 	local() // at the moment, no local specific info, so just use a marker function.
+
+	// CancelCurrentUpdate breaks any locks held on the given stack by in-progress or abandoned updates.
+	CancelCurrentUpdate(ctx context.Context, stackRef backend.StackReference) error
 }
 
 type localBackend struct {
@@ -70,6 +75,13 @@ type localBackend struct {
 	url         string
 
 	bucket Bucket
+
+	// lockID uniquely identifies the locks taken by this backend, so that it can tell its own locks apart from
+	// those held by other processes.
+	lockID string
+
+	locksLock sync.Mutex           // protects locks.
+	locks     map[tokens.QName]int // the number of outstanding calls to Lock for each stack this backend has locked.
 }
 
 type localBackendReference struct {
@@ -131,6 +143,8 @@ func New(d diag.Sink, originalURL string) (Backend, error) {
 		originalURL: originalURL,
 		url:         u,
 		bucket:      &wrappedBucket{bucket: bucket},
+		lockID:      uuid.NewV4().String(),
+		locks:       make(map[tokens.QName]int),
 	}, nil
 }
 
@@ -380,19 +394,49 @@ func (b *localBackend) Preview(ctx context.Context, stack backend.Stack,
 
 func (b *localBackend) Update(ctx context.Context, stack backend.Stack,
 	op backend.UpdateOperation) (engine.ResourceChanges, result.Result) {
+	if err := b.Lock(ctx, stack.Ref()); err != nil {
+		return nil, result.FromError(err)
+	}
+	defer b.Unlock(ctx, stack.Ref())
+
 	return backend.PreviewThenPromptThenExecute(ctx, apitype.UpdateUpdate, stack, op, b.apply)
 }
 
 func (b *localBackend) Refresh(ctx context.Context, stack backend.Stack,
 	op backend.UpdateOperation) (engine.ResourceChanges, result.Result) {
+	// A preview-only refresh (e.g. `pulumi drift`) never writes the checkpoint, so it just reads the stack's snapshot
+	// and neither takes nor waits on the stack's lock.
+	if !op.Opts.PreviewOnly {
+		if err := b.Lock(ctx, stack.Ref()); err != nil {
+			return nil, result.FromError(err)
+		}
+		defer b.Unlock(ctx, stack.Ref())
+	}
+
 	return backend.PreviewThenPromptThenExecute(ctx, apitype.RefreshUpdate, stack, op, b.apply)
 }
 
 func (b *localBackend) Destroy(ctx context.Context, stack backend.Stack,
 	op backend.UpdateOperation) (engine.ResourceChanges, result.Result) {
+	if err := b.Lock(ctx, stack.Ref()); err != nil {
+		return nil, result.FromError(err)
+	}
+	defer b.Unlock(ctx, stack.Ref())
+
 	return backend.PreviewThenPromptThenExecute(ctx, apitype.DestroyUpdate, stack, op, b.apply)
 }
 
+func (b *localBackend) Import(ctx context.Context, stack backend.Stack,
+	op backend.UpdateOperation) (engine.ResourceChanges, result.Result) {
+
+	if err := b.Lock(ctx, stack.Ref()); err != nil {
+		return nil, result.FromError(err)
+	}
+	defer b.Unlock(ctx, stack.Ref())
+
+	return backend.PreviewThenPromptThenExecute(ctx, apitype.ResourceImportUpdate, stack, op, b.apply)
+}
+
 func (b *localBackend) Query(ctx context.Context, op backend.QueryOperation) result.Result {
 
 	return b.query(ctx, op, nil /*events*/)
@@ -474,6 +518,8 @@ func (b *localBackend) apply(
 		changes, updateRes = engine.Refresh(update, engineCtx, op.Opts.Engine, opts.DryRun)
 	case apitype.DestroyUpdate:
 		changes, updateRes = engine.Destroy(update, engineCtx, op.Opts.Engine, opts.DryRun)
+	case apitype.ResourceImportUpdate:
+		changes, updateRes = engine.Import(update, engineCtx, op.Opts.Engine, op.Imports, opts.DryRun)
 	default:
 		contract.Failf("Unrecognized update kind: %s", kind)
 	}
@@ -632,6 +678,11 @@ func (b *localBackend) ExportDeployment(ctx context.Context,
 func (b *localBackend) ImportDeployment(ctx context.Context, stk backend.Stack,
 	deployment *apitype.UntypedDeployment) error {
 
+	if err := b.Lock(ctx, stk.Ref()); err != nil {
+		return err
+	}
+	defer b.Unlock(ctx, stk.Ref())
+
 	stackName := stk.Ref().Name()
 	_, _, err := b.getStack(stackName)
 	if err != nil {
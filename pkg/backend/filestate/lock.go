@@ -0,0 +1,249 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/user"
+	"path"
+	"strings"
+	"time"
+
+	ps "github.com/mitchellh/go-ps"
+	"github.com/pkg/errors"
+	"gocloud.dev/gcerrors"
+
+	"github.com/pulumi/pulumi/pkg/backend"
+	"github.com/pulumi/pulumi/pkg/diag"
+	"github.com/pulumi/pulumi/pkg/tokens"
+	"github.com/pulumi/pulumi/pkg/util/contract"
+	"github.com/pulumi/pulumi/pkg/util/fsutil"
+	"github.com/pulumi/pulumi/pkg/util/logging"
+	"github.com/pulumi/pulumi/pkg/workspace"
+)
+
+// lockContent is the metadata written into a stack's lock file. It records who holds the lock so that other
+// processes can tell the user who they are waiting on, and so that stale locks can be detected.
+type lockContent struct {
+	Pid       int       `json:"pid"`
+	Username  string    `json:"username"`
+	Hostname  string    `json:"hostname"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func newLockContent() (*lockContent, error) {
+	u, err := user.Current()
+	if err != nil {
+		return nil, err
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+	return &lockContent{
+		Pid:       os.Getpid(),
+		Username:  u.Username,
+		Hostname:  hostname,
+		Timestamp: time.Now(),
+	}, nil
+}
+
+// String returns a human readable description of the lock's owner.
+func (l *lockContent) String() string {
+	return fmt.Sprintf("created by %v@%v (pid %v) at %v", l.Username, l.Hostname, l.Pid, l.Timestamp.Format(time.RFC3339))
+}
+
+// isStale returns true if the lock was taken by a process on this machine that is no longer running. Locks held by
+// other machines are never considered stale, since we have no way of knowing whether their owner is still alive.
+func (l *lockContent) isStale() bool {
+	hostname, err := os.Hostname()
+	if err != nil || hostname != l.Hostname {
+		return false
+	}
+	if l.Pid == os.Getpid() {
+		return false
+	}
+	proc, err := ps.FindProcess(l.Pid)
+	return err == nil && proc == nil
+}
+
+// lockDir returns the directory that holds the locks for all stacks.
+func (b *localBackend) lockDir() string {
+	return path.Join(b.StateDir(), workspace.LockDir)
+}
+
+// stackLockDir returns the directory that holds the locks for the given stack.
+func (b *localBackend) stackLockDir(stack tokens.QName) string {
+	contract.Require(stack != "", "stack")
+	return path.Join(b.lockDir(), fsutil.QnamePath(stack))
+}
+
+// lockPath returns the path of the lock file this backend writes when locking the given stack.
+func (b *localBackend) lockPath(stack tokens.QName) string {
+	return path.Join(b.stackLockDir(stack), b.lockID+".json")
+}
+
+// checkForLock returns an error if any process other than this one holds a lock on the given stack. Locks that are
+// found to be stale are removed.
+func (b *localBackend) checkForLock(ctx context.Context, stack tokens.QName) error {
+	files, err := listBucket(b.bucket, b.stackLockDir(stack))
+	if err != nil {
+		// The lock directory doesn't exist until a stack has been locked.
+		if gcerrors.Code(errors.Cause(err)) == gcerrors.NotFound {
+			return nil
+		}
+		return err
+	}
+
+	ours := b.lockPath(stack)
+	var held []string
+	for _, file := range files {
+		if file.IsDir || file.Key == ours {
+			continue
+		}
+
+		content, err := b.readLock(ctx, file.Key)
+		if err != nil {
+			return err
+		}
+		if content.isStale() {
+			b.d.Warningf(diag.Message("", "removing stale lock %v %v"), file.Key, content)
+			if err = b.bucket.Delete(ctx, file.Key); err != nil {
+				return errors.Wrapf(err, "removing stale lock %v", file.Key)
+			}
+			continue
+		}
+
+		held = append(held, fmt.Sprintf("  %v: %v", file.Key, content))
+	}
+
+	if len(held) > 0 {
+		return errors.Errorf("the stack is currently locked by %v lock(s). Either wait for the other "+
+			"process(es) to end or run `pulumi cancel` to break the lock(s).\n%v",
+			len(held), strings.Join(held, "\n"))
+	}
+	return nil
+}
+
+// readLock reads and decodes the lock file at the given key.
+func (b *localBackend) readLock(ctx context.Context, key string) (*lockContent, error) {
+	byts, err := b.bucket.ReadAll(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading lock %v", key)
+	}
+	var content lockContent
+	if err = json.Unmarshal(byts, &content); err != nil {
+		return nil, errors.Wrapf(err, "decoding lock %v", key)
+	}
+	return &content, nil
+}
+
+// Lock acquires a lock on the given stack, failing if another process already holds one. Locks are re-entrant: if this
+// backend already holds the lock, Lock succeeds immediately, and the lock is only released once every call to Lock
+// has been matched by a call to Unlock.
+func (b *localBackend) Lock(ctx context.Context, stackRef backend.StackReference) error {
+	stackName := stackRef.Name()
+
+	b.locksLock.Lock()
+	defer b.locksLock.Unlock()
+	if b.locks[stackName] > 0 {
+		b.locks[stackName]++
+		return nil
+	}
+
+	if err := b.checkForLock(ctx, stackName); err != nil {
+		return err
+	}
+
+	content, err := newLockContent()
+	if err != nil {
+		return err
+	}
+	byts, err := json.Marshal(content)
+	if err != nil {
+		return err
+	}
+	if err = b.bucket.WriteAll(ctx, b.lockPath(stackName), byts, nil); err != nil {
+		return errors.Wrap(err, "writing lock")
+	}
+
+	// Another process may have raced with us between the check above and our write; check again now that our own
+	// lock is visible, and back off if anyone else got there too.
+	if err = b.checkForLock(ctx, stackName); err != nil {
+		b.deleteLock(ctx, stackName)
+		return err
+	}
+
+	b.locks[stackName] = 1
+	logging.V(7).Infof("Acquired lock on stack %s: %s", stackName, b.lockPath(stackName))
+	return nil
+}
+
+// Unlock releases this process's lock on the given stack once it has been called as many times as Lock.
+func (b *localBackend) Unlock(ctx context.Context, stackRef backend.StackReference) {
+	stackName := stackRef.Name()
+
+	b.locksLock.Lock()
+	defer b.locksLock.Unlock()
+	if b.locks[stackName] > 1 {
+		b.locks[stackName]--
+		return
+	}
+	delete(b.locks, stackName)
+	b.deleteLock(ctx, stackName)
+}
+
+// deleteLock deletes this process's lock file for the given stack.
+func (b *localBackend) deleteLock(ctx context.Context, stackName tokens.QName) {
+	lock := b.lockPath(stackName)
+	if err := b.bucket.Delete(ctx, lock); err != nil {
+		b.d.Errorf(diag.Message("",
+			"there was a problem deleting the lock at %v, manual clean up may be required: %v"), lock, err)
+	}
+}
+
+// CancelCurrentUpdate breaks every lock held on the given stack, regardless of which process owns it.
+func (b *localBackend) CancelCurrentUpdate(ctx context.Context, stackRef backend.StackReference) error {
+	stackName := stackRef.Name()
+	files, err := listBucket(b.bucket, b.stackLockDir(stackName))
+	if err != nil {
+		if gcerrors.Code(errors.Cause(err)) == gcerrors.NotFound {
+			return errors.Errorf("stack %s is not locked", stackName)
+		}
+		return err
+	}
+
+	removed := 0
+	for _, file := range files {
+		if file.IsDir {
+			continue
+		}
+		if err = b.bucket.Delete(ctx, file.Key); err != nil {
+			return errors.Wrapf(err, "removing lock %v", file.Key)
+		}
+		removed++
+	}
+	if removed == 0 {
+		return errors.Errorf("stack %s is not locked", stackName)
+	}
+
+	b.locksLock.Lock()
+	delete(b.locks, stackName)
+	b.locksLock.Unlock()
+	return nil
+}
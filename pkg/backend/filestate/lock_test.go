@@ -0,0 +1,172 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/backend"
+	"github.com/pulumi/pulumi/pkg/backend/display"
+	"github.com/pulumi/pulumi/pkg/diag"
+	"github.com/pulumi/pulumi/pkg/diag/colors"
+	"github.com/pulumi/pulumi/pkg/engine"
+	"github.com/pulumi/pulumi/pkg/util/cancel"
+	"github.com/pulumi/pulumi/pkg/workspace"
+)
+
+func newTestBackend(t *testing.T, dir string) *localBackend {
+	sink := diag.DefaultSink(ioutil.Discard, ioutil.Discard, diag.FormatOptions{Color: colors.Raw})
+	b, err := New(sink, FilePathPrefix+dir)
+	assert.NoError(t, err)
+	return b.(*localBackend)
+}
+
+func TestLock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filestate-lock")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ctx := context.Background()
+	ref := localBackendReference{name: "dev"}
+	first, second := newTestBackend(t, dir), newTestBackend(t, dir)
+
+	// The first backend takes the lock, which blocks the second.
+	assert.NoError(t, first.Lock(ctx, ref))
+	assert.Error(t, second.Lock(ctx, ref))
+
+	// Once released, the second backend can take it.
+	first.Unlock(ctx, ref)
+	assert.NoError(t, second.Lock(ctx, ref))
+
+	// Cancelling breaks the lock no matter who holds it.
+	assert.NoError(t, first.CancelCurrentUpdate(ctx, ref))
+	assert.NoError(t, first.Lock(ctx, ref))
+	first.Unlock(ctx, ref)
+	assert.Error(t, first.CancelCurrentUpdate(ctx, ref))
+}
+
+func TestNestedLock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filestate-lock")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ctx := context.Background()
+	ref := localBackendReference{name: "dev"}
+	b, other := newTestBackend(t, dir), newTestBackend(t, dir)
+	s, err := b.CreateStack(ctx, ref, nil)
+	assert.NoError(t, err)
+	deployment, err := b.ExportDeployment(ctx, s)
+	assert.NoError(t, err)
+
+	// Importing a deployment while holding the stack's lock, as state edits do, takes the lock again.
+	assert.NoError(t, b.Lock(ctx, ref))
+	assert.NoError(t, b.ImportDeployment(ctx, s, deployment))
+
+	// The nested import must not release the outer lock.
+	assert.Error(t, other.Lock(ctx, ref))
+
+	// Releasing the outer lock does.
+	b.Unlock(ctx, ref)
+	assert.NoError(t, other.Lock(ctx, ref))
+	other.Unlock(ctx, ref)
+}
+
+func TestStaleLock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filestate-lock")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ctx := context.Background()
+	ref := localBackendReference{name: "dev"}
+	b := newTestBackend(t, dir)
+
+	// Write a lock on behalf of a process on this host that has since exited.
+	hostname, err := os.Hostname()
+	assert.NoError(t, err)
+	stale := &lockContent{Pid: 1 << 30, Username: "someone", Hostname: hostname, Timestamp: time.Now()}
+	assert.True(t, stale.isStale())
+	byts, err := json.Marshal(stale)
+	assert.NoError(t, err)
+	assert.NoError(t, b.bucket.WriteAll(ctx, b.stackLockDir(ref.name)+"/stale.json", byts, nil))
+
+	// The stale lock is cleared rather than blocking us.
+	assert.NoError(t, b.Lock(ctx, ref))
+	b.Unlock(ctx, ref)
+
+	// Locks from other hosts are never considered stale.
+	remote := &lockContent{Pid: 1 << 30, Username: "someone", Hostname: hostname + "-other", Timestamp: time.Now()}
+	assert.False(t, remote.isStale())
+}
+
+type testCancellationScope struct {
+	source *cancel.Source
+}
+
+func (s testCancellationScope) Context() *cancel.Context { return s.source.Context() }
+func (s testCancellationScope) Close()                   {}
+
+type testCancellationScopeSource struct{}
+
+func (testCancellationScopeSource) NewScope(events chan<- engine.Event, isPreview bool) backend.CancellationScope {
+	_, source := cancel.NewContext(context.Background())
+	return testCancellationScope{source: source}
+}
+
+func TestPreviewOnlyRefreshWhileLocked(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filestate-lock")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ctx := context.Background()
+	ref := localBackendReference{name: "dev"}
+	b, other := newTestBackend(t, dir), newTestBackend(t, dir)
+	s, err := b.CreateStack(ctx, ref, nil)
+	assert.NoError(t, err)
+
+	// Another process is in the middle of updating the stack.
+	assert.NoError(t, other.Lock(ctx, ref))
+	defer other.Unlock(ctx, ref)
+
+	op := backend.UpdateOperation{
+		Proj: &workspace.Project{Name: "test", Runtime: workspace.NewProjectRuntimeInfo("nodejs", nil)},
+		Root: dir,
+		M:    &backend.UpdateMetadata{},
+		Opts: backend.UpdateOptions{
+			Display: display.Options{Color: colors.Never, SuppressOutputs: true},
+		},
+		Scopes: testCancellationScopeSource{},
+	}
+
+	// A refresh that would write the checkpoint must wait for the lock...
+	_, res := b.Refresh(ctx, s, op)
+	if assert.NotNil(t, res) {
+		assert.Contains(t, res.Error().Error(), "the stack is currently locked")
+	}
+
+	// ...but a preview-only refresh, as run by `pulumi drift`, only reads the stack's snapshot.
+	op.Opts.PreviewOnly = true
+	_, res = b.Refresh(ctx, s, op)
+	assert.Nil(t, res)
+
+	// The other process's lock is left in place.
+	assert.Error(t, b.Lock(ctx, ref))
+}
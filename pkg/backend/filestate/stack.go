@@ -80,6 +80,10 @@ func (s *localStack) Destroy(ctx context.Context, op backend.UpdateOperation) (e
 	return backend.DestroyStack(ctx, s, op)
 }
 
+func (s *localStack) Import(ctx context.Context, op backend.UpdateOperation) (engine.ResourceChanges, result.Result) {
+	return backend.ImportStack(ctx, s, op)
+}
+
 func (s *localStack) Watch(ctx context.Context, op backend.UpdateOperation) result.Result {
 	return backend.WatchStack(ctx, s, op)
 }
@@ -717,6 +717,11 @@ func (b *cloudBackend) Destroy(ctx context.Context, stack backend.Stack,
 	return backend.PreviewThenPromptThenExecute(ctx, apitype.DestroyUpdate, stack, op, b.apply)
 }
 
+func (b *cloudBackend) Import(ctx context.Context, stack backend.Stack,
+	op backend.UpdateOperation) (engine.ResourceChanges, result.Result) {
+	return backend.PreviewThenPromptThenExecute(ctx, apitype.ResourceImportUpdate, stack, op, b.apply)
+}
+
 func (b *cloudBackend) Watch(ctx context.Context, stack backend.Stack,
 	op backend.UpdateOperation) result.Result {
 	return backend.Watch(ctx, b, stack, op, b.apply)
@@ -903,6 +908,8 @@ func (b *cloudBackend) runEngineAction(
 		changes, res = engine.Refresh(u, engineCtx, op.Opts.Engine, dryRun)
 	case apitype.DestroyUpdate:
 		changes, res = engine.Destroy(u, engineCtx, op.Opts.Engine, dryRun)
+	case apitype.ResourceImportUpdate:
+		changes, res = engine.Import(u, engineCtx, op.Opts.Engine, op.Imports, dryRun)
 	default:
 		contract.Failf("Unrecognized update kind: %s", kind)
 	}
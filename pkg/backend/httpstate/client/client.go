@@ -433,6 +433,8 @@ func (pc *Client) CreateUpdate(
 		endpoint = "refresh"
 	case apitype.DestroyUpdate:
 		endpoint = "destroy"
+	case apitype.ResourceImportUpdate:
+		endpoint = "import"
 	default:
 		contract.Failf("Unknown kind: %s", kind)
 	}
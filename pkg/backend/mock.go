@@ -61,6 +61,8 @@ type MockBackend struct {
 		UpdateOperation) (engine.ResourceChanges, result.Result)
 	DestroyF func(context.Context, Stack,
 		UpdateOperation) (engine.ResourceChanges, result.Result)
+	ImportF func(context.Context, Stack,
+		UpdateOperation) (engine.ResourceChanges, result.Result)
 	WatchF func(context.Context, Stack,
 		UpdateOperation) result.Result
 	GetLogsF func(context.Context, Stack, StackConfiguration,
@@ -191,6 +193,15 @@ func (be *MockBackend) Destroy(ctx context.Context, stack Stack,
 	panic("not implemented")
 }
 
+func (be *MockBackend) Import(ctx context.Context, stack Stack,
+	op UpdateOperation) (engine.ResourceChanges, result.Result) {
+
+	if be.ImportF != nil {
+		return be.ImportF(ctx, stack, op)
+	}
+	panic("not implemented")
+}
+
 func (be *MockBackend) Watch(ctx context.Context, stack Stack,
 	op UpdateOperation) result.Result {
 
@@ -296,6 +307,7 @@ type MockStack struct {
 	UpdateF   func(ctx context.Context, op UpdateOperation) (engine.ResourceChanges, result.Result)
 	RefreshF  func(ctx context.Context, op UpdateOperation) (engine.ResourceChanges, result.Result)
 	DestroyF  func(ctx context.Context, op UpdateOperation) (engine.ResourceChanges, result.Result)
+	ImportF   func(ctx context.Context, op UpdateOperation) (engine.ResourceChanges, result.Result)
 	WatchF    func(ctx context.Context, op UpdateOperation) result.Result
 	QueryF    func(ctx context.Context, op UpdateOperation) result.Result
 	RemoveF   func(ctx context.Context, force bool) (bool, error)
@@ -364,6 +376,13 @@ func (ms *MockStack) Destroy(ctx context.Context, op UpdateOperation) (engine.Re
 	panic("not implemented")
 }
 
+func (ms *MockStack) Import(ctx context.Context, op UpdateOperation) (engine.ResourceChanges, result.Result) {
+	if ms.ImportF != nil {
+		return ms.ImportF(ctx, op)
+	}
+	panic("not implemented")
+}
+
 func (ms *MockStack) Watch(ctx context.Context, op UpdateOperation) result.Result {
 	if ms.WatchF != nil {
 		return ms.WatchF(ctx, op)
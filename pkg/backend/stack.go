@@ -47,6 +47,8 @@ type Stack interface {
 	Refresh(ctx context.Context, op UpdateOperation) (engine.ResourceChanges, result.Result)
 	// Destroy this stack's resources.
 	Destroy(ctx context.Context, op UpdateOperation) (engine.ResourceChanges, result.Result)
+	// Import existing resources into this stack.
+	Import(ctx context.Context, op UpdateOperation) (engine.ResourceChanges, result.Result)
 	// Watch this stack.
 	Watch(ctx context.Context, op UpdateOperation) result.Result
 
@@ -92,6 +94,11 @@ func DestroyStack(ctx context.Context, s Stack, op UpdateOperation) (engine.Reso
 	return s.Backend().Destroy(ctx, s, op)
 }
 
+// ImportStack adopts existing cloud resources into the stack.
+func ImportStack(ctx context.Context, s Stack, op UpdateOperation) (engine.ResourceChanges, result.Result) {
+	return s.Backend().Import(ctx, s, op)
+}
+
 // WatchStack watches the projects working directory for changes and automatically updates the
 // active stack.
 func WatchStack(ctx context.Context, s Stack, op UpdateOperation) result.Result {
@@ -0,0 +1,385 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package importer generates the program code needed to keep managing resources that were imported into a stack.
+package importer
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/codegen/python"
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/deploy/providers"
+	"github.com/pulumi/pulumi/pkg/tokens"
+)
+
+// IsLanguageSupported returns true if code can be generated for the given project runtime.
+func IsLanguageSupported(runtime string) bool {
+	return runtime == "nodejs" || runtime == "python"
+}
+
+// GenerateProgram writes code that declares the given resources in the language used by the given project runtime.
+func GenerateProgram(w io.Writer, runtime string, resources []*resource.State) error {
+	switch runtime {
+	case "nodejs":
+		return GenerateTypeScript(w, resources)
+	case "python":
+		return GeneratePython(w, resources)
+	default:
+		return errors.Errorf("code generation is not supported for the %q runtime", runtime)
+	}
+}
+
+// GenerateTypeScript writes TypeScript code that declares the given resources.
+func GenerateTypeScript(w io.Writer, resources []*resource.State) error {
+	g := &generator{w: w}
+
+	resources, opts, err := resourceOptions(resources, camelName)
+	if err != nil {
+		return err
+	}
+
+	pkgs := packages(resources)
+	for _, pkg := range pkgs {
+		g.printf("import * as %s from \"@pulumi/%s\";\n", camelName(string(pkg)), pkg)
+	}
+	if len(pkgs) > 0 {
+		g.printf("\n")
+	}
+
+	for _, res := range resources {
+		g.printf("const %s = new %s(%q, ", camelName(string(res.URN.Name())), typeScriptType(res.Type),
+			string(res.URN.Name()))
+		g.genTypeScriptValue(resource.NewObjectProperty(res.Inputs), "")
+		if o := opts[res.URN]; o.parent != "" || o.provider != "" || res.Protect {
+			g.printf(", {\n")
+			if o.parent != "" {
+				g.printf("    parent: %s,\n", o.parent)
+			}
+			if o.provider != "" {
+				g.printf("    provider: %s,\n", o.provider)
+			}
+			if res.Protect {
+				g.printf("    protect: true,\n")
+			}
+			g.printf("}")
+		}
+		g.printf(");\n")
+	}
+	return g.err
+}
+
+// GeneratePython writes Python code that declares the given resources.
+func GeneratePython(w io.Writer, resources []*resource.State) error {
+	g := &generator{w: w}
+
+	resources, opts, err := resourceOptions(resources, func(name string) string {
+		return python.PyName(camelName(name))
+	})
+	if err != nil {
+		return err
+	}
+
+	g.printf("import pulumi\n")
+	for _, pkg := range packages(resources) {
+		g.printf("import %s\n", pythonModule(pkg))
+	}
+	g.printf("\n")
+
+	for _, res := range resources {
+		g.printf("%s = %s(%q", python.PyName(camelName(string(res.URN.Name()))), pythonType(res.Type),
+			string(res.URN.Name()))
+		for _, k := range res.Inputs.StableKeys() {
+			g.printf(",\n    %s=", python.PyName(string(k)))
+			g.genPythonValue(res.Inputs[k], "    ")
+		}
+		o, args := opts[res.URN], []string(nil)
+		if o.parent != "" {
+			args = append(args, "parent="+o.parent)
+		}
+		if o.provider != "" {
+			args = append(args, "provider="+o.provider)
+		}
+		if res.Protect {
+			args = append(args, "protect=True")
+		}
+		if len(args) > 0 {
+			g.printf(",\n    opts=pulumi.ResourceOptions(%s)", strings.Join(args, ", "))
+		}
+		g.printf(")\n")
+	}
+	return g.err
+}
+
+// options holds the names of the variables that refer to a resource's parent and provider, if it has them.
+type options struct {
+	parent   string
+	provider string
+}
+
+// resourceOptions returns the given resources ordered so that each is declared after its parent and provider, if
+// those are among them, along with the options of each resource. The variables that refer to parents and providers
+// are named by applying varName to their resource names; those that are not among the given resources are expected
+// to be declared by the program already. Parents that are the stack and default providers are left implicit.
+func resourceOptions(resources []*resource.State,
+	varName func(string) string) ([]*resource.State, map[resource.URN]options, error) {
+
+	byURN := make(map[resource.URN]*resource.State)
+	for _, res := range resources {
+		byURN[res.URN] = res
+	}
+
+	opts := make(map[resource.URN]options)
+	deps := make(map[resource.URN][]resource.URN)
+	for _, res := range resources {
+		var o options
+		if res.Parent != "" && res.Parent.Type() != resource.RootStackType {
+			o.parent = varName(string(res.Parent.Name()))
+			deps[res.URN] = append(deps[res.URN], res.Parent)
+		}
+		if res.Provider != "" {
+			ref, err := providers.ParseReference(res.Provider)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "parsing provider reference for resource %v", res.URN)
+			}
+			if !providers.IsDefaultProvider(ref.URN()) {
+				o.provider = varName(string(ref.URN().Name()))
+				deps[res.URN] = append(deps[res.URN], ref.URN())
+			}
+		}
+		opts[res.URN] = o
+	}
+
+	// Order the resources depth-first, so that each follows its dependencies but otherwise keeps its position.
+	sorted, visited := make([]*resource.State, 0, len(resources)), make(map[resource.URN]bool)
+	var visit func(res *resource.State)
+	visit = func(res *resource.State) {
+		if visited[res.URN] {
+			return
+		}
+		visited[res.URN] = true
+		for _, dep := range deps[res.URN] {
+			if d, ok := byURN[dep]; ok {
+				visit(d)
+			}
+		}
+		sorted = append(sorted, res)
+	}
+	for _, res := range resources {
+		visit(res)
+	}
+	return sorted, opts, nil
+}
+
+type generator struct {
+	w   io.Writer
+	err error
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	if g.err == nil {
+		_, g.err = fmt.Fprintf(g.w, format, args...)
+	}
+}
+
+func (g *generator) genTypeScriptValue(v resource.PropertyValue, indent string) {
+	switch {
+	case v.IsNull() || v.IsComputed() || v.IsOutput():
+		g.printf("undefined")
+	case v.IsBool():
+		g.printf("%v", v.BoolValue())
+	case v.IsNumber():
+		g.printf("%s", strconv.FormatFloat(v.NumberValue(), 'f', -1, 64))
+	case v.IsString():
+		g.printf("%q", v.StringValue())
+	case v.IsSecret():
+		g.printf("pulumi.secret(")
+		g.genTypeScriptValue(v.SecretValue().Element, indent)
+		g.printf(")")
+	case v.IsArray():
+		arr := v.ArrayValue()
+		if len(arr) == 0 {
+			g.printf("[]")
+			return
+		}
+		g.printf("[\n")
+		for _, e := range arr {
+			g.printf("%s    ", indent)
+			g.genTypeScriptValue(e, indent+"    ")
+			g.printf(",\n")
+		}
+		g.printf("%s]", indent)
+	case v.IsObject():
+		obj := v.ObjectValue()
+		if len(obj) == 0 {
+			g.printf("{}")
+			return
+		}
+		g.printf("{\n")
+		for _, k := range obj.StableKeys() {
+			key := string(k)
+			if !isIdentifier(key) {
+				key = strconv.Quote(key)
+			}
+			g.printf("%s    %s: ", indent, key)
+			g.genTypeScriptValue(obj[k], indent+"    ")
+			g.printf(",\n")
+		}
+		g.printf("%s}", indent)
+	default:
+		// Assets and archives cannot be reconstructed from their state.
+		g.printf("undefined")
+	}
+}
+
+func (g *generator) genPythonValue(v resource.PropertyValue, indent string) {
+	switch {
+	case v.IsNull() || v.IsComputed() || v.IsOutput():
+		g.printf("None")
+	case v.IsBool():
+		if v.BoolValue() {
+			g.printf("True")
+		} else {
+			g.printf("False")
+		}
+	case v.IsNumber():
+		g.printf("%s", strconv.FormatFloat(v.NumberValue(), 'f', -1, 64))
+	case v.IsString():
+		g.printf("%q", v.StringValue())
+	case v.IsSecret():
+		g.printf("pulumi.Output.secret(")
+		g.genPythonValue(v.SecretValue().Element, indent)
+		g.printf(")")
+	case v.IsArray():
+		arr := v.ArrayValue()
+		if len(arr) == 0 {
+			g.printf("[]")
+			return
+		}
+		g.printf("[\n")
+		for _, e := range arr {
+			g.printf("%s    ", indent)
+			g.genPythonValue(e, indent+"    ")
+			g.printf(",\n")
+		}
+		g.printf("%s]", indent)
+	case v.IsObject():
+		obj := v.ObjectValue()
+		if len(obj) == 0 {
+			g.printf("{}")
+			return
+		}
+		g.printf("{\n")
+		for _, k := range obj.StableKeys() {
+			g.printf("%s    %q: ", indent, string(k))
+			g.genPythonValue(obj[k], indent+"    ")
+			g.printf(",\n")
+		}
+		g.printf("%s}", indent)
+	default:
+		// Assets and archives cannot be reconstructed from their state.
+		g.printf("None")
+	}
+}
+
+// packages returns the sorted set of packages that the given resources belong to.
+func packages(resources []*resource.State) []tokens.Package {
+	seen := make(map[tokens.Package]bool)
+	var pkgs []tokens.Package
+	for _, res := range resources {
+		pkg := res.Type.Package()
+		if !seen[pkg] {
+			seen[pkg] = true
+			pkgs = append(pkgs, pkg)
+		}
+	}
+	sort.Slice(pkgs, func(i, j int) bool { return pkgs[i] < pkgs[j] })
+	return pkgs
+}
+
+// moduleAndName splits a type token like `aws:s3/bucket:Bucket` into the SDK module (`s3`) and type (`Bucket`)
+// names. The module is empty for types in the package's index module.
+func moduleAndName(t tokens.Type) (string, string) {
+	module := string(t.Module().Name())
+	if i := strings.Index(module, "/"); i != -1 {
+		module = module[:i]
+	}
+	if module == "index" {
+		module = ""
+	}
+	return module, string(t.Name())
+}
+
+func typeScriptType(t tokens.Type) string {
+	module, name := moduleAndName(t)
+	parts := []string{camelName(string(t.Package()))}
+	if module != "" {
+		parts = append(parts, module)
+	}
+	return strings.Join(append(parts, name), ".")
+}
+
+func pythonModule(pkg tokens.Package) string {
+	return "pulumi_" + strings.Replace(string(pkg), "-", "_", -1)
+}
+
+func pythonType(t tokens.Type) string {
+	module, name := moduleAndName(t)
+	parts := []string{pythonModule(t.Package())}
+	if module != "" {
+		parts = append(parts, python.PyName(module))
+	}
+	return strings.Join(append(parts, name), ".")
+}
+
+// camelName turns an arbitrary resource name into a camelCase identifier.
+func camelName(name string) string {
+	var b strings.Builder
+	upper := false
+	for _, c := range name {
+		switch {
+		case unicode.IsLetter(c) || unicode.IsDigit(c) || c == '_':
+			if b.Len() == 0 && unicode.IsDigit(c) {
+				b.WriteRune('_')
+			}
+			if upper && b.Len() > 0 {
+				c = unicode.ToUpper(c)
+			}
+			b.WriteRune(c)
+			upper = false
+		default:
+			upper = true
+		}
+	}
+	if b.Len() == 0 {
+		return "_"
+	}
+	return b.String()
+}
+
+func isIdentifier(s string) bool {
+	for i, c := range s {
+		if !(unicode.IsLetter(c) || c == '_' || c == '$' || (i > 0 && unicode.IsDigit(c))) {
+			return false
+		}
+	}
+	return s != ""
+}
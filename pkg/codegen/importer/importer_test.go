@@ -0,0 +1,149 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/tokens"
+)
+
+func testResources() []*resource.State {
+	t := tokens.Type("aws:s3/bucket:Bucket")
+	urn := resource.NewURN("stack", "project", "", t, "my-bucket")
+	inputs := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"bucketName":   "my-bucket-1234",
+		"forceDestroy": false,
+		"tags": map[string]interface{}{
+			"Owner": "me",
+		},
+	})
+	return []*resource.State{
+		resource.NewState(t, urn, true, false, "my-bucket-1234", inputs, nil, "", true, false, nil, nil, "",
+			nil, false, nil, nil, nil, false),
+	}
+}
+
+func TestGenerateTypeScript(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, GenerateTypeScript(&buf, testResources()))
+	assert.Equal(t, `import * as aws from "@pulumi/aws";
+
+const myBucket = new aws.s3.Bucket("my-bucket", {
+    bucketName: "my-bucket-1234",
+    forceDestroy: false,
+    tags: {
+        Owner: "me",
+    },
+}, {
+    protect: true,
+});
+`, buf.String())
+}
+
+func TestGeneratePython(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, GeneratePython(&buf, testResources()))
+	assert.Equal(t, `import pulumi
+import pulumi_aws
+
+my_bucket = pulumi_aws.s3.Bucket("my-bucket",
+    bucket_name="my-bucket-1234",
+    force_destroy=False,
+    tags={
+        "Owner": "me",
+    },
+    opts=pulumi.ResourceOptions(protect=True))
+`, buf.String())
+}
+
+// testChildResources returns an imported bucket object and the bucket that is its parent, listed child first. The
+// bucket is itself parented to a component that the program already declares, and both use an explicit provider.
+func testChildResources() []*resource.State {
+	componentURN := resource.NewURN("stack", "project", "", "my:index:Component", "my-component")
+	providerURN := resource.NewURN("stack", "project", "", "pulumi:providers:aws", "us-east")
+	provider := string(providerURN) + "::provider-id"
+
+	bucketType := tokens.Type("aws:s3/bucket:Bucket")
+	bucketURN := resource.NewURN("stack", "project", componentURN.QualifiedType(), bucketType, "my-bucket")
+	bucketInputs := resource.NewPropertyMapFromMap(map[string]interface{}{"bucketName": "my-bucket-1234"})
+
+	objectType := tokens.Type("aws:s3/bucketObject:BucketObject")
+	objectURN := resource.NewURN("stack", "project", bucketURN.QualifiedType(), objectType, "my-object")
+	objectInputs := resource.NewPropertyMapFromMap(map[string]interface{}{"key": "index.html"})
+
+	return []*resource.State{
+		resource.NewState(objectType, objectURN, true, false, "index.html", objectInputs, nil, bucketURN, false,
+			false, nil, nil, provider, nil, false, nil, nil, nil, false),
+		resource.NewState(bucketType, bucketURN, true, false, "my-bucket-1234", bucketInputs, nil, componentURN, false,
+			false, nil, nil, provider, nil, false, nil, nil, nil, false),
+	}
+}
+
+func TestGenerateTypeScriptParentAndProvider(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, GenerateTypeScript(&buf, testChildResources()))
+	assert.Equal(t, `import * as aws from "@pulumi/aws";
+
+const myBucket = new aws.s3.Bucket("my-bucket", {
+    bucketName: "my-bucket-1234",
+}, {
+    parent: myComponent,
+    provider: usEast,
+});
+const myObject = new aws.s3.BucketObject("my-object", {
+    key: "index.html",
+}, {
+    parent: myBucket,
+    provider: usEast,
+});
+`, buf.String())
+}
+
+func TestGeneratePythonParentAndProvider(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, GeneratePython(&buf, testChildResources()))
+	assert.Equal(t, `import pulumi
+import pulumi_aws
+
+my_bucket = pulumi_aws.s3.Bucket("my-bucket",
+    bucket_name="my-bucket-1234",
+    opts=pulumi.ResourceOptions(parent=my_component, provider=us_east))
+my_object = pulumi_aws.s3.BucketObject("my-object",
+    key="index.html",
+    opts=pulumi.ResourceOptions(parent=my_bucket, provider=us_east))
+`, buf.String())
+}
+
+func TestGenerateDefaultProviderAndStackParent(t *testing.T) {
+	resources := testResources()
+	resources[0].Parent = resource.DefaultRootStackURN("stack", "project")
+	resources[0].Provider = string(resource.NewURN("stack", "project", "", "pulumi:providers:aws", "default")) +
+		"::provider-id"
+
+	var buf bytes.Buffer
+	assert.NoError(t, GenerateTypeScript(&buf, resources))
+	assert.NotContains(t, buf.String(), "parent")
+	assert.NotContains(t, buf.String(), "provider")
+}
+
+func TestUnsupportedLanguage(t *testing.T) {
+	assert.False(t, IsLanguageSupported("dotnet"))
+	assert.Error(t, GenerateProgram(&bytes.Buffer{}, "dotnet", testResources()))
+}
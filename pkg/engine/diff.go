@@ -414,7 +414,8 @@ func GetResourceOutputsPropertiesString(
 
 func considerSameIfNotCreateOrDelete(op deploy.StepOp) deploy.StepOp {
 	switch op {
-	case deploy.OpCreate, deploy.OpDelete, deploy.OpDeleteReplaced, deploy.OpReadDiscard, deploy.OpDiscardReplaced:
+	case deploy.OpCreate, deploy.OpDelete, deploy.OpDeleteReplaced, deploy.OpReadDiscard, deploy.OpDiscardReplaced,
+		deploy.OpRetainDiscard:
 		return op
 	default:
 		return deploy.OpSame
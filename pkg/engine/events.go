@@ -16,7 +16,9 @@ package engine
 
 import (
 	"bytes"
+	"fmt"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/pulumi/pulumi/pkg/apitype"
@@ -51,6 +53,7 @@ const (
 	ResourceOutputsEvent    EventType = "resource-outputs"
 	ResourceOperationFailed EventType = "resource-operationfailed"
 	PolicyViolationEvent    EventType = "policy-violation"
+	PolicyRemediationEvent  EventType = "policy-remediation"
 )
 
 func cancelEvent() Event {
@@ -80,6 +83,18 @@ type PolicyViolationEventPayload struct {
 	Prefix            string
 }
 
+// PolicyRemediationEventPayload is the payload for an event with type `policy-remediation`.
+type PolicyRemediationEventPayload struct {
+	ResourceURN       resource.URN
+	Message           string
+	Color             colors.Colorization
+	PolicyName        string
+	PolicyPackName    string
+	PolicyPackVersion string
+	Keys              []resource.PropertyKey
+	Prefix            string
+}
+
 type StdoutEventPayload struct {
 	Message string
 	Color   colors.Colorization
@@ -561,6 +576,59 @@ func (e *eventEmitter) policyViolationEvent(urn resource.URN, d plugin.AnalyzeDi
 	}
 }
 
+func (e *eventEmitter) policyRemediationEvent(urn resource.URN, t plugin.Remediation,
+	before resource.PropertyMap, after resource.PropertyMap) {
+
+	contract.Requiref(e != nil, "e", "!= nil")
+
+	// Figure out which properties the remediation changed.
+	var keys []resource.PropertyKey
+	if diff := before.Diff(after); diff != nil {
+		for _, k := range diff.Keys() {
+			if diff.Changed(k) {
+				keys = append(keys, k)
+			}
+		}
+	}
+
+	// Write prefix.
+	var prefix bytes.Buffer
+	prefix.WriteString(colors.SpecInfo)
+	prefix.WriteString("remediated: ")
+	prefix.WriteString(colors.Reset)
+
+	// Write the message itself.
+	var buffer bytes.Buffer
+	buffer.WriteString(colors.SpecNote)
+	buffer.WriteString(fmt.Sprintf("[%s@v%s] %s", t.PolicyPackName, t.PolicyPackVersion, t.PolicyName))
+	if t.Description != "" {
+		buffer.WriteString(fmt.Sprintf(" (%s)", t.Description))
+	}
+	if len(keys) > 0 {
+		strs := make([]string, len(keys))
+		for i, k := range keys {
+			strs[i] = string(k)
+		}
+		buffer.WriteString(fmt.Sprintf(" changed %s", strings.Join(strs, ", ")))
+	}
+	buffer.WriteString(colors.Reset)
+	buffer.WriteRune('\n')
+
+	e.ch <- Event{
+		Type: PolicyRemediationEvent,
+		Payload: PolicyRemediationEventPayload{
+			ResourceURN:       urn,
+			Message:           logging.FilterString(buffer.String()),
+			Color:             colors.Raw,
+			PolicyName:        t.PolicyName,
+			PolicyPackName:    t.PolicyPackName,
+			PolicyPackVersion: t.PolicyPackVersion,
+			Keys:              keys,
+			Prefix:            logging.FilterString(prefix.String()),
+		},
+	}
+}
+
 func diagEvent(e *eventEmitter, d *diag.Diag, prefix, msg string, sev diag.Severity,
 	ephemeral bool) {
 	contract.Requiref(e != nil, "e", "!= nil")
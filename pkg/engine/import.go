@@ -0,0 +1,83 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"github.com/pulumi/pulumi/pkg/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/resource/plugin"
+	"github.com/pulumi/pulumi/pkg/util/contract"
+	"github.com/pulumi/pulumi/pkg/util/logging"
+	"github.com/pulumi/pulumi/pkg/util/result"
+	"github.com/pulumi/pulumi/pkg/workspace"
+)
+
+// Import adopts the given existing resources into the stack's snapshot by reading their state from their providers.
+// No program is run, and no resources are created, updated or deleted.
+func Import(u UpdateInfo, ctx *Context, opts UpdateOptions, imports []deploy.Import,
+	dryRun bool) (ResourceChanges, result.Result) {
+
+	contract.Require(u != nil, "u")
+	contract.Require(ctx != nil, "ctx")
+
+	defer func() { ctx.Events <- cancelEvent() }()
+
+	info, err := newPlanContext(u, "import", ctx.ParentSpan)
+	if err != nil {
+		return nil, result.FromError(err)
+	}
+	defer info.Close()
+
+	emitter, err := makeEventEmitter(ctx.Events, u)
+	if err != nil {
+		return nil, result.FromError(err)
+	}
+	defer emitter.Close()
+
+	return update(ctx, info, planOptions{
+		UpdateOptions: opts,
+		SourceFunc:    newImportSource(imports),
+		Events:        emitter,
+		Diag:          newEventSink(emitter, false),
+		StatusDiag:    newEventSink(emitter, true),
+		imports:       imports,
+	}, dryRun)
+}
+
+func newImportSource(imports []deploy.Import) planSourceFunc {
+	return func(client deploy.BackendClient, opts planOptions, proj *workspace.Project, pwd, main string,
+		target *deploy.Target, plugctx *plugin.Context, dryRun bool) (deploy.Source, error) {
+
+		// Like Refresh, we don't run the user's program, so we need the plugins described by the snapshot, plus the
+		// plugins for each of the packages we are about to import from.
+		plugins, err := gatherPluginsFromSnapshot(plugctx, target)
+		if err != nil {
+			return nil, err
+		}
+		for _, imp := range imports {
+			plugins.Add(workspace.PluginInfo{
+				Name:    imp.Type.Package().String(),
+				Kind:    workspace.ResourcePlugin,
+				Version: imp.Version,
+			})
+		}
+
+		if err := ensurePluginsAreInstalled(plugins); err != nil {
+			logging.V(7).Infof("newImportSource(): failed to install missing plugins: %v", err)
+		}
+
+		// Just return an error source. Import doesn't use its source.
+		return deploy.NewErrorSource(proj.Name), nil
+	}
+}
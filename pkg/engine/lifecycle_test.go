@@ -23,6 +23,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/blang/semver"
 	"github.com/mitchellh/copystructure"
@@ -128,7 +129,8 @@ func (j *Journal) Snap(base *deploy.Snapshot) *deploy.Snapshot {
 			switch e.Step.Op() {
 			case deploy.OpCreate, deploy.OpCreateReplacement:
 				ops = append(ops, resource.NewOperation(e.Step.New(), resource.OperationTypeCreating))
-			case deploy.OpDelete, deploy.OpDeleteReplaced, deploy.OpReadDiscard, deploy.OpDiscardReplaced:
+			case deploy.OpDelete, deploy.OpDeleteReplaced, deploy.OpReadDiscard, deploy.OpDiscardReplaced,
+				deploy.OpRetainDiscard:
 				ops = append(ops, resource.NewOperation(e.Step.Old(), resource.OperationTypeDeleting))
 			case deploy.OpRead, deploy.OpReadReplacement:
 				ops = append(ops, resource.NewOperation(e.Step.New(), resource.OperationTypeReading))
@@ -143,7 +145,8 @@ func (j *Journal) Snap(base *deploy.Snapshot) *deploy.Snapshot {
 			case deploy.OpCreate, deploy.OpCreateReplacement, deploy.OpRead, deploy.OpReadReplacement, deploy.OpUpdate,
 				deploy.OpImport, deploy.OpImportReplacement:
 				doneOps[e.Step.New()] = true
-			case deploy.OpDelete, deploy.OpDeleteReplaced, deploy.OpReadDiscard, deploy.OpDiscardReplaced:
+			case deploy.OpDelete, deploy.OpDeleteReplaced, deploy.OpReadDiscard, deploy.OpDiscardReplaced,
+				deploy.OpRetainDiscard:
 				doneOps[e.Step.Old()] = true
 			}
 		}
@@ -159,7 +162,8 @@ func (j *Journal) Snap(base *deploy.Snapshot) *deploy.Snapshot {
 				if old := e.Step.Old(); old != nil && old.PendingReplacement {
 					dones[old] = true
 				}
-			case deploy.OpDelete, deploy.OpDeleteReplaced, deploy.OpReadDiscard, deploy.OpDiscardReplaced:
+			case deploy.OpDelete, deploy.OpDeleteReplaced, deploy.OpReadDiscard, deploy.OpDiscardReplaced,
+				deploy.OpRetainDiscard:
 				if old := e.Step.Old(); !old.PendingReplacement {
 					dones[old] = true
 				}
@@ -1334,7 +1338,7 @@ func TestRefreshInitFailure(t *testing.T) {
 		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
 			return &deploytest.Provider{
 				ReadF: func(
-					urn resource.URN, id resource.ID, inputs, state resource.PropertyMap,
+					urn resource.URN, id resource.ID, inputs, state resource.PropertyMap, timeout float64,
 				) (plugin.ReadResult, resource.Status, error) {
 					if refreshShouldFail && urn == resURN {
 						err := &plugin.InitError{
@@ -1535,7 +1539,7 @@ func TestRefreshWithDelete(t *testing.T) {
 				deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
 					return &deploytest.Provider{
 						ReadF: func(
-							urn resource.URN, id resource.ID, inputs, state resource.PropertyMap,
+							urn resource.URN, id resource.ID, inputs, state resource.PropertyMap, timeout float64,
 						) (plugin.ReadResult, resource.Status, error) {
 							// This thing doesn't exist. Returning nil from Read should trigger
 							// the engine to delete it from the snapshot.
@@ -1568,6 +1572,123 @@ func TestRefreshWithDelete(t *testing.T) {
 	}
 }
 
+// Tests that refresh bounds the number of concurrent reads per provider, cancels reads that exceed the read timeout,
+// and continues to refresh the remaining resources before summarizing the failures. Reads that time out must keep
+// their slot until they return, so the bound holds even after timeouts.
+func TestRefreshBoundedReads(t *testing.T) {
+	names := []string{"resA", "resB", "resC", "resD", "resE", "resF"}
+	hanging := map[string]bool{"resB": true, "resC": true}
+	const customName = "resF"
+
+	var m sync.Mutex
+	current, max := 0, 0
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				ReadF: func(
+					urn resource.URN, id resource.ID, inputs, state resource.PropertyMap, timeout float64,
+				) (plugin.ReadResult, resource.Status, error) {
+					m.Lock()
+					current++
+					if current > max {
+						max = current
+					}
+					m.Unlock()
+					defer func() {
+						m.Lock()
+						current--
+						m.Unlock()
+					}()
+
+					if urn.Name() == customName {
+						assert.Equal(t, float64(60), timeout)
+					} else {
+						assert.Equal(t, 0.1, timeout)
+					}
+
+					// This fake stands in for the provider plugin, which enforces the deadline itself (see
+					// TestReadTimeout in pkg/resource/plugin); here, a hanging read returns the plugin's timeout
+					// error once its deadline has passed, so that only the engine's handling of it is tested.
+					if hanging[string(urn.Name())] {
+						d := time.Duration(timeout * float64(time.Second))
+						time.Sleep(d)
+						return plugin.ReadResult{}, resource.StatusOK, errors.Errorf("timed out after %v reading resource", d)
+					}
+
+					time.Sleep(10 * time.Millisecond)
+					outputs := resource.PropertyMap{"refreshed": resource.NewBoolProperty(true)}
+					return plugin.ReadResult{Inputs: inputs, Outputs: outputs}, resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		for _, name := range names {
+			var opts deploytest.ResourceOptions
+			if name == customName {
+				opts.CustomTimeouts = &resource.CustomTimeouts{Read: 60}
+			}
+			_, _, _, err := monitor.RegisterResource("pkgA:m:typA", name, true, opts)
+			assert.NoError(t, err)
+		}
+		return nil
+	})
+
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+	p := &TestPlan{Options: UpdateOptions{host: host, Parallel: len(names)}}
+
+	p.Steps = []TestStep{{Op: Update}}
+	snap := p.Run(t, nil)
+
+	var hangingURNs []resource.URN
+	for name := range hanging {
+		hangingURNs = append(hangingURNs, p.NewURN("pkgA:m:typA", name, ""))
+	}
+	p.Options.RefreshParallel = 2
+	p.Options.RefreshTimeout = 100 * time.Millisecond
+	p.Steps = []TestStep{{
+		Op:            Refresh,
+		ExpectFailure: true,
+		SkipPreview:   true,
+		Validate: func(project workspace.Project, target deploy.Target, j *Journal,
+			evts []Event, res result.Result) result.Result {
+
+			sawSummary := false
+			for _, evt := range evts {
+				if evt.Type == DiagEvent {
+					e := evt.Payload.(DiagEventPayload)
+					msg := colors.Never.Colorize(e.Message)
+					if strings.HasPrefix(msg, "refresh failed for 2 resource(s):") && e.Severity == diag.Error {
+						sawSummary = true
+						for _, urn := range hangingURNs {
+							assert.Contains(t, msg, string(urn))
+						}
+						assert.Contains(t, msg, "timed out after 100ms")
+					}
+				}
+			}
+			assert.True(t, sawSummary)
+			return res
+		},
+	}}
+	snap = p.Run(t, snap)
+
+	m.Lock()
+	assert.Equal(t, 0, current)
+	assert.True(t, max >= 1 && max <= 2, "saw %d concurrent reads", max)
+	m.Unlock()
+
+	// Every resource but those whose reads timed out should have been refreshed.
+	for _, res := range snap.Resources {
+		if providers.IsProviderType(res.Type) {
+			continue
+		}
+		_, refreshed := res.Outputs["refreshed"]
+		assert.Equal(t, !hanging[string(res.URN.Name())], refreshed, "unexpected refresh state for %s", res.URN)
+	}
+}
+
 func pickURN(t *testing.T, urns []resource.URN, names []string, target string) resource.URN {
 	assert.Equal(t, len(urns), len(names))
 	assert.Contains(t, names, target)
@@ -1648,7 +1769,7 @@ func validateRefreshDeleteCombination(t *testing.T, names []string, targets []st
 		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
 			return &deploytest.Provider{
 				ReadF: func(urn resource.URN, id resource.ID,
-					inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+					inputs, state resource.PropertyMap, timeout float64) (plugin.ReadResult, resource.Status, error) {
 
 					switch id {
 					case "0", "4":
@@ -1822,7 +1943,7 @@ func validateRefreshBasicsCombination(t *testing.T, names []string, targets []st
 		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
 			return &deploytest.Provider{
 				ReadF: func(urn resource.URN, id resource.ID,
-					inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+					inputs, state resource.PropertyMap, timeout float64) (plugin.ReadResult, resource.Status, error) {
 
 					new, hasNewState := newStates[id]
 					assert.True(t, hasNewState)
@@ -1963,7 +2084,7 @@ func TestCanceledRefresh(t *testing.T) {
 		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
 			return &deploytest.Provider{
 				ReadF: func(urn resource.URN, id resource.ID,
-					inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+					inputs, state resource.PropertyMap, timeout float64) (plugin.ReadResult, resource.Status, error) {
 
 					refreshes <- id
 					<-cancelled
@@ -3214,6 +3335,278 @@ func TestSingleResourceIgnoreChanges(t *testing.T) {
 	}, []string{"a", "b"}, []deploy.StepOp{deploy.OpUpdate})
 }
 
+func TestSingleResourceReplaceOnChanges(t *testing.T) {
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				DiffF: func(urn resource.URN, id resource.ID,
+					olds, news resource.PropertyMap, ignoreChanges []string) (plugin.DiffResult, error) {
+
+					return plugin.DiffResult{}, nil
+				},
+			}, nil
+		}),
+	}
+
+	replaceOnChanges := []string{"b.c"}
+	updateProgramWithProps := func(snap *deploy.Snapshot, props resource.PropertyMap,
+		allowedOps []deploy.StepOp, replaceKeys []resource.PropertyKey) *deploy.Snapshot {
+
+		program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+			_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+				Inputs:           props,
+				ReplaceOnChanges: replaceOnChanges,
+			})
+			assert.NoError(t, err)
+			return nil
+		})
+		host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+		p := &TestPlan{
+			Options: UpdateOptions{host: host},
+			Steps: []TestStep{
+				{
+					Op: Update,
+					Validate: func(project workspace.Project, target deploy.Target, j *Journal,
+						events []Event, res result.Result) result.Result {
+						for _, event := range events {
+							if event.Type == ResourcePreEvent {
+								payload := event.Payload.(ResourcePreEventPayload)
+								assert.Subset(t, allowedOps, []deploy.StepOp{payload.Metadata.Op})
+								if payload.Metadata.Op == deploy.OpReplace {
+									assert.Equal(t, replaceKeys, payload.Metadata.Keys)
+								}
+							}
+						}
+						return res
+					},
+				},
+			},
+		}
+		return p.Run(t, snap)
+	}
+
+	snap := updateProgramWithProps(nil, resource.NewPropertyMapFromMap(map[string]interface{}{
+		"a": 1,
+		"b": map[string]interface{}{
+			"c": "foo",
+		},
+	}), []deploy.StepOp{deploy.OpCreate}, nil)
+
+	// Ensure that a change to a property that is not listed results in an OpUpdate
+	snap = updateProgramWithProps(snap, resource.NewPropertyMapFromMap(map[string]interface{}{
+		"a": 2,
+		"b": map[string]interface{}{
+			"c": "foo",
+			"d": "bar",
+		},
+	}), []deploy.StepOp{deploy.OpUpdate}, nil)
+
+	// Ensure that a change to a listed property results in a replacement that is blamed on that property
+	replaceOps := []deploy.StepOp{deploy.OpCreateReplacement, deploy.OpReplace, deploy.OpDeleteReplaced}
+	snap = updateProgramWithProps(snap, resource.NewPropertyMapFromMap(map[string]interface{}{
+		"a": 2,
+		"b": map[string]interface{}{
+			"c": "qux",
+			"d": "bar",
+		},
+	}), replaceOps, []resource.PropertyKey{"b"})
+
+	// Ensure that removing a listed property results in a replacement
+	_ = updateProgramWithProps(snap, resource.NewPropertyMapFromMap(map[string]interface{}{
+		"a": 2,
+	}), replaceOps, []resource.PropertyKey{"b"})
+}
+
+func TestRetainOnDelete(t *testing.T) {
+	idCounter := 0
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				DiffF: func(urn resource.URN, id resource.ID,
+					olds, news resource.PropertyMap, ignoreChanges []string) (plugin.DiffResult, error) {
+
+					if !olds["foo"].DeepEquals(news["foo"]) {
+						return plugin.DiffResult{
+							Changes:     plugin.DiffSome,
+							ReplaceKeys: []resource.PropertyKey{"foo"},
+						}, nil
+					}
+					return plugin.DiffResult{}, nil
+				},
+				CreateF: func(urn resource.URN, news resource.PropertyMap,
+					timeout float64) (resource.ID, resource.PropertyMap, resource.Status, error) {
+
+					resourceID := resource.ID(fmt.Sprintf("created-id-%d", idCounter))
+					idCounter = idCounter + 1
+					return resourceID, news, resource.StatusOK, nil
+				},
+				DeleteF: func(urn resource.URN, id resource.ID,
+					olds resource.PropertyMap, timeout float64) (resource.Status, error) {
+
+					assert.Fail(t, "Delete was called for a retained resource")
+					return resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	ins := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"foo": "bar",
+	})
+	createResource := true
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		if createResource {
+			_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+				Inputs:         ins,
+				RetainOnDelete: true,
+			})
+			assert.NoError(t, err)
+		}
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{
+		Options: UpdateOptions{host: host},
+	}
+
+	project := p.GetProject()
+
+	// Run an update to create the resource and check that the retain bit is persisted in the snapshot.
+	snap, res := TestOp(Update).Run(project, p.GetTarget(nil), p.Options, false, p.BackendClient, nil)
+	assert.Nil(t, res)
+	assert.NotNil(t, snap)
+	assert.Len(t, snap.Resources, 2)
+	assert.True(t, snap.Resources[1].RetainOnDelete)
+	assert.Equal(t, "created-id-0", snap.Resources[1].ID.String())
+
+	// Change an input that forces a replacement. The original resource must be discarded rather than deleted.
+	ins = resource.NewPropertyMapFromMap(map[string]interface{}{
+		"foo": "baz",
+	})
+	snap, res = TestOp(Update).Run(project, p.GetTarget(snap), p.Options, false, p.BackendClient,
+		func(_ workspace.Project, _ deploy.Target, _ *Journal, events []Event, res result.Result) result.Result {
+			discarded := false
+			for _, event := range events {
+				if event.Type == ResourcePreEvent {
+					payload := event.Payload.(ResourcePreEventPayload)
+					assert.NotEqual(t, deploy.OpDeleteReplaced, payload.Metadata.Op)
+					if payload.Metadata.Op == deploy.OpDiscardReplaced {
+						discarded = true
+					}
+				}
+			}
+			assert.True(t, discarded)
+			return res
+		})
+	assert.Nil(t, res)
+	assert.NotNil(t, snap)
+	assert.Len(t, snap.Resources, 2)
+	assert.Equal(t, "created-id-1", snap.Resources[1].ID.String())
+
+	// Remove the resource from the program. It should be discarded and dropped from the snapshot.
+	createResource = false
+	snap, res = TestOp(Update).Run(project, p.GetTarget(snap), p.Options, false, p.BackendClient,
+		func(_ workspace.Project, _ deploy.Target, _ *Journal, events []Event, res result.Result) result.Result {
+			discarded := false
+			for _, event := range events {
+				if event.Type == ResourcePreEvent {
+					payload := event.Payload.(ResourcePreEventPayload)
+					assert.NotEqual(t, deploy.OpDelete, payload.Metadata.Op)
+					if payload.Metadata.Op == deploy.OpRetainDiscard {
+						discarded = true
+					}
+				}
+			}
+			assert.True(t, discarded)
+			return res
+		})
+	assert.Nil(t, res)
+	assert.NotNil(t, snap)
+	assert.Len(t, snap.Resources, 0)
+}
+
+func TestPolicyRemediation(t *testing.T) {
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				CheckF: func(urn resource.URN,
+					olds, news resource.PropertyMap) (resource.PropertyMap, []plugin.CheckFailure, error) {
+
+					// The provider must only ever see the remediated inputs.
+					assert.True(t, news["encrypted"].DeepEquals(resource.NewBoolProperty(true)))
+					return news, nil, nil
+				},
+			}, nil
+		}),
+	}
+
+	analyzer := &deploytest.Analyzer{
+		Info: plugin.AnalyzerInfo{Name: "policies"},
+		RemediateF: func(r plugin.AnalyzerResource) ([]plugin.Remediation, error) {
+			if r.Type != "pkgA:m:typA" {
+				return nil, nil
+			}
+			props := r.Properties.Copy()
+			props["encrypted"] = resource.NewBoolProperty(true)
+			return []plugin.Remediation{
+				{
+					PolicyName:        "enforce-encryption",
+					PolicyPackName:    "policies",
+					PolicyPackVersion: "1.0.0",
+					Properties:        props,
+				},
+				// A remediation that makes no changes should not be reported.
+				{
+					PolicyName:        "no-op",
+					PolicyPackName:    "policies",
+					PolicyPackVersion: "1.0.0",
+					Properties:        props,
+				},
+			}, nil
+		},
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			Inputs: resource.PropertyMap{
+				"foo":       resource.NewStringProperty("bar"),
+				"encrypted": resource.NewBoolProperty(false),
+			},
+		})
+		assert.NoError(t, err)
+		return nil
+	})
+	host := deploytest.NewPluginHostWithAnalyzers(nil, nil, program, []plugin.Analyzer{analyzer}, loaders...)
+
+	validate := func(_ workspace.Project, _ deploy.Target, _ *Journal, events []Event,
+		res result.Result) result.Result {
+
+		var remediations []PolicyRemediationEventPayload
+		for _, event := range events {
+			if event.Type == PolicyRemediationEvent {
+				remediations = append(remediations, event.Payload.(PolicyRemediationEventPayload))
+			}
+		}
+		if assert.Len(t, remediations, 1) {
+			assert.Equal(t, "enforce-encryption", remediations[0].PolicyName)
+			assert.Equal(t, []resource.PropertyKey{"encrypted"}, remediations[0].Keys)
+			assert.Contains(t, remediations[0].Message, "enforce-encryption")
+		}
+		return res
+	}
+
+	p := &TestPlan{
+		Options: UpdateOptions{host: host},
+		Steps:   []TestStep{{Op: Update, Validate: validate}},
+	}
+	snap := p.Run(t, nil)
+	assert.Len(t, snap.Resources, 2)
+	assert.True(t, snap.Resources[1].Inputs["encrypted"].DeepEquals(resource.NewBoolProperty(true)))
+	assert.True(t, snap.Resources[1].Inputs["foo"].DeepEquals(resource.NewStringProperty("bar")))
+}
+
 // TestDefaultProviderDiff tests that the engine can gracefully recover whenever a resource's default provider changes
 // and there is no diff in the provider's inputs.
 func TestDefaultProviderDiff(t *testing.T) {
@@ -3925,7 +4318,7 @@ func TestImport(t *testing.T) {
 					return "created-id", news, resource.StatusOK, nil
 				},
 				ReadF: func(urn resource.URN, id resource.ID,
-					inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+					inputs, state resource.PropertyMap, timeout float64) (plugin.ReadResult, resource.Status, error) {
 
 					return plugin.ReadResult{
 						Inputs: resource.PropertyMap{
@@ -4283,7 +4676,7 @@ func TestRefreshStepWillPersistUpdatedIDs(t *testing.T) {
 		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
 			return &deploytest.Provider{
 				ReadF: func(
-					urn resource.URN, id resource.ID, inputs, state resource.PropertyMap,
+					urn resource.URN, id resource.ID, inputs, state resource.PropertyMap, timeout float64,
 				) (plugin.ReadResult, resource.Status, error) {
 					return plugin.ReadResult{ID: idAfter, Outputs: outputs, Inputs: resource.PropertyMap{}}, resource.StatusOK, nil
 				},
@@ -4334,7 +4727,8 @@ func TestMissingRead(t *testing.T) {
 	loaders := []*deploytest.ProviderLoader{
 		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
 			return &deploytest.Provider{
-				ReadF: func(_ resource.URN, _ resource.ID, _, _ resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+				ReadF: func(_ resource.URN, _ resource.ID, _, _ resource.PropertyMap,
+					_ float64) (plugin.ReadResult, resource.Status, error) {
 					return plugin.ReadResult{}, resource.StatusOK, nil
 				},
 			}, nil
@@ -4367,7 +4761,7 @@ func TestImportUpdatedID(t *testing.T) {
 		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
 			return &deploytest.Provider{
 				ReadF: func(
-					urn resource.URN, id resource.ID, inputs, state resource.PropertyMap,
+					urn resource.URN, id resource.ID, inputs, state resource.PropertyMap, timeout float64,
 				) (plugin.ReadResult, resource.Status, error) {
 					return plugin.ReadResult{
 						ID:      actualID,
@@ -4404,6 +4798,85 @@ func TestImportUpdatedID(t *testing.T) {
 	}
 }
 
+func TestImportPlan(t *testing.T) {
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				ReadF: func(urn resource.URN, id resource.ID,
+					inputs, state resource.PropertyMap, timeout float64) (plugin.ReadResult, resource.Status, error) {
+
+					return plugin.ReadResult{
+						Inputs: resource.PropertyMap{
+							"foo": resource.NewStringProperty("bar"),
+						},
+						Outputs: resource.PropertyMap{
+							"foo": resource.NewStringProperty("bar"),
+						},
+					}, resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true)
+		assert.NoError(t, err)
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{
+		Options: UpdateOptions{host: host},
+	}
+	provURN := p.NewProviderURN("pkgA", "default", "")
+	resAURN := p.NewURN("pkgA:m:typA", "resA", "")
+	resBURN := p.NewURN("pkgA:m:typA", "resB", "")
+
+	importOp := func(imports []deploy.Import) TestOp {
+		return func(u UpdateInfo, ctx *Context, opts UpdateOptions, dryRun bool) (ResourceChanges, result.Result) {
+			return Import(u, ctx, opts, imports, dryRun)
+		}
+	}
+
+	// Create the stack's initial resources, then import a second resource alongside them.
+	p.Steps = []TestStep{{Op: Update}}
+	snap := p.Run(t, nil)
+
+	p.Steps = []TestStep{{
+		Op: importOp([]deploy.Import{{Type: "pkgA:m:typA", Name: "resB", ID: "imported-id", Protect: true}}),
+		Validate: func(_ workspace.Project, _ deploy.Target, j *Journal, _ []Event, res result.Result) result.Result {
+			for _, entry := range j.Entries {
+				switch urn := entry.Step.URN(); urn {
+				case provURN, resAURN:
+					assert.Equal(t, deploy.OpSame, entry.Step.Op())
+				case resBURN:
+					assert.Equal(t, deploy.OpImport, entry.Step.Op())
+				default:
+					t.Fatalf("unexpected resource %v", urn)
+				}
+			}
+			return res
+		},
+	}}
+	snap = p.Run(t, snap)
+	assert.Len(t, snap.Resources, 3)
+	assert.NoError(t, snap.VerifyIntegrity())
+
+	imported := snap.Resources[2]
+	assert.Equal(t, resBURN, imported.URN)
+	assert.Equal(t, resource.ID("imported-id"), imported.ID)
+	assert.True(t, imported.Protect)
+	assert.Equal(t, resource.NewStringProperty("bar"), imported.Inputs["foo"])
+	assert.Equal(t, string(provURN), imported.Provider[:len(provURN)])
+
+	// Importing a resource that already exists fails.
+	p.Steps = []TestStep{{
+		Op:            importOp([]deploy.Import{{Type: "pkgA:m:typA", Name: "resA", ID: "imported-id"}}),
+		ExpectFailure: true,
+	}}
+	p.Run(t, snap)
+}
+
 func TestDestroyTarget(t *testing.T) {
 	// Try refreshing a stack with combinations of the above resources as target to destroy.
 	subsets := combinations.All(complexTestDependencyGraphNames)
@@ -5148,7 +5621,7 @@ func TestSingleResourceDefaultProviderGolangLifecycle(t *testing.T) {
 					return "created-id", news, resource.StatusOK, nil
 				},
 				ReadF: func(urn resource.URN, id resource.ID,
-					inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+					inputs, state resource.PropertyMap, timeout float64) (plugin.ReadResult, resource.Status, error) {
 					return plugin.ReadResult{Inputs: inputs, Outputs: state}, resource.StatusOK, nil
 				},
 			}, nil
@@ -5166,14 +5639,15 @@ func TestSingleResourceDefaultProviderGolangLifecycle(t *testing.T) {
 		assert.NoError(t, err)
 
 		return pulumi.RunWithContext(ctx, func(ctx *pulumi.Context) error {
-			res, err := ctx.RegisterResource("pkgA:m:typA", "resA", true, map[string]interface{}{
+			res := &pulumi.ResourceState{}
+			err := ctx.RegisterResource("pkgA:m:typA", "resA", true, map[string]interface{}{
 				"foo": "bar",
-			})
+			}, res)
 			assert.NoError(t, err)
 
-			_, err = ctx.RegisterResource("pkgA:m:typA", "resB", true, map[string]interface{}{
+			err = ctx.RegisterResource("pkgA:m:typA", "resB", true, map[string]interface{}{
 				"baz": res.State["foo"],
-			})
+			}, &pulumi.ResourceState{})
 			assert.NoError(t, err)
 
 			return nil
@@ -5202,7 +5676,7 @@ func TestIgnoreChangesGolangLifecycle(t *testing.T) {
 					return "created-id", news, resource.StatusOK, nil
 				},
 				ReadF: func(urn resource.URN, id resource.ID,
-					inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+					inputs, state resource.PropertyMap, timeout float64) (plugin.ReadResult, resource.Status, error) {
 					return plugin.ReadResult{Inputs: inputs, Outputs: state}, resource.StatusOK, nil
 				},
 				DiffF: func(urn resource.URN, id resource.ID,
@@ -5230,7 +5704,7 @@ func TestIgnoreChangesGolangLifecycle(t *testing.T) {
 				opts := pulumi.ResourceOpt{
 					IgnoreChanges: ignoreChanges,
 				}
-				_, err := ctx.RegisterResource("pkgA:m:typA", "resA", true, nil, opts)
+				err := ctx.RegisterResource("pkgA:m:typA", "resA", true, nil, &pulumi.ResourceState{}, opts)
 				assert.NoError(t, err)
 
 				return nil
@@ -5321,13 +5795,14 @@ func TestExplicitDeleteBeforeReplaceGoSDK(t *testing.T) {
 		assert.NoError(t, err)
 
 		return pulumi.RunWithContext(ctx, func(ctx *pulumi.Context) error {
-			provider, err := ctx.RegisterResource(string(providers.MakeProviderType("pkgA")), "provA", true,
-				map[string]interface{}{})
+			provider := &pulumi.ResourceState{}
+			err := ctx.RegisterResource(string(providers.MakeProviderType("pkgA")), "provA", true,
+				map[string]interface{}{}, provider)
 			assert.NoError(t, err)
 			optsA.Provider = provider
 			optsA.DeleteBeforeReplace = getDbr()
 			fmt.Println(getDbr())
-			_, err = ctx.RegisterResource("pkgA:m:typA", "resA", true, inputsA, optsA)
+			err = ctx.RegisterResource("pkgA:m:typA", "resA", true, inputsA, &pulumi.ResourceState{}, optsA)
 			assert.NoError(t, err)
 
 			return nil
@@ -5391,7 +5866,7 @@ func TestReadResourceGolangLifecycle(t *testing.T) {
 		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
 			return &deploytest.Provider{
 				ReadF: func(urn resource.URN, id resource.ID,
-					inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+					inputs, state resource.PropertyMap, timeout float64) (plugin.ReadResult, resource.Status, error) {
 					assert.Equal(t, resource.ID("someId"), id)
 					return plugin.ReadResult{Inputs: inputs, Outputs: state}, resource.StatusOK, nil
 				},
@@ -5463,7 +5938,7 @@ func TestProviderInheritanceGolangLifecycle(t *testing.T) {
 					return "created-id", news, resource.StatusOK, nil
 				},
 				ReadF: func(urn resource.URN, id resource.ID,
-					inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+					inputs, state resource.PropertyMap, timeout float64) (plugin.ReadResult, resource.Status, error) {
 					return plugin.ReadResult{Inputs: inputs, Outputs: state}, resource.StatusOK, nil
 				},
 			}
@@ -5482,7 +5957,7 @@ func TestProviderInheritanceGolangLifecycle(t *testing.T) {
 					return "created-id", news, resource.StatusOK, nil
 				},
 				ReadF: func(urn resource.URN, id resource.ID,
-					inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+					inputs, state resource.PropertyMap, timeout float64) (plugin.ReadResult, resource.Status, error) {
 					return plugin.ReadResult{Inputs: inputs, Outputs: state}, resource.StatusOK, nil
 				},
 			}
@@ -5507,27 +5982,31 @@ func TestProviderInheritanceGolangLifecycle(t *testing.T) {
 
 		return pulumi.RunWithContext(ctx, func(ctx *pulumi.Context) error {
 			// register a couple of providers, pass in some props that we can use to indentify it during invoke
-			providerA, err := ctx.RegisterResource(string(providers.MakeProviderType("pkgA")), "prov1", true,
+			providerA := &pulumi.ResourceState{}
+			err := ctx.RegisterResource(string(providers.MakeProviderType("pkgA")), "prov1", true,
 				map[string]interface{}{
 					"foo": "1",
-				})
+				}, providerA)
 			assert.NoError(t, err)
-			providerB, err := ctx.RegisterResource(string(providers.MakeProviderType("pkgB")), "prov2", true,
+			providerB := &pulumi.ResourceState{}
+			err = ctx.RegisterResource(string(providers.MakeProviderType("pkgB")), "prov2", true,
 				map[string]interface{}{
 					"bar": "2",
-				})
+				}, providerB)
 			assert.NoError(t, err)
-			providerBOverride, err := ctx.RegisterResource(string(providers.MakeProviderType("pkgB")), "prov3", true,
+			providerBOverride := &pulumi.ResourceState{}
+			err = ctx.RegisterResource(string(providers.MakeProviderType("pkgB")), "prov3", true,
 				map[string]interface{}{
 					"bang": "3",
-				})
+				}, providerBOverride)
 			assert.NoError(t, err)
 			componentProviders := make(map[string]pulumi.ProviderResource)
 			componentProviders["pkgA"] = providerA
 			componentProviders["pkgB"] = providerB
 			// create a component resource that uses provider map
-			componentResource, err := ctx.RegisterResource("pkgA:m:typA", "resA", true,
-				map[string]interface{}{}, pulumi.ResourceOpt{
+			componentResource := &pulumi.ResourceState{}
+			err = ctx.RegisterResource("pkgA:m:typA", "resA", true,
+				map[string]interface{}{}, componentResource, pulumi.ResourceOpt{
 					Providers: componentProviders,
 				})
 			assert.NoError(t, err)
@@ -5536,8 +6015,9 @@ func TestProviderInheritanceGolangLifecycle(t *testing.T) {
 			assert.Same(t, providerA, componentResultProvider)
 
 			// create a child resource
-			childResource, err := ctx.RegisterResource("pkgB:m:typB", "resBChild", true,
-				map[string]interface{}{}, pulumi.ResourceOpt{
+			childResource := &pulumi.ResourceState{}
+			err = ctx.RegisterResource("pkgB:m:typB", "resBChild", true,
+				map[string]interface{}{}, childResource, pulumi.ResourceOpt{
 					Parent: componentResource,
 				})
 			assert.NoError(t, err)
@@ -5547,8 +6027,9 @@ func TestProviderInheritanceGolangLifecycle(t *testing.T) {
 			assert.Same(t, providerB, childResultProvider)
 
 			// create a child with a provider specified
-			childWithOverride, err := ctx.RegisterResource("pkgB:m:typB", "resBChildOverride", true,
-				map[string]interface{}{}, pulumi.ResourceOpt{
+			childWithOverride := &pulumi.ResourceState{}
+			err = ctx.RegisterResource("pkgB:m:typB", "resBChildOverride", true,
+				map[string]interface{}{}, childWithOverride, pulumi.ResourceOpt{
 					Parent:   componentResource,
 					Provider: providerBOverride,
 				})
@@ -5623,3 +6104,167 @@ func TestProviderInheritanceGolangLifecycle(t *testing.T) {
 	}
 	p.Run(t, nil)
 }
+
+func TestUpdatePlan(t *testing.T) {
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				DiffF: func(urn resource.URN, id resource.ID,
+					olds, news resource.PropertyMap, ignoreChanges []string) (plugin.DiffResult, error) {
+
+					if olds["foo"].DeepEquals(news["foo"]) {
+						return plugin.DiffResult{Changes: plugin.DiffNone}, nil
+					}
+					return plugin.DiffResult{
+						Changes:     plugin.DiffSome,
+						ChangedKeys: []resource.PropertyKey{"foo"},
+					}, nil
+				},
+				CreateF: func(urn resource.URN,
+					news resource.PropertyMap, timeout float64) (resource.ID, resource.PropertyMap, resource.Status, error) {
+
+					return "created-id", news, resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	createB, inputs := false, resource.PropertyMap{"foo": resource.NewStringProperty("bar")}
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			Inputs: inputs,
+		})
+		assert.NoError(t, err)
+
+		if createB {
+			_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resB", true)
+			assert.NoError(t, err)
+		}
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{
+		Options: UpdateOptions{host: host},
+	}
+	resURN := p.NewURN("pkgA:m:typA", "resA", "")
+
+	// Create the resource without a plan.
+	project := p.GetProject()
+	snap, res := TestOp(Update).Run(project, p.GetTarget(nil), p.Options, false, p.BackendClient, nil)
+	assert.Nil(t, res)
+
+	// Preview a change to the resource's inputs, recording the plan.
+	inputs["foo"] = resource.NewStringProperty("baz")
+	plan := deploy.NewUpdatePlan()
+	recordOpts := p.Options
+	recordOpts.RecordPlan = plan
+	_, res = TestOp(Update).Run(project, p.GetTarget(snap), recordOpts, true, p.BackendClient, nil)
+	assert.Nil(t, res)
+	if assert.Contains(t, plan.ResourcePlans, resURN) {
+		rp := plan.ResourcePlans[resURN]
+		assert.Equal(t, []deploy.StepOp{deploy.OpUpdate}, rp.Ops)
+		assert.Equal(t, []resource.PropertyKey{"foo"}, rp.Diffs)
+		assert.Equal(t, "bar", rp.Olds["foo"].StringValue())
+		assert.Equal(t, "baz", rp.News["foo"].StringValue())
+	}
+
+	planOpts := p.Options
+	planOpts.Plan = plan
+	expectViolation := func(_ workspace.Project, _ deploy.Target, j *Journal, evts []Event,
+		res result.Result) result.Result {
+
+		sawViolation := false
+		for _, evt := range evts {
+			if evt.Type == DiagEvent {
+				e := evt.Payload.(DiagEventPayload)
+				msg := colors.Never.Colorize(e.Message)
+				sawViolation = sawViolation || strings.Contains(msg, "violates the update plan")
+			}
+		}
+		assert.True(t, sawViolation)
+		for _, entry := range j.Entries {
+			assert.NotEqual(t, deploy.OpCreate, entry.Step.Op())
+		}
+		return res
+	}
+
+	// An update that sets a different value than the planned one must fail.
+	inputs["foo"] = resource.NewStringProperty("qux")
+	_, res = TestOp(Update).Run(project, p.GetTarget(snap), planOpts, false, p.BackendClient, expectViolation)
+	assert.NotNil(t, res)
+
+	// So must an update that creates a resource that the plan doesn't include.
+	inputs["foo"], createB = resource.NewStringProperty("baz"), true
+	_, res = TestOp(Update).Run(project, p.GetTarget(snap), planOpts, false, p.BackendClient, expectViolation)
+	assert.NotNil(t, res)
+
+	// An update that matches the plan succeeds.
+	createB = false
+	snap, res = TestOp(Update).Run(project, p.GetTarget(snap), planOpts, false, p.BackendClient, nil)
+	assert.Nil(t, res)
+	assert.Len(t, snap.Resources, 2)
+	assert.Equal(t, "baz", snap.Resources[1].Inputs["foo"].StringValue())
+}
+
+func TestConstructRemoteComponent(t *testing.T) {
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				ConstructF: func(info plugin.ConstructInfo, typ tokens.Type, name tokens.QName, parent resource.URN,
+					inputs resource.PropertyMap, options plugin.ConstructOptions) (plugin.ConstructResult, error) {
+
+					assert.Equal(t, "test", info.Project)
+					assert.Equal(t, tokens.Type("pkgA:m:typComponent"), typ)
+					assert.Equal(t, resource.NewStringProperty("qux"), inputs["foo"])
+
+					// Register the component and its child with the engine's resource monitor.
+					monitor, err := deploytest.DialMonitor(info.MonitorAddress)
+					if err != nil {
+						return plugin.ConstructResult{}, err
+					}
+					defer contract.IgnoreClose(monitor)
+
+					urn, _, _, err := monitor.RegisterResource(typ, string(name), false, deploytest.ResourceOptions{
+						Parent:  parent,
+						Aliases: options.Aliases,
+						Protect: options.Protect,
+					})
+					if err != nil {
+						return plugin.ConstructResult{}, err
+					}
+					childURN, _, _, err := monitor.RegisterResource("pkgA:m:typA", string(name)+"-child", true,
+						deploytest.ResourceOptions{Parent: urn})
+					if err != nil {
+						return plugin.ConstructResult{}, err
+					}
+
+					return plugin.ConstructResult{
+						URN:                urn,
+						Outputs:            resource.PropertyMap{"bar": inputs["foo"]},
+						OutputDependencies: map[resource.PropertyKey][]resource.URN{"bar": {childURN}},
+					}, nil
+				},
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		urn, _, outs, err := monitor.RegisterResource("pkgA:m:typComponent", "comp", false, deploytest.ResourceOptions{
+			Inputs: resource.PropertyMap{"foo": resource.NewStringProperty("qux")},
+			Remote: true,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, resource.URN("urn:pulumi:test::test::pkgA:m:typComponent::comp"), urn)
+		assert.Equal(t, resource.PropertyMap{"bar": resource.NewStringProperty("qux")}, outs)
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	// The default provider, the component, and the component's child should all be registered.
+	p := &TestPlan{
+		Options: UpdateOptions{host: host},
+		Steps:   MakeBasicLifecycleSteps(t, 3),
+	}
+	p.Run(t, nil)
+}
@@ -97,6 +97,9 @@ type planOptions struct {
 	// true if we're planning a refresh.
 	isRefresh bool
 
+	// the resources to import, if we're planning an import.
+	imports []deploy.Import
+
 	// true if we should trust the dependency graph reported by the language host. Not all Pulumi-supported languages
 	// correctly report their dependencies, in which case this will be false.
 	trustDependencies bool
@@ -182,12 +185,20 @@ func (planResult *planResult) Walk(cancelCtx *Context, events deploy.Events, pre
 			Refresh:           planResult.Options.Refresh,
 			RefreshOnly:       planResult.Options.isRefresh,
 			RefreshTargets:    planResult.Options.RefreshTargets,
+			RefreshParallel:   planResult.Options.RefreshParallel,
+			RefreshTimeout:    planResult.Options.RefreshTimeout,
 			ReplaceTargets:    planResult.Options.ReplaceTargets,
 			DestroyTargets:    planResult.Options.DestroyTargets,
 			UpdateTargets:     planResult.Options.UpdateTargets,
 			TargetDependents:  planResult.Options.TargetDependents,
 			TrustDependencies: planResult.Options.trustDependencies,
 			UseLegacyDiff:     planResult.Options.UseLegacyDiff,
+			Imports:           planResult.Options.imports,
+			Plan:              planResult.Options.Plan,
+		}
+		// Update plans are only recorded by previews.
+		if preview {
+			opts.RecordPlan = planResult.Options.RecordPlan
 		}
 		walkResult = planResult.Plan.Execute(ctx, opts, preview)
 		close(done)
@@ -348,6 +359,12 @@ func (acts *planActions) OnPolicyViolation(urn resource.URN, d plugin.AnalyzeDia
 	acts.Opts.Events.policyViolationEvent(urn, d)
 }
 
+func (acts *planActions) OnPolicyRemediation(urn resource.URN, t plugin.Remediation,
+	before resource.PropertyMap, after resource.PropertyMap) {
+
+	acts.Opts.Events.policyRemediationEvent(urn, t, before, after)
+}
+
 func assertSeen(seen map[resource.URN]deploy.Step, step deploy.Step) {
 	_, has := seen[step.URN()]
 	contract.Assertf(has, "URN '%v' had not been marked as seen", step.URN())
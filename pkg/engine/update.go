@@ -65,6 +65,12 @@ type UpdateOptions struct {
 	// Specific resources to refresh during a refresh operation.
 	RefreshTargets []resource.URN
 
+	// the maximum number of concurrent reads per provider during a refresh (<=0 for no limit).
+	RefreshParallel int
+
+	// the maximum time to wait for each resource read during a refresh (0 for no limit).
+	RefreshTimeout time.Duration
+
 	// Specific resources to replace during an update operation.
 	ReplaceTargets []resource.URN
 
@@ -81,6 +87,12 @@ type UpdateOptions struct {
 	// true if the engine should use legacy diffing behavior during an update.
 	UseLegacyDiff bool
 
+	// an optional update plan that constrains the steps that the update may perform.
+	Plan *deploy.UpdatePlan
+
+	// an optional update plan in which a preview records the steps that it plans to perform.
+	RecordPlan *deploy.UpdatePlan
+
 	// true if we should report events for steps that involve default providers.
 	reportDefaultProviderSteps bool
 
@@ -436,3 +448,9 @@ func (acts *updateActions) OnResourceOutputs(step deploy.Step) error {
 func (acts *updateActions) OnPolicyViolation(urn resource.URN, d plugin.AnalyzeDiagnostic) {
 	acts.Opts.Events.policyViolationEvent(urn, d)
 }
+
+func (acts *updateActions) OnPolicyRemediation(urn resource.URN, t plugin.Remediation,
+	before resource.PropertyMap, after resource.PropertyMap) {
+
+	acts.Opts.Events.policyRemediationEvent(urn, t, before, after)
+}
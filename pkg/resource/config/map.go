@@ -44,6 +44,20 @@ func (m Map) Decrypt(decrypter Decrypter) (map[Key]string, error) {
 	return r, nil
 }
 
+// Copy returns a copy of this configuration in which every secure value has been decrypted using the given decrypter
+// and then re-encrypted using the given encrypter.
+func (m Map) Copy(decrypter Decrypter, encrypter Encrypter) (Map, error) {
+	r := Map{}
+	for k, c := range m {
+		copied, err := c.Copy(decrypter, encrypter)
+		if err != nil {
+			return nil, err
+		}
+		r[k] = copied
+	}
+	return r, nil
+}
+
 // HasSecureValue returns true if the config map contains a secure (encrypted) value.
 func (m Map) HasSecureValue() bool {
 	for _, v := range m {
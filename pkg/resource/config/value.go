@@ -72,6 +72,39 @@ func (c Value) Value(decrypter Decrypter) (string, error) {
 	return decrypter.DecryptValue(c.value)
 }
 
+// Copy returns a copy of this value in which every secure value has been decrypted using the given decrypter and then
+// re-encrypted using the given encrypter. This is used to move configuration from one secrets provider to another.
+func (c Value) Copy(decrypter Decrypter, encrypter Encrypter) (Value, error) {
+	if !c.secure {
+		return c, nil
+	}
+	if !c.object {
+		plaintext, err := decrypter.DecryptValue(c.value)
+		if err != nil {
+			return Value{}, err
+		}
+		ciphertext, err := encrypter.EncryptValue(plaintext)
+		if err != nil {
+			return Value{}, err
+		}
+		return NewSecureValue(ciphertext), nil
+	}
+
+	var obj interface{}
+	if err := json.Unmarshal([]byte(c.value), &obj); err != nil {
+		return Value{}, err
+	}
+	copied, err := reencryptObject(obj, decrypter, encrypter)
+	if err != nil {
+		return Value{}, err
+	}
+	json, err := json.Marshal(copied)
+	if err != nil {
+		return Value{}, err
+	}
+	return NewSecureObjectValue(string(json)), nil
+}
+
 func (c Value) SecureValues(decrypter Decrypter) ([]string, error) {
 	d := NewTrackingDecrypter(decrypter)
 	if _, err := c.Value(d); err != nil {
@@ -273,3 +306,43 @@ func decryptObject(v interface{}, decrypter Decrypter) (interface{}, error) {
 	}
 	return v, nil
 }
+
+// reencryptObject returns a new object with all secure values in the object decrypted using the given decrypter and
+// then re-encrypted using the given encrypter.
+func reencryptObject(v interface{}, decrypter Decrypter, encrypter Encrypter) (interface{}, error) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		if isSecure, secureVal := isSecureValue(t); isSecure {
+			plaintext, err := decrypter.DecryptValue(secureVal)
+			if err != nil {
+				return nil, err
+			}
+			ciphertext, err := encrypter.EncryptValue(plaintext)
+			if err != nil {
+				return nil, err
+			}
+			return map[string]interface{}{"secure": ciphertext}, nil
+		}
+
+		m := make(map[string]interface{})
+		for key, val := range t {
+			copied, err := reencryptObject(val, decrypter, encrypter)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = copied
+		}
+		return m, nil
+	case []interface{}:
+		a := make([]interface{}, len(t))
+		for i, val := range t {
+			copied, err := reencryptObject(val, decrypter, encrypter)
+			if err != nil {
+				return nil, err
+			}
+			a[i] = copied
+		}
+		return a, nil
+	}
+	return v, nil
+}
@@ -17,8 +17,10 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	yaml "gopkg.in/yaml.v2"
 )
@@ -264,3 +266,60 @@ func roundtripValue(v Value, marshal func(v interface{}) ([]byte, error),
 	err = unmarshal(b, &newV)
 	return newV, err
 }
+
+type prefixCrypter struct {
+	prefix string
+}
+
+func (c prefixCrypter) EncryptValue(plaintext string) (string, error) {
+	return c.prefix + plaintext, nil
+}
+
+func (c prefixCrypter) DecryptValue(ciphertext string) (string, error) {
+	if !strings.HasPrefix(ciphertext, c.prefix) {
+		return "", errors.Errorf("%q was not encrypted by this crypter", ciphertext)
+	}
+	return strings.TrimPrefix(ciphertext, c.prefix), nil
+}
+
+func TestCopyingValue(t *testing.T) {
+	tests := []struct {
+		Value    Value
+		Expected Value
+	}{
+		{
+			Value:    NewValue("value"),
+			Expected: NewValue("value"),
+		},
+		{
+			Value:    NewObjectValue(`{"foo":"bar"}`),
+			Expected: NewObjectValue(`{"foo":"bar"}`),
+		},
+		{
+			Value:    NewSecureValue("old:value"),
+			Expected: NewSecureValue("new:value"),
+		},
+		{
+			Value:    NewSecureObjectValue(`{"foo":{"secure":"old:value"}}`),
+			Expected: NewSecureObjectValue(`{"foo":{"secure":"new:value"}}`),
+		},
+		{
+			Value:    NewSecureObjectValue(`["a",{"secure":"old:value"}]`),
+			Expected: NewSecureObjectValue(`["a",{"secure":"new:value"}]`),
+		},
+	}
+
+	decrypter, encrypter := prefixCrypter{prefix: "old:"}, prefixCrypter{prefix: "new:"}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v", test.Value), func(t *testing.T) {
+			actual, err := test.Value.Copy(decrypter, encrypter)
+			assert.NoError(t, err)
+			assert.Equal(t, test.Expected, actual)
+		})
+	}
+
+	// Values that the decrypter can't decrypt are an error.
+	_, err := NewSecureValue("other:value").Copy(decrypter, encrypter)
+	assert.Error(t, err)
+}
@@ -127,7 +127,7 @@ func (p *builtinProvider) Delete(urn resource.URN, id resource.ID,
 }
 
 func (p *builtinProvider) Read(urn resource.URN, id resource.ID,
-	inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+	inputs, state resource.PropertyMap, timeout float64) (plugin.ReadResult, resource.Status, error) {
 
 	contract.Assert(urn.Type() == stackReferenceType)
 
@@ -145,6 +145,11 @@ func (p *builtinProvider) Read(urn resource.URN, id resource.ID,
 const readStackOutputs = "pulumi:pulumi:readStackOutputs"
 const readStackResourceOutputs = "pulumi:pulumi:readStackResourceOutputs"
 
+func (p *builtinProvider) Construct(info plugin.ConstructInfo, typ tokens.Type, name tokens.QName, parent resource.URN,
+	inputs resource.PropertyMap, options plugin.ConstructOptions) (plugin.ConstructResult, error) {
+	return plugin.ConstructResult{}, errors.New("builtin resources may not be constructed")
+}
+
 func (p *builtinProvider) Invoke(tok tokens.ModuleMember,
 	args resource.PropertyMap) (resource.PropertyMap, []plugin.CheckFailure, error) {
 
@@ -173,6 +178,11 @@ func (p *builtinProvider) StreamInvoke(
 	return nil, fmt.Errorf("the builtin provider does not implement streaming invokes")
 }
 
+func (p *builtinProvider) GetSchema(version int) ([]byte, error) {
+	// return an error: this should not be called for the builtin provider
+	return nil, errors.New("the builtin provider does not report a schema")
+}
+
 func (p *builtinProvider) GetPluginInfo() (workspace.PluginInfo, error) {
 	// return an error: this should not be called for the builtin provider
 	return workspace.PluginInfo{}, errors.New("the builtin provider does not report plugin info")
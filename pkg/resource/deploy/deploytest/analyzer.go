@@ -0,0 +1,73 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploytest
+
+import (
+	"github.com/blang/semver"
+
+	"github.com/pulumi/pulumi/pkg/resource/plugin"
+	"github.com/pulumi/pulumi/pkg/tokens"
+	"github.com/pulumi/pulumi/pkg/workspace"
+)
+
+type Analyzer struct {
+	Info    plugin.AnalyzerInfo
+	Version semver.Version
+
+	AnalyzeF      func(r plugin.AnalyzerResource) ([]plugin.AnalyzeDiagnostic, error)
+	AnalyzeStackF func(resources []plugin.AnalyzerResource) ([]plugin.AnalyzeDiagnostic, error)
+	RemediateF    func(r plugin.AnalyzerResource) ([]plugin.Remediation, error)
+}
+
+func (a *Analyzer) Close() error {
+	return nil
+}
+
+func (a *Analyzer) Name() tokens.QName {
+	return tokens.QName(a.Info.Name)
+}
+
+func (a *Analyzer) Analyze(r plugin.AnalyzerResource) ([]plugin.AnalyzeDiagnostic, error) {
+	if a.AnalyzeF == nil {
+		return nil, nil
+	}
+	return a.AnalyzeF(r)
+}
+
+func (a *Analyzer) AnalyzeStack(resources []plugin.AnalyzerResource) ([]plugin.AnalyzeDiagnostic, error) {
+	if a.AnalyzeStackF == nil {
+		return nil, nil
+	}
+	return a.AnalyzeStackF(resources)
+}
+
+func (a *Analyzer) Remediate(r plugin.AnalyzerResource) ([]plugin.Remediation, error) {
+	if a.RemediateF == nil {
+		return nil, nil
+	}
+	return a.RemediateF(r)
+}
+
+func (a *Analyzer) GetAnalyzerInfo() (plugin.AnalyzerInfo, error) {
+	return a.Info, nil
+}
+
+func (a *Analyzer) GetPluginInfo() (workspace.PluginInfo, error) {
+	return workspace.PluginInfo{
+		Name:    a.Info.Name,
+		Kind:    workspace.AnalyzerPlugin,
+		Version: &a.Version,
+	}, nil
+}
@@ -15,13 +15,9 @@
 package deploytest
 
 import (
-	"github.com/pkg/errors"
-	"google.golang.org/grpc"
-
 	"github.com/pulumi/pulumi/pkg/resource/plugin"
 	"github.com/pulumi/pulumi/pkg/util/contract"
 	"github.com/pulumi/pulumi/pkg/workspace"
-	pulumirpc "github.com/pulumi/pulumi/sdk/proto/go"
 )
 
 type ProgramFunc func(runInfo plugin.RunInfo, monitor *ResourceMonitor) error
@@ -47,20 +43,16 @@ func (p *languageRuntime) GetRequiredPlugins(info plugin.ProgInfo) ([]workspace.
 }
 
 func (p *languageRuntime) Run(info plugin.RunInfo) (string, bool, error) {
-	// Connect to the resource monitor and create an appropriate client.
-	conn, err := grpc.Dial(info.MonitorAddress, grpc.WithInsecure())
+	monitor, err := DialMonitor(info.MonitorAddress)
 	if err != nil {
-		return "", false, errors.Wrapf(err, "could not connect to resource monitor")
+		return "", false, err
 	}
-	defer contract.IgnoreClose(conn)
-
-	// Fire up a resource monitor client
-	resmon := pulumirpc.NewResourceMonitorClient(conn)
+	defer contract.IgnoreClose(monitor)
 
 	// Run the program.
 	done := make(chan error)
 	go func() {
-		done <- p.program(info, &ResourceMonitor{resmon: resmon})
+		done <- p.program(info, monitor)
 	}()
 	if progerr := <-done; progerr != nil {
 		return progerr.Error(), false, nil
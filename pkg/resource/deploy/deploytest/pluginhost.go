@@ -58,6 +58,7 @@ func NewProviderLoaderWithHost(pkg tokens.Package, version semver.Version,
 type pluginHost struct {
 	providerLoaders []*ProviderLoader
 	languageRuntime plugin.LanguageRuntime
+	analyzers       []plugin.Analyzer
 	sink            diag.Sink
 	statusSink      diag.Sink
 
@@ -69,9 +70,16 @@ type pluginHost struct {
 func NewPluginHost(sink, statusSink diag.Sink, languageRuntime plugin.LanguageRuntime,
 	providerLoaders ...*ProviderLoader) plugin.Host {
 
+	return NewPluginHostWithAnalyzers(sink, statusSink, languageRuntime, nil, providerLoaders...)
+}
+
+func NewPluginHostWithAnalyzers(sink, statusSink diag.Sink, languageRuntime plugin.LanguageRuntime,
+	analyzers []plugin.Analyzer, providerLoaders ...*ProviderLoader) plugin.Host {
+
 	return &pluginHost{
 		providerLoaders: providerLoaders,
 		languageRuntime: languageRuntime,
+		analyzers:       analyzers,
 		sink:            sink,
 		statusSink:      statusSink,
 		providers:       make(map[plugin.Provider]struct{}),
@@ -160,6 +168,11 @@ func (host *pluginHost) LogStatus(sev diag.Severity, urn resource.URN, msg strin
 	}
 }
 func (host *pluginHost) Analyzer(nm tokens.QName) (plugin.Analyzer, error) {
+	for _, a := range host.analyzers {
+		if a.Name() == nm {
+			return a, nil
+		}
+	}
 	return nil, errors.New("unsupported")
 }
 func (host *pluginHost) CloseProvider(provider plugin.Provider) error {
@@ -185,5 +198,5 @@ func (host *pluginHost) PolicyAnalyzer(name tokens.QName, path string) (plugin.A
 }
 
 func (host *pluginHost) ListAnalyzers() []plugin.Analyzer {
-	return nil
+	return host.analyzers
 }
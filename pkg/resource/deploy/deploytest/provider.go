@@ -52,10 +52,15 @@ type Provider struct {
 	DeleteF func(urn resource.URN, id resource.ID, olds resource.PropertyMap, timeout float64) (resource.Status, error)
 
 	ReadF func(urn resource.URN, id resource.ID,
-		inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error)
+		inputs, state resource.PropertyMap, timeout float64) (plugin.ReadResult, resource.Status, error)
 	InvokeF func(tok tokens.ModuleMember,
 		inputs resource.PropertyMap) (resource.PropertyMap, []plugin.CheckFailure, error)
 
+	ConstructF func(info plugin.ConstructInfo, typ tokens.Type, name tokens.QName, parent resource.URN,
+		inputs resource.PropertyMap, options plugin.ConstructOptions) (plugin.ConstructResult, error)
+
+	GetSchemaF func(version int) ([]byte, error)
+
 	CancelF func() error
 }
 
@@ -81,6 +86,13 @@ func (prov *Provider) GetPluginInfo() (workspace.PluginInfo, error) {
 	}, nil
 }
 
+func (prov *Provider) GetSchema(version int) ([]byte, error) {
+	if prov.GetSchemaF == nil {
+		return []byte("{}"), nil
+	}
+	return prov.GetSchemaF(version)
+}
+
 func (prov *Provider) CheckConfig(urn resource.URN, olds,
 	news resource.PropertyMap, allowUnknowns bool) (resource.PropertyMap, []plugin.CheckFailure, error) {
 	if prov.CheckConfigF == nil {
@@ -143,15 +155,23 @@ func (prov *Provider) Delete(urn resource.URN,
 }
 
 func (prov *Provider) Read(urn resource.URN, id resource.ID,
-	inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+	inputs, state resource.PropertyMap, timeout float64) (plugin.ReadResult, resource.Status, error) {
 	if prov.ReadF == nil {
 		return plugin.ReadResult{
 			Outputs: resource.PropertyMap{},
 			Inputs:  resource.PropertyMap{},
 		}, resource.StatusUnknown, nil
 	}
-	return prov.ReadF(urn, id, inputs, state)
+	return prov.ReadF(urn, id, inputs, state, timeout)
+}
+func (prov *Provider) Construct(info plugin.ConstructInfo, typ tokens.Type, name tokens.QName, parent resource.URN,
+	inputs resource.PropertyMap, options plugin.ConstructOptions) (plugin.ConstructResult, error) {
+	if prov.ConstructF == nil {
+		return plugin.ConstructResult{}, nil
+	}
+	return prov.ConstructF(info, typ, name, parent, inputs, options)
 }
+
 func (prov *Provider) Invoke(tok tokens.ModuleMember,
 	args resource.PropertyMap) (resource.PropertyMap, []plugin.CheckFailure, error) {
 	if prov.InvokeF == nil {
@@ -18,6 +18,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
 	"github.com/pulumi/pulumi/pkg/resource"
 	"github.com/pulumi/pulumi/pkg/resource/plugin"
 	"github.com/pulumi/pulumi/pkg/tokens"
@@ -25,9 +28,30 @@ import (
 )
 
 type ResourceMonitor struct {
+	conn   *grpc.ClientConn
 	resmon pulumirpc.ResourceMonitorClient
 }
 
+// DialMonitor connects to the resource monitor at the given address.
+func DialMonitor(endpoint string) (*ResourceMonitor, error) {
+	// Connect to the resource monitor and create an appropriate client.
+	conn, err := grpc.Dial(endpoint, grpc.WithInsecure())
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not connect to resource monitor")
+	}
+
+	// Fire up a resource monitor client and return.
+	return &ResourceMonitor{
+		conn:   conn,
+		resmon: pulumirpc.NewResourceMonitorClient(conn),
+	}, nil
+}
+
+// Close closes the connection to the resource monitor.
+func (rm *ResourceMonitor) Close() error {
+	return rm.conn.Close()
+}
+
 type ResourceOptions struct {
 	Parent                resource.URN
 	Protect               bool
@@ -42,6 +66,9 @@ type ResourceOptions struct {
 	ImportID              resource.ID
 	CustomTimeouts        *resource.CustomTimeouts
 	SupportsPartialValues *bool
+	Remote                bool
+	ReplaceOnChanges      []string
+	RetainOnDelete        bool
 }
 
 func (rm *ResourceMonitor) RegisterResource(t tokens.Type, name string, custom bool,
@@ -89,6 +116,7 @@ func (rm *ResourceMonitor) RegisterResource(t tokens.Type, name string, custom b
 		timeouts.Create = prepareTestTimeout(opts.CustomTimeouts.Create)
 		timeouts.Update = prepareTestTimeout(opts.CustomTimeouts.Update)
 		timeouts.Delete = prepareTestTimeout(opts.CustomTimeouts.Delete)
+		timeouts.Read = prepareTestTimeout(opts.CustomTimeouts.Read)
 	}
 
 	deleteBeforeReplace := false
@@ -117,6 +145,9 @@ func (rm *ResourceMonitor) RegisterResource(t tokens.Type, name string, custom b
 		ImportId:                   string(opts.ImportID),
 		CustomTimeouts:             &timeouts,
 		SupportsPartialValues:      supportsPartialValues,
+		Remote:                     opts.Remote,
+		ReplaceOnChanges:           opts.ReplaceOnChanges,
+		RetainOnDelete:             opts.RetainOnDelete,
 	}
 
 	// submit request
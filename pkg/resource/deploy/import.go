@@ -0,0 +1,285 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"context"
+
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/diag"
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/deploy/providers"
+	"github.com/pulumi/pulumi/pkg/tokens"
+	"github.com/pulumi/pulumi/pkg/util/contract"
+	"github.com/pulumi/pulumi/pkg/util/logging"
+	"github.com/pulumi/pulumi/pkg/util/result"
+)
+
+// Import specifies a resource to import.
+type Import struct {
+	Type     tokens.Type     // The type token for the resource. Required.
+	Name     tokens.QName    // The name of the resource. Required.
+	ID       resource.ID     // The ID of the resource. Required.
+	Parent   resource.URN    // The parent of the resource, if any.
+	Provider resource.URN    // The specific provider to use for the resource, if any.
+	Version  *semver.Version // The provider version to use for the resource, if any.
+	Protect  bool            // Whether to mark the imported resource as protected.
+}
+
+// noopEvent is a RegisterResourceEvent for steps that are not driven by a program, and therefore have no one waiting
+// to hear about their results.
+type noopEvent int
+
+func (noopEvent) event()                      {}
+func (noopEvent) Goal() *resource.Goal        { return nil }
+func (noopEvent) Done(result *RegisterResult) {}
+
+// importer drives the steps necessary to import a list of resources into a plan's snapshot without running a program.
+type importer struct {
+	plan     *Plan
+	executor *stepExecutor
+	preview  bool
+}
+
+// importResources imports the resources listed in the plan options into the current snapshot. Existing resources are
+// left untouched.
+func (pe *planExecutor) importResources(callerCtx context.Context, opts Options, preview bool) result.Result {
+	ctx, cancel := context.WithCancel(callerCtx)
+	stepExec := newStepExecutor(ctx, cancel, pe.plan, opts, preview, true)
+
+	imp := &importer{plan: pe.plan, executor: stepExec, preview: preview}
+	res := imp.importResources(ctx, opts.Imports)
+
+	stepExec.SignalCompletion()
+	stepExec.WaitForCompletion()
+
+	// NOTE: we use the presence of an error in the caller context in order to distinguish caller-initiated
+	// cancellation from internally-initiated cancellation.
+	canceled := callerCtx.Err() != nil
+
+	if res != nil || stepExec.Errored() {
+		if res != nil && res.Error() != nil {
+			pe.reportError("", res.Error())
+		}
+		pe.reportExecResult("failed", preview)
+		return result.Bail()
+	} else if canceled {
+		pe.reportExecResult("canceled", preview)
+		return result.Bail()
+	}
+	return nil
+}
+
+func (i *importer) wait(ctx context.Context, tok completionToken) bool {
+	tok.Wait(ctx)
+	return ctx.Err() == nil && !i.executor.Errored()
+}
+
+func (i *importer) importResources(ctx context.Context, imports []Import) result.Result {
+	// Record every existing resource in the snapshot first. We issue these serially so that the resources remain in
+	// the order in which they appear in the state, which keeps the snapshot's topological sort intact.
+	if !i.registerExistingResources(ctx) {
+		return nil
+	}
+
+	// Ensure that every resource we import has a provider to read it with.
+	refs, ok, res := i.registerProviders(ctx, imports)
+	if res != nil || !ok {
+		return res
+	}
+
+	// Finally, issue the imports themselves. These are independent of each other, so they may run in parallel.
+	urns := make(map[resource.URN]bool)
+	steps := make([]Step, 0, len(imports))
+	for idx, imp := range imports {
+		parent := imp.Parent
+		if parent == "" {
+			parent = i.rootStackURN()
+		}
+		urn := i.plan.generateURN(parent, imp.Type, imp.Name)
+		if urns[urn] {
+			return result.Errorf("cannot import the same resource '%v' twice", urn)
+		}
+		urns[urn] = true
+		if _, has := i.plan.olds[urn]; has {
+			return result.Errorf("resource '%v' already exists", urn)
+		}
+
+		state := resource.NewState(imp.Type, urn, true, false, imp.ID, resource.PropertyMap{}, nil, parent,
+			imp.Protect, false, nil, nil, refs[idx].String(), nil, false, nil, nil, nil, false)
+		steps = append(steps, newImportPlanStep(i.plan, noopEvent(0), state))
+	}
+
+	i.wait(ctx, i.executor.ExecuteParallel(steps))
+	return nil
+}
+
+// rootStackURN returns the URN of the stack's root resource, if it exists in the snapshot.
+func (i *importer) rootStackURN() resource.URN {
+	if i.plan.prev == nil {
+		return ""
+	}
+	for _, res := range i.plan.prev.Resources {
+		if res.Type == resource.RootStackType && res.Parent == "" && !res.Delete {
+			return res.URN
+		}
+	}
+	return ""
+}
+
+func (i *importer) registerExistingResources(ctx context.Context) bool {
+	if i.plan.prev == nil {
+		return true
+	}
+
+	steps := make([]Step, 0, len(i.plan.prev.Resources))
+	for _, res := range i.plan.prev.Resources {
+		// Resources that are pending deletion are carried over from the base snapshot as-is.
+		if res.Delete {
+			continue
+		}
+		new := *res
+		new.ID = ""
+		steps = append(steps, NewSameStep(i.plan, noopEvent(0), res, &new))
+	}
+	return i.wait(ctx, i.executor.ExecuteSerial(steps))
+}
+
+// registerProviders returns a provider reference for each import, creating default providers as necessary.
+func (i *importer) registerProviders(ctx context.Context,
+	imports []Import) ([]providers.Reference, bool, result.Result) {
+
+	refs := make([]providers.Reference, len(imports))
+
+	defaultProviders := make(map[string]*resource.State)
+	var steps []Step
+	var pending []int
+	for idx, imp := range imports {
+		if imp.Type == "" || imp.Name == "" || imp.ID == "" {
+			return nil, false, result.Errorf("import %d is missing a type, name or ID", idx)
+		}
+		if providers.IsProviderType(imp.Type) {
+			return nil, false, result.Errorf("provider resource '%v' may not be imported", imp.Name)
+		}
+		if imp.Parent != "" {
+			if _, has := i.plan.olds[imp.Parent]; !has {
+				return nil, false, result.Errorf("unknown parent '%v' for resource '%v'", imp.Parent, imp.Name)
+			}
+		}
+
+		// If the import names a specific provider, it must already exist in the stack.
+		if imp.Provider != "" {
+			prov, has := i.plan.olds[imp.Provider]
+			if !has || !providers.IsProviderType(prov.Type) {
+				return nil, false, result.Errorf("unknown provider '%v' for resource '%v'", imp.Provider, imp.Name)
+			}
+			ref, err := providers.NewReference(prov.URN, prov.ID)
+			contract.Assert(err == nil)
+			refs[idx] = ref
+			continue
+		}
+
+		// Otherwise, use the default provider for the resource's package, creating it if necessary.
+		pkg := imp.Type.Package()
+		urn := defaultProviderURN(i.plan.target, i.plan.source, pkg)
+		if old, has := i.plan.olds[urn]; has && i.versionMatches(old, imp.Version) {
+			ref, err := providers.NewReference(old.URN, old.ID)
+			contract.Assert(err == nil)
+			refs[idx] = ref
+			continue
+		}
+		if _, has := i.plan.olds[urn]; has {
+			return nil, false, result.Errorf("the default provider for package '%v' does not match the requested "+
+				"version %v; please specify an explicit provider for '%v'", pkg, imp.Version, imp.Name)
+		}
+
+		key := string(pkg)
+		if _, has := defaultProviders[key]; !has {
+			inputs, err := i.defaultProviderInputs(pkg, imp.Version)
+			if err != nil {
+				return nil, false, result.FromError(err)
+			}
+			state := resource.NewState(urn.Type(), urn, true, false, "", inputs, nil, "", false, false, nil, nil, "",
+				nil, false, nil, nil, nil, false)
+			checked, failures, err := i.plan.providers.Check(urn, nil, inputs, false)
+			if err != nil {
+				return nil, false, result.FromError(err)
+			}
+			if issueCheckErrors(i.plan, state, urn, failures) {
+				return nil, false, result.Bail()
+			}
+			state.Inputs = checked
+
+			defaultProviders[key] = state
+			steps = append(steps, NewCreateStep(i.plan, noopEvent(0), state))
+		}
+		pending = append(pending, idx)
+	}
+
+	if len(steps) != 0 && !i.wait(ctx, i.executor.ExecuteParallel(steps)) {
+		return nil, false, nil
+	}
+
+	for _, idx := range pending {
+		state := defaultProviders[string(imports[idx].Type.Package())]
+		id := state.ID
+		if id == "" {
+			contract.Assert(i.preview)
+			id = providers.UnknownID
+		}
+		ref, err := providers.NewReference(state.URN, id)
+		contract.Assert(err == nil)
+		refs[idx] = ref
+	}
+
+	logging.V(7).Infof("importer.registerProviders(...): created %d default provider(s)", len(steps))
+	return refs, true, nil
+}
+
+// versionMatches returns true if the given provider satisfies the requested version, if any.
+func (i *importer) versionMatches(provider *resource.State, version *semver.Version) bool {
+	if version == nil {
+		return true
+	}
+	current, err := providers.GetProviderVersion(provider.Inputs)
+	return err == nil && current != nil && current.EQ(*version)
+}
+
+// defaultProviderInputs computes the inputs for the default provider of the given package from the stack's
+// configuration.
+func (i *importer) defaultProviderInputs(pkg tokens.Package, version *semver.Version) (resource.PropertyMap, error) {
+	cfg, err := i.plan.target.GetPackageConfig(pkg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not fetch configuration for default provider '%v'", pkg)
+	}
+
+	inputs := make(resource.PropertyMap)
+	for k, v := range cfg {
+		inputs[resource.PropertyKey(k.Name())] = resource.NewStringProperty(v)
+	}
+	if version != nil {
+		inputs["version"] = resource.NewStringProperty(version.String())
+	}
+	return inputs, nil
+}
+
+// warnImportMismatch reports that the inputs the provider returned for an imported resource do not round-trip.
+func warnImportMismatch(plan *Plan, urn resource.URN) {
+	plan.ctx.Diag.Warningf(diag.StreamMessage(urn,
+		"the inputs read for this resource differ from its checked inputs; "+
+			"the next update may report changes for it", 0))
+}
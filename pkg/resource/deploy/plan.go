@@ -17,6 +17,7 @@ package deploy
 import (
 	"context"
 	"math"
+	"time"
 
 	"github.com/blang/semver"
 	"github.com/pkg/errors"
@@ -52,12 +53,17 @@ type Options struct {
 	Refresh           bool           // whether or not to refresh before executing the plan.
 	RefreshOnly       bool           // whether or not to exit after refreshing.
 	RefreshTargets    []resource.URN // The specific resources to refresh during a refresh op.
+	RefreshParallel   int            // the maximum number of concurrent reads per provider (<=0 for no limit).
+	RefreshTimeout    time.Duration  // the maximum time to wait for each read in a refresh (0 for no limit).
 	ReplaceTargets    []resource.URN // Specific resources to replace.
 	DestroyTargets    []resource.URN // Specific resources to destroy.
 	UpdateTargets     []resource.URN // Specific resources to update.
 	TargetDependents  bool           // true if we're allowing things to proceed, even with unspecified targets
 	TrustDependencies bool           // whether or not to trust the resource dependency graph.
 	UseLegacyDiff     bool           // whether or not to use legacy diffing behavior.
+	Imports           []Import       // resources to import, if this is an import operation.
+	Plan              *UpdatePlan    // an optional plan that constrains the steps an update may perform.
+	RecordPlan        *UpdatePlan    // an optional plan in which to record the steps that are performed.
 }
 
 // DegreeOfParallelism returns the degree of parallelism that should be used during the
@@ -81,9 +87,10 @@ type StepExecutorEvents interface {
 	OnResourceOutputs(step Step) error
 }
 
-// PolicyEvents is an interface that can be used to hook policy violation events.
+// PolicyEvents is an interface that can be used to hook policy violation and remediation events.
 type PolicyEvents interface {
 	OnPolicyViolation(resource.URN, plugin.AnalyzeDiagnostic)
+	OnPolicyRemediation(urn resource.URN, t plugin.Remediation, before resource.PropertyMap, after resource.PropertyMap)
 }
 
 // Events is an interface that can be used to hook interesting engine/planning events.
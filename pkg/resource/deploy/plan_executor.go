@@ -16,6 +16,7 @@ package deploy
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -142,6 +143,11 @@ func (pe *planExecutor) Execute(callerCtx context.Context, opts Options, preview
 		}
 	}
 
+	// If we were asked to import resources, do so without evaluating the source.
+	if len(opts.Imports) != 0 {
+		return pe.importResources(callerCtx, opts, preview)
+	}
+
 	// The set of -t targets provided on hte command line.  'nil' means 'update everything'.
 	// Non-nill means 'update only in this set'.  We don't error if the user specifies an target
 	// during `update` that we don't know about because it might be the urn for a resource they
@@ -318,6 +324,11 @@ func (pe *planExecutor) performDeletes(
 		return res
 	}
 
+	if err := pe.applyUpdatePlan(deleteSteps); err != nil {
+		pe.reportError("", err)
+		return result.Bail()
+	}
+
 	deletes := pe.stepGen.ScheduleDeletes(deleteSteps)
 
 	// ScheduleDeletes gives us a list of lists of steps. Each list of steps can safely be executed
@@ -371,11 +382,33 @@ func (pe *planExecutor) handleSingleEvent(event SourceEvent) result.Result {
 	if res != nil {
 		return res
 	}
+	if err := pe.applyUpdatePlan(steps); err != nil {
+		return result.FromError(err)
+	}
 
 	pe.stepExec.ExecuteSerial(steps)
 	return nil
 }
 
+// applyUpdatePlan checks the given steps against the update plan that constrains this plan's execution, if any, and
+// records them in the update plan that is being recorded, if any. Steps are only recorded if all of them are
+// permitted.
+func (pe *planExecutor) applyUpdatePlan(steps []Step) error {
+	if constraints := pe.stepGen.opts.Plan; constraints != nil {
+		for _, step := range steps {
+			if err := constraints.checkStep(step); err != nil {
+				return err
+			}
+		}
+	}
+	if record := pe.stepGen.opts.RecordPlan; record != nil {
+		for _, step := range steps {
+			record.recordStep(step)
+		}
+	}
+	return nil
+}
+
 // retirePendingDeletes deletes all resources that are pending deletion. Run before the start of a plan, this pass
 // ensures that the engine never sees any resources that are pending deletion from a previous plan.
 //
@@ -435,17 +468,24 @@ func (pe *planExecutor) refresh(callerCtx context.Context, opts Options, preview
 	// If the user did not provide any --target's, create a refresh step for each resource in the
 	// old snapshot.  If they did provider --target's then only create refresh steps for those
 	// specific targets.
+	//
+	// Reads are bounded by the overall degree of parallelism, by the per-provider limit on concurrent reads, and by
+	// the read timeout, if any.
+	limiter := newReadLimiter(opts.RefreshParallel)
 	steps := []Step{}
+	refreshSteps := []*RefreshStep{}
 	resourceToStep := map[*resource.State]Step{}
 	for _, res := range prev.Resources {
 		if targetMapOpt == nil || targetMapOpt[res.URN] {
-			step := NewRefreshStep(pe.plan, res, nil)
+			step := newBoundedRefreshStep(pe.plan, res, limiter, opts.RefreshTimeout)
 			steps = append(steps, step)
+			refreshSteps = append(refreshSteps, step)
 			resourceToStep[res] = step
 		}
 	}
 
-	// Fire up a worker pool and issue each refresh in turn.
+	// Fire up a worker pool and issue each refresh in turn. Failed reads do not stop the refresh; instead, they are
+	// summarized once every read has completed.
 	ctx, cancel := context.WithCancel(callerCtx)
 	stepExec := newStepExecutor(ctx, cancel, pe.plan, opts, preview, true)
 	stepExec.ExecuteParallel(steps)
@@ -453,6 +493,7 @@ func (pe *planExecutor) refresh(callerCtx context.Context, opts Options, preview
 	stepExec.WaitForCompletion()
 
 	pe.rebuildBaseState(resourceToStep, true /*refresh*/)
+	pe.reportRefreshFailures(refreshSteps)
 
 	// NOTE: we use the presence of an error in the caller context in order to distinguish caller-initiated
 	// cancellation from internally-initiated cancellation.
@@ -468,6 +509,22 @@ func (pe *planExecutor) refresh(callerCtx context.Context, opts Options, preview
 	return nil
 }
 
+// reportRefreshFailures issues a single error that lists each resource whose refresh failed or timed out, if any.
+func (pe *planExecutor) reportRefreshFailures(steps []*RefreshStep) {
+	var failures []string
+	for _, step := range steps {
+		if err := step.Err(); err != nil {
+			failures = append(failures, fmt.Sprintf("    * %s: %v", step.URN(), err))
+		}
+	}
+	if len(failures) == 0 {
+		return
+	}
+
+	msg := fmt.Sprintf("refresh failed for %d resource(s):\n%s", len(failures), strings.Join(failures, "\n"))
+	pe.plan.Diag().Errorf(diag.RawMessage("", msg))
+}
+
 func (pe *planExecutor) rebuildBaseState(resourceToStep map[*resource.State]Step, refresh bool) {
 	// Rebuild this plan's map of old resources and dependency graph, stripping out any deleted
 	// resources and repairing dependency lists as necessary. Note that this updates the base
@@ -95,7 +95,7 @@ func (src *evalSource) Iterate(
 	regChan := make(chan *registerResourceEvent)
 	regOutChan := make(chan *registerResourceOutputsEvent)
 	regReadChan := make(chan *readResourceEvent)
-	mon, err := newResourceMonitor(src, providers, regChan, regOutChan, regReadChan, tracingSpan)
+	mon, err := newResourceMonitor(src, providers, regChan, regOutChan, regReadChan, opts, tracingSpan)
 	if err != nil {
 		return nil, result.FromError(errors.Wrap(err, "failed to start resource monitor"))
 	}
@@ -300,7 +300,7 @@ func (d *defaultProviders) newRegisterDefaultProviderEvent(
 	event := &registerResourceEvent{
 		goal: resource.NewGoal(
 			providers.MakeProviderType(req.Package()),
-			req.Name(), true, inputs, "", false, nil, "", nil, nil, nil, nil, nil, nil, "", nil),
+			req.Name(), true, inputs, "", false, nil, "", nil, nil, nil, nil, nil, nil, "", nil, nil, false),
 		done: done,
 	}
 	return event, done, nil
@@ -391,6 +391,8 @@ func (d *defaultProviders) getDefaultProviderRef(req providers.ProviderRequest)
 // resmon implements the pulumirpc.ResourceMonitor interface and acts as the gateway between a language runtime's
 // evaluation of a program and the internal resource planning and deployment logic.
 type resmon struct {
+	src              *evalSource                        // the evaluation source.
+	parallel         int                                // the degree of parallelism for resource operations.
 	providers        ProviderSource                     // the provider source itself.
 	defaultProviders *defaultProviders                  // the default provider manager.
 	regChan          chan *registerResourceEvent        // the channel to send resource registrations to.
@@ -405,7 +407,7 @@ var _ SourceResourceMonitor = (*resmon)(nil)
 
 // newResourceMonitor creates a new resource monitor RPC server.
 func newResourceMonitor(src *evalSource, provs ProviderSource, regChan chan *registerResourceEvent,
-	regOutChan chan *registerResourceOutputsEvent, regReadChan chan *readResourceEvent,
+	regOutChan chan *registerResourceOutputsEvent, regReadChan chan *readResourceEvent, opts Options,
 	tracingSpan opentracing.Span) (*resmon, error) {
 
 	// Create our cancellation channel.
@@ -423,6 +425,8 @@ func newResourceMonitor(src *evalSource, provs ProviderSource, regChan chan *reg
 
 	// New up an engine RPC server.
 	resmon := &resmon{
+		src:              src,
+		parallel:         opts.Parallel,
 		providers:        provs,
 		defaultProviders: d,
 		regChan:          regChan,
@@ -685,6 +689,8 @@ func (rm *resmon) RegisterResource(ctx context.Context,
 	protect := req.GetProtect()
 	deleteBeforeReplaceValue := req.GetDeleteBeforeReplace()
 	ignoreChanges := req.GetIgnoreChanges()
+	replaceOnChanges := req.GetReplaceOnChanges()
+	retainOnDelete := req.GetRetainOnDelete()
 	id := resource.ID(req.GetImportId())
 	customTimeouts := req.GetCustomTimeouts()
 	var t tokens.Type
@@ -783,6 +789,13 @@ func (rm *resmon) RegisterResource(ctx context.Context,
 			}
 			timeouts.Update = seconds
 		}
+		if customTimeouts.Read != "" {
+			seconds, err := generateTimeoutInSeconds(customTimeouts.Read)
+			if err != nil {
+				return nil, err
+			}
+			timeouts.Read = seconds
+		}
 	}
 
 	var deleteBeforeReplace *bool
@@ -790,16 +803,29 @@ func (rm *resmon) RegisterResource(ctx context.Context,
 		deleteBeforeReplace = &deleteBeforeReplaceValue
 	}
 
+	// Remote components are constructed by their provider, which registers the component and its children with this
+	// resource monitor itself.
+	if !custom && req.GetRemote() {
+		return rm.constructResource(req, t, name, parent, props, plugin.ConstructOptions{
+			Aliases:              aliases,
+			Dependencies:         dependencies,
+			Protect:              protect,
+			PropertyDependencies: propertyDependencies,
+		})
+	}
+
 	logging.V(5).Infof(
 		"ResourceMonitor.RegisterResource received: t=%v, name=%v, custom=%v, #props=%v, parent=%v, protect=%v, "+
-			"provider=%v, deps=%v, deleteBeforeReplace=%v, ignoreChanges=%v, aliases=%v, customTimeouts=%v",
+			"provider=%v, deps=%v, deleteBeforeReplace=%v, ignoreChanges=%v, aliases=%v, customTimeouts=%v, "+
+			"replaceOnChanges=%v, retainOnDelete=%v",
 		t, name, custom, len(props), parent, protect, provider, dependencies, deleteBeforeReplace, ignoreChanges,
-		aliases, timeouts)
+		aliases, timeouts, replaceOnChanges, retainOnDelete)
 
 	// Send the goal state to the engine.
 	step := &registerResourceEvent{
 		goal: resource.NewGoal(t, name, custom, props, parent, protect, dependencies, provider, nil,
-			propertyDependencies, deleteBeforeReplace, ignoreChanges, additionalSecretOutputs, aliases, id, &timeouts),
+			propertyDependencies, deleteBeforeReplace, ignoreChanges, additionalSecretOutputs, aliases, id, &timeouts,
+			replaceOnChanges, retainOnDelete),
 		done: make(chan *RegisterResult),
 	}
 
@@ -853,6 +879,90 @@ func (rm *resmon) RegisterResource(ctx context.Context,
 	}, nil
 }
 
+// constructResource constructs a remote component resource using the provider for the component's package and returns
+// the component's URN, outputs, and output dependencies to the language runtime.
+func (rm *resmon) constructResource(req *pulumirpc.RegisterResourceRequest, t tokens.Type, name tokens.QName,
+	parent resource.URN, props resource.PropertyMap,
+	options plugin.ConstructOptions) (*pulumirpc.RegisterResourceResponse, error) {
+
+	label := fmt.Sprintf("ResourceMonitor.RegisterResource(%s,%s)", t, name)
+
+	// Remote components must have a three-part type so that we can retrieve the provider that constructs them.
+	if _, err := tokens.ParseTypeToken(string(t)); err != nil {
+		return nil, rpcerror.New(codes.InvalidArgument, err.Error())
+	}
+	providerReq, err := parseProviderRequest(t.Package(), req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+	prov, err := getProviderFromSource(rm.providers, rm.defaultProviders, providerReq, req.GetProvider())
+	if err != nil {
+		return nil, err
+	}
+
+	// If the component was given an explicit provider, its children from the same package should use it as well.
+	if req.GetProvider() != "" {
+		options.Providers = map[string]string{string(t.Package()): req.GetProvider()}
+	}
+
+	config, err := rm.src.runinfo.Target.Config.Decrypt(rm.src.runinfo.Target.Decrypter)
+	if err != nil {
+		return nil, err
+	}
+	info := plugin.ConstructInfo{
+		Project:        string(rm.src.runinfo.Proj.Name),
+		Stack:          string(rm.src.runinfo.Target.Name),
+		Config:         config,
+		DryRun:         rm.src.dryRun,
+		Parallel:       rm.parallel,
+		MonitorAddress: rm.addr,
+	}
+
+	logging.V(5).Infof("ResourceMonitor.RegisterResource constructing remote component: t=%v, name=%v", t, name)
+	result, err := prov.Construct(info, t, name, parent, props, options)
+	if err != nil {
+		return nil, errors.Wrapf(err, "constructing component %s", name)
+	}
+
+	outputs := result.Outputs
+	if !req.GetSupportsPartialValues() {
+		filtered := resource.PropertyMap{}
+		for k, v := range outputs {
+			if !v.ContainsUnknowns() {
+				filtered[k] = v
+			}
+		}
+		outputs = filtered
+	}
+
+	logging.V(5).Infof("ResourceMonitor.RegisterResource constructed remote component: urn=%v, #outs=%v",
+		result.URN, len(outputs))
+
+	obj, err := plugin.MarshalProperties(outputs, plugin.MarshalOptions{
+		Label:        label,
+		KeepUnknowns: true,
+		KeepSecrets:  req.GetAcceptSecrets(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	outputDependencies := make(map[string]*pulumirpc.RegisterResourceResponse_PropertyDependencies)
+	for k, deps := range result.OutputDependencies {
+		urns := make([]string, len(deps))
+		for i, urn := range deps {
+			urns[i] = string(urn)
+		}
+		outputDependencies[string(k)] = &pulumirpc.RegisterResourceResponse_PropertyDependencies{Urns: urns}
+	}
+
+	return &pulumirpc.RegisterResourceResponse{
+		Urn:                  string(result.URN),
+		Object:               obj,
+		PropertyDependencies: outputDependencies,
+	}, nil
+}
+
 // RegisterResourceOutputs records some new output properties for a resource that have arrived after its initial
 // provisioning.  These will make their way into the eventual checkpoint state file for that resource.
 func (rm *resmon) RegisterResourceOutputs(ctx context.Context,
@@ -17,6 +17,8 @@ package deploy
 import (
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -309,6 +311,12 @@ func (s *DeleteStep) Op() StepOp {
 		}
 		return OpReadDiscard
 	}
+	if s.old.RetainOnDelete {
+		if s.replacing {
+			return OpDiscardReplaced
+		}
+		return OpRetainDiscard
+	}
 
 	if s.replacing {
 		return OpDeleteReplaced
@@ -331,8 +339,9 @@ func (s *DeleteStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 			errors.Errorf("refusing to delete protected resource '%s'", s.old.URN)
 	}
 
-	// Deleting an External resource is a no-op, since Pulumi does not own the lifecycle.
-	if !preview && !s.old.External {
+	// Deleting an External resource is a no-op, since Pulumi does not own the lifecycle. Likewise, a resource that
+	// was registered with RetainOnDelete is simply dropped from the snapshot and left intact in the provider.
+	if !preview && !s.old.External && !s.old.RetainOnDelete {
 		if s.old.Custom {
 			// Invoke the Delete RPC function for this provider:
 			prov, err := getProvider(s)
@@ -620,7 +629,7 @@ func (s *ReadStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error
 			return resource.StatusOK, nil, err
 		}
 
-		result, rst, err := prov.Read(urn, id, nil, s.new.Inputs)
+		result, rst, err := prov.Read(urn, id, nil, s.new.Inputs, s.new.CustomTimeouts.Read)
 		if err != nil {
 			if rst != resource.StatusPartialFailure {
 				return rst, nil, err
@@ -662,10 +671,13 @@ func (s *ReadStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error
 // resource by reading its current state from its provider plugin. These steps are not issued by the step generator;
 // instead, they are issued by the plan executor as the optional first step in plan execution.
 type RefreshStep struct {
-	plan *Plan           // the plan that produced this refresh
-	old  *resource.State // the old resource state, if one exists for this urn
-	new  *resource.State // the new resource state, to be used to query the provider
-	done chan<- bool     // the channel to use to signal completion, if any
+	plan    *Plan           // the plan that produced this refresh
+	old     *resource.State // the old resource state, if one exists for this urn
+	new     *resource.State // the new resource state, to be used to query the provider
+	done    chan<- bool     // the channel to use to signal completion, if any
+	limiter *readLimiter    // an optional limiter that bounds concurrent reads per provider
+	timeout time.Duration   // the maximum time to wait for the provider to read the resource (0 for no limit)
+	err     error           // the error that failed this step, if any
 }
 
 // NewRefreshStep creates a new Refresh step.
@@ -681,6 +693,14 @@ func NewRefreshStep(plan *Plan, old *resource.State, done chan<- bool) Step {
 	}
 }
 
+// newBoundedRefreshStep creates a new Refresh step whose read is bounded by the given limiter and timeout.
+func newBoundedRefreshStep(plan *Plan, old *resource.State, limiter *readLimiter, timeout time.Duration) *RefreshStep {
+	step := NewRefreshStep(plan, old, nil).(*RefreshStep)
+	step.limiter = limiter
+	step.timeout = timeout
+	return step
+}
+
 func (s *RefreshStep) Op() StepOp           { return OpRefresh }
 func (s *RefreshStep) Plan() *Plan          { return s.plan }
 func (s *RefreshStep) Type() tokens.Type    { return s.old.Type }
@@ -703,7 +723,18 @@ func (s *RefreshStep) ResultOp() StepOp {
 	return OpUpdate
 }
 
+// Err returns the error that failed this step, if any.
+func (s *RefreshStep) Err() error {
+	return s.err
+}
+
 func (s *RefreshStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
+	status, complete, err := s.apply()
+	s.err = err
+	return status, complete, err
+}
+
+func (s *RefreshStep) apply() (resource.Status, StepCompleteFunc, error) {
 	var complete func()
 	if s.done != nil {
 		complete = func() { close(s.done) }
@@ -723,7 +754,7 @@ func (s *RefreshStep) Apply(preview bool) (resource.Status, StepCompleteFunc, er
 	}
 
 	var initErrors []string
-	refreshed, rst, err := prov.Read(s.old.URN, resourceID, s.old.Inputs, s.old.Outputs)
+	refreshed, rst, err := s.read(prov, resourceID)
 	if err != nil {
 		if rst != resource.StatusPartialFailure {
 			return rst, nil, err
@@ -761,7 +792,7 @@ func (s *RefreshStep) Apply(preview bool) (resource.Status, StepCompleteFunc, er
 		s.new = resource.NewState(s.old.Type, s.old.URN, s.old.Custom, s.old.Delete, resourceID, inputs, outputs,
 			s.old.Parent, s.old.Protect, s.old.External, s.old.Dependencies, initErrors, s.old.Provider,
 			s.old.PropertyDependencies, s.old.PendingReplacement, s.old.AdditionalSecretOutputs, s.old.Aliases,
-			&s.old.CustomTimeouts)
+			&s.old.CustomTimeouts, s.old.RetainOnDelete)
 	} else {
 		s.new = nil
 	}
@@ -769,6 +800,60 @@ func (s *RefreshStep) Apply(preview bool) (resource.Status, StepCompleteFunc, er
 	return rst, complete, err
 }
 
+// read asks the given provider for the current state of the resource once the step's limiter admits the read. The read
+// is cancelled if it does not complete within the resource's custom read timeout or, failing that, the step's timeout;
+// either way, the limiter's slot is only released once the provider has returned.
+func (s *RefreshStep) read(prov plugin.Provider, id resource.ID) (plugin.ReadResult, resource.Status, error) {
+	if s.limiter != nil {
+		s.limiter.acquire(s.old.Provider)
+		defer s.limiter.release(s.old.Provider)
+	}
+
+	timeout := s.old.CustomTimeouts.Read
+	if timeout == 0 {
+		timeout = s.timeout.Seconds()
+	}
+	return prov.Read(s.old.URN, id, s.old.Inputs, s.old.Outputs, timeout)
+}
+
+// readLimiter bounds the number of reads that may be outstanding against each provider at once.
+type readLimiter struct {
+	limit int                      // the maximum number of outstanding reads per provider.
+	m     sync.Mutex               // protects slots.
+	slots map[string]chan struct{} // a semaphore for each provider reference.
+}
+
+// newReadLimiter creates a new limiter that admits at most limit concurrent reads per provider. If limit is not
+// positive, no limiter is returned and reads are not bounded.
+func newReadLimiter(limit int) *readLimiter {
+	if limit <= 0 {
+		return nil
+	}
+	return &readLimiter{limit: limit, slots: make(map[string]chan struct{})}
+}
+
+func (l *readLimiter) semaphore(provider string) chan struct{} {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	sem, ok := l.slots[provider]
+	if !ok {
+		sem = make(chan struct{}, l.limit)
+		l.slots[provider] = sem
+	}
+	return sem
+}
+
+// acquire blocks until a read against the given provider may proceed.
+func (l *readLimiter) acquire(provider string) {
+	l.semaphore(provider) <- struct{}{}
+}
+
+// release signals that a read against the given provider has completed.
+func (l *readLimiter) release(provider string) {
+	<-l.semaphore(provider)
+}
+
 type ImportStep struct {
 	plan          *Plan                          // the current plan.
 	reg           RegisterResourceEvent          // the registration intent to convey a URN back to.
@@ -779,6 +864,7 @@ type ImportStep struct {
 	diffs         []resource.PropertyKey         // any keys that differed between the user's program and the actual state.
 	detailedDiff  map[string]plugin.PropertyDiff // the structured property diff.
 	ignoreChanges []string                       // a list of property paths to ignore when updating.
+	planned       bool                           // true if this import is not driven by a program.
 }
 
 func NewImportStep(plan *Plan, reg RegisterResourceEvent, new *resource.State, ignoreChanges []string) Step {
@@ -797,6 +883,24 @@ func NewImportStep(plan *Plan, reg RegisterResourceEvent, new *resource.State, i
 	}
 }
 
+// newImportPlanStep creates an import step for a resource that is being imported outside of a program (e.g. by
+// `pulumi import`). The resource's inputs are taken from the provider rather than from the caller.
+func newImportPlanStep(plan *Plan, reg RegisterResourceEvent, new *resource.State) Step {
+	contract.Assert(new != nil)
+	contract.Assert(new.URN != "")
+	contract.Assert(new.ID != "")
+	contract.Assert(new.Custom)
+	contract.Assert(!new.Delete)
+	contract.Assert(!new.External)
+
+	return &ImportStep{
+		plan:    plan,
+		reg:     reg,
+		new:     new,
+		planned: true,
+	}
+}
+
 func NewImportReplacementStep(plan *Plan, reg RegisterResourceEvent, original, new *resource.State,
 	ignoreChanges []string) Step {
 
@@ -845,7 +949,7 @@ func (s *ImportStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 	if err != nil {
 		return resource.StatusOK, nil, err
 	}
-	read, rst, err := prov.Read(s.new.URN, s.new.ID, nil, nil)
+	read, rst, err := prov.Read(s.new.URN, s.new.ID, nil, nil, s.new.CustomTimeouts.Read)
 	if err != nil {
 		if initErr, isInitErr := err.(*plugin.InitError); isInitErr {
 			s.new.InitErrors = initErr.Reasons
@@ -866,12 +970,17 @@ func (s *ImportStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 	}
 	s.new.Outputs = read.Outputs
 
+	// If this import is not driven by a program, the resource's current inputs are the desired inputs.
+	if s.planned {
+		s.new.Inputs = read.Inputs
+	}
+
 	// Magic up an old state so the frontend can display a proper diff. This state is the output of the just-executed
 	// `Read` combined with the resource identity and metadata from the desired state. This ensures that the only
 	// differences between the old and new states are between the inputs and outputs.
 	s.old = resource.NewState(s.new.Type, s.new.URN, s.new.Custom, false, s.new.ID, read.Inputs, read.Outputs,
 		s.new.Parent, s.new.Protect, false, s.new.Dependencies, s.new.InitErrors, s.new.Provider,
-		s.new.PropertyDependencies, false, nil, nil, &s.new.CustomTimeouts)
+		s.new.PropertyDependencies, false, nil, nil, &s.new.CustomTimeouts, s.new.RetainOnDelete)
 
 	// Check the user inputs using the provider inputs for defaults.
 	inputs, failures, err := prov.Check(s.new.URN, s.old.Inputs, s.new.Inputs, preview)
@@ -894,7 +1003,9 @@ func (s *ImportStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 	if diff.Changes != plugin.DiffNone {
 		const message = "inputs to import do not match the existing resource"
 
-		if preview {
+		if s.planned {
+			warnImportMismatch(s.plan, s.new.URN)
+		} else if preview {
 			s.plan.ctx.Diag.Warningf(diag.StreamMessage(s.new.URN, message+"; importing this resource will fail", 0))
 		} else {
 			err = errors.New(message)
@@ -924,7 +1035,8 @@ const (
 	OpReadReplacement      StepOp = "read-replacement"       // reading an existing resource for a replacement.
 	OpRefresh              StepOp = "refresh"                // refreshing an existing resource.
 	OpReadDiscard          StepOp = "discard"                // removing a resource that was read.
-	OpDiscardReplaced      StepOp = "discard-replaced"       // discarding a read resource that was replaced.
+	OpDiscardReplaced      StepOp = "discard-replaced"       // discarding a read or retained resource that was replaced.
+	OpRetainDiscard        StepOp = "discard-retained"       // discarding a retained resource without deleting it.
 	OpRemovePendingReplace StepOp = "remove-pending-replace" // removing a pending replace resource.
 	OpImport               StepOp = "import"                 // import an existing resource.
 	OpImportReplacement    StepOp = "import-replacement"     // replace an existing resource with an imported resource.
@@ -944,6 +1056,7 @@ var StepOps = []StepOp{
 	OpRefresh,
 	OpReadDiscard,
 	OpDiscardReplaced,
+	OpRetainDiscard,
 	OpRemovePendingReplace,
 	OpImport,
 	OpImportReplacement,
@@ -972,7 +1085,7 @@ func (op StepOp) Color() string {
 		return colors.SpecReplace
 	case OpRefresh:
 		return colors.SpecUpdate
-	case OpReadDiscard, OpDiscardReplaced:
+	case OpReadDiscard, OpDiscardReplaced, OpRetainDiscard:
 		return colors.SpecDelete
 	default:
 		contract.Failf("Unrecognized resource step op: '%v'", op)
@@ -1008,7 +1121,7 @@ func (op StepOp) RawPrefix() string {
 		return ">>"
 	case OpRefresh:
 		return "~ "
-	case OpReadDiscard:
+	case OpReadDiscard, OpRetainDiscard:
 		return "< "
 	case OpDiscardReplaced:
 		return "<<"
@@ -1030,7 +1143,7 @@ func (op StepOp) PastTense() string {
 		return "refreshed"
 	case OpRead:
 		return "read"
-	case OpReadDiscard, OpDiscardReplaced:
+	case OpReadDiscard, OpDiscardReplaced, OpRetainDiscard:
 		return "discarded"
 	case OpImport, OpImportReplacement:
 		return "imported"
@@ -108,8 +108,9 @@ func (sg *stepGenerator) GenerateReadSteps(event ReadResourceEvent) ([]Step, res
 		nil,   /* propertyDependencies */
 		false, /* deleteBeforeCreate */
 		event.AdditionalSecretOutputs(),
-		nil, /* aliases */
-		nil, /* customTimeouts */
+		nil,   /* aliases */
+		nil,   /* customTimeouts */
+		false, /* retainOnDelete */
 	)
 	old, hasOld := sg.plan.Olds()[urn]
 
@@ -251,7 +252,7 @@ func (sg *stepGenerator) generateSteps(event RegisterResourceEvent) ([]Step, res
 	// get serialized into the checkpoint file.
 	new := resource.NewState(goal.Type, urn, goal.Custom, false, "", inputs, nil, goal.Parent, goal.Protect, false,
 		goal.Dependencies, goal.InitErrors, goal.Provider, goal.PropertyDependencies, false,
-		goal.AdditionalSecretOutputs, goal.Aliases, &goal.CustomTimeouts)
+		goal.AdditionalSecretOutputs, goal.Aliases, &goal.CustomTimeouts, goal.RetainOnDelete)
 
 	// Mark the URN/resource as having been seen. So we can run analyzers on all resources seen, as well as
 	// lookup providers for calculating replacement of resources that use the provider.
@@ -291,27 +292,13 @@ func (sg *stepGenerator) generateSteps(event RegisterResourceEvent) ([]Step, res
 		return []Step{NewImportStep(sg.plan, event, new, goal.IgnoreChanges)}, nil
 	}
 
-	// Ensure the provider is okay with this resource and fetch the inputs to pass to subsequent methods.
-	var err error
-	if prov != nil {
-		var failures []plugin.CheckFailure
-
-		// If we are re-creating this resource because it was deleted earlier, the old inputs are now
-		// invalid (they got deleted) so don't consider them. Similarly, if the old resource was External,
-		// don't consider those inputs since Pulumi does not own them. Finally, if the resource has been
-		// targeted for replacement, ignore its old state.
-		if recreating || wasExternal || sg.isTargetedReplace(urn) {
-			inputs, failures, err = prov.Check(urn, nil, goal.Properties, allowUnknowns)
-		} else {
-			inputs, failures, err = prov.Check(urn, oldInputs, inputs, allowUnknowns)
-		}
-
-		if err != nil {
-			return nil, result.FromError(err)
-		} else if issueCheckErrors(sg.plan, new, urn, failures) {
-			invalid = true
-		}
-		new.Inputs = inputs
+	// If we are re-creating this resource because it was deleted earlier, the old inputs are now
+	// invalid (they got deleted) so don't consider them. Similarly, if the old resource was External,
+	// don't consider those inputs since Pulumi does not own them. Finally, if the resource has been
+	// targeted for replacement, ignore its old state.
+	checkOlds := oldInputs
+	if prov != nil && (recreating || wasExternal || sg.isTargetedReplace(urn)) {
+		checkOlds, inputs = nil, goal.Properties
 	}
 
 	// Load all policy packs into the plugin host.
@@ -330,8 +317,28 @@ func (sg *stepGenerator) generateSteps(event RegisterResourceEvent) ([]Step, res
 		}
 	}
 
-	// Send the resource off to any Analyzers before being operated on.
+	// Give any Analyzers the opportunity to remediate the resource's inputs before they are checked.
 	analyzers := sg.plan.ctx.Host.ListAnalyzers()
+	inputs, res = sg.applyRemediations(analyzers, urn, goal.Type, inputs)
+	if res != nil {
+		return nil, res
+	}
+	new.Inputs = inputs
+
+	// Ensure the provider is okay with this resource and fetch the inputs to pass to subsequent methods.
+	var err error
+	if prov != nil {
+		var failures []plugin.CheckFailure
+		inputs, failures, err = prov.Check(urn, checkOlds, inputs, allowUnknowns)
+		if err != nil {
+			return nil, result.FromError(err)
+		} else if issueCheckErrors(sg.plan, new, urn, failures) {
+			invalid = true
+		}
+		new.Inputs = inputs
+	}
+
+	// Send the resource off to any Analyzers before being operated on.
 	for _, analyzer := range analyzers {
 		r := plugin.AnalyzerResource{
 			URN:        new.URN,
@@ -515,6 +522,16 @@ func (sg *stepGenerator) generateStepsFromDiff(
 			"unrecognized diff state for %s: %d", urn, diff.Changes)
 	}
 
+	// If the goal state requests a replacement when certain properties change, force a replacement if any of them
+	// did, regardless of what the provider decided.
+	if len(goal.ReplaceOnChanges) > 0 {
+		var res result.Result
+		diff, res = applyReplaceOnChanges(diff, oldInputs, inputs, goal.ReplaceOnChanges)
+		if res != nil {
+			return nil, res
+		}
+	}
+
 	// If there were changes, check for a replacement vs. an in-place update.
 	if diff.Changes == plugin.DiffSome {
 		if diff.Replace() {
@@ -1120,6 +1137,121 @@ func processIgnoreChanges(inputs, oldInputs resource.PropertyMap,
 	return ignoredInputs.ObjectValue(), nil
 }
 
+// applyRemediations sends a resource's inputs to each of the given analyzers in turn, allowing them to transform
+// the inputs before they are checked by the resource's provider. Each remediation that changes the inputs is reported
+// so that previews can show which policy changed which properties.
+func (sg *stepGenerator) applyRemediations(analyzers []plugin.Analyzer, urn resource.URN, t tokens.Type,
+	inputs resource.PropertyMap) (resource.PropertyMap, result.Result) {
+
+	for _, analyzer := range analyzers {
+		r := plugin.AnalyzerResource{
+			URN:        urn,
+			Type:       t,
+			Name:       urn.Name(),
+			Properties: inputs,
+		}
+		remediations, err := analyzer.Remediate(r)
+		if err != nil {
+			return nil, result.FromError(err)
+		}
+		for _, remediation := range remediations {
+			// A remediation without properties did not transform the resource.
+			if remediation.Properties == nil {
+				continue
+			}
+			if inputs.Diff(remediation.Properties) != nil {
+				sg.opts.Events.OnPolicyRemediation(urn, remediation, inputs, remediation.Properties)
+			}
+			inputs = remediation.Properties
+		}
+	}
+	return inputs, nil
+}
+
+// applyReplaceOnChanges forces a replacement for each replaceOnChanges property whose value differs between oldInputs
+// and inputs. Each such property is recorded in the diff's replace keys and, if the diff is detailed, its detailed diff
+// entries are marked as requiring replacement so that the property that triggered the replacement is displayed.
+func applyReplaceOnChanges(diff plugin.DiffResult, oldInputs, inputs resource.PropertyMap,
+	replaceOnChanges []string) (plugin.DiffResult, result.Result) {
+
+	hasKey := func(keys []resource.PropertyKey, key resource.PropertyKey) bool {
+		for _, k := range keys {
+			if k == key {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, replaceOnChange := range replaceOnChanges {
+		path, err := resource.ParsePropertyPath(replaceOnChange)
+		if err != nil || len(path) == 0 {
+			return plugin.DiffResult{}, result.Errorf("invalid replaceOnChanges property path %q", replaceOnChange)
+		}
+		rootKey, ok := path[0].(string)
+		if !ok {
+			return plugin.DiffResult{}, result.Errorf("invalid replaceOnChanges property path %q", replaceOnChange)
+		}
+
+		oldValue, hasOld := path.Get(resource.NewObjectProperty(oldInputs))
+		newValue, hasNew := path.Get(resource.NewObjectProperty(inputs))
+
+		var kind plugin.DiffKind
+		switch {
+		case hasOld && hasNew:
+			if oldValue.DeepEquals(newValue) {
+				continue
+			}
+			kind = plugin.DiffUpdateReplace
+		case hasOld && !hasNew:
+			kind = plugin.DiffDeleteReplace
+		case !hasOld && hasNew:
+			kind = plugin.DiffAddReplace
+		default:
+			continue
+		}
+
+		logging.V(7).Infof("replaceOnChanges property %q changed; forcing replacement", replaceOnChange)
+
+		key := resource.PropertyKey(rootKey)
+		diff.Changes = plugin.DiffSome
+		if !hasKey(diff.ReplaceKeys, key) {
+			diff.ReplaceKeys = append(diff.ReplaceKeys, key)
+		}
+		// An empty list of changed keys means that the provider did not report which keys changed, in which case all
+		// keys are treated as changed.
+		if len(diff.ChangedKeys) > 0 && !hasKey(diff.ChangedKeys, key) {
+			diff.ChangedKeys = append(diff.ChangedKeys, key)
+		}
+
+		if diff.DetailedDiff != nil {
+			// Mark any detailed diff entries at or beneath the path as replacements. If there are none, record the
+			// path itself.
+			marked := false
+			for k, pdiff := range diff.DetailedDiff {
+				if k == replaceOnChange || strings.HasPrefix(k, replaceOnChange+".") ||
+					strings.HasPrefix(k, replaceOnChange+"[") {
+
+					switch pdiff.Kind {
+					case plugin.DiffAdd:
+						pdiff.Kind = plugin.DiffAddReplace
+					case plugin.DiffDelete:
+						pdiff.Kind = plugin.DiffDeleteReplace
+					case plugin.DiffUpdate:
+						pdiff.Kind = plugin.DiffUpdateReplace
+					}
+					diff.DetailedDiff[k] = pdiff
+					marked = true
+				}
+			}
+			if !marked {
+				diff.DetailedDiff[replaceOnChange] = plugin.PropertyDiff{Kind: kind, InputDiff: true}
+			}
+		}
+	}
+	return diff, nil
+}
+
 func (sg *stepGenerator) loadResourceProvider(
 	urn resource.URN, custom bool, provider string, typ tokens.Type) (plugin.Provider, result.Result) {
 
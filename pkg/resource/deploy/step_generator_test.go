@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/plugin"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -113,3 +114,89 @@ func TestIgnoreChanges(t *testing.T) {
 		})
 	}
 }
+
+func TestReplaceOnChanges(t *testing.T) {
+	cases := []struct {
+		name             string
+		oldInputs        map[string]interface{}
+		newInputs        map[string]interface{}
+		diff             plugin.DiffResult
+		replaceOnChanges []string
+		expected         plugin.DiffResult
+		expectFailure    bool
+	}{
+		{
+			name:             "Unchanged property",
+			oldInputs:        map[string]interface{}{"a": "foo", "b": 1},
+			newInputs:        map[string]interface{}{"a": "foo", "b": 2},
+			diff:             plugin.DiffResult{Changes: plugin.DiffSome, ChangedKeys: []resource.PropertyKey{"b"}},
+			replaceOnChanges: []string{"a"},
+			expected:         plugin.DiffResult{Changes: plugin.DiffSome, ChangedKeys: []resource.PropertyKey{"b"}},
+		},
+		{
+			name:             "Changed nested property",
+			oldInputs:        map[string]interface{}{"a": map[string]interface{}{"b": "foo"}},
+			newInputs:        map[string]interface{}{"a": map[string]interface{}{"b": "bar"}},
+			diff:             plugin.DiffResult{Changes: plugin.DiffNone},
+			replaceOnChanges: []string{"a.b"},
+			expected: plugin.DiffResult{
+				Changes:     plugin.DiffSome,
+				ReplaceKeys: []resource.PropertyKey{"a"},
+			},
+		},
+		{
+			name:      "Detailed diff",
+			oldInputs: map[string]interface{}{"a": map[string]interface{}{"b": "foo"}},
+			newInputs: map[string]interface{}{"a": map[string]interface{}{"b": "bar", "c": "baz"}},
+			diff: plugin.DiffResult{
+				Changes:     plugin.DiffSome,
+				ChangedKeys: []resource.PropertyKey{"a"},
+				DetailedDiff: map[string]plugin.PropertyDiff{
+					"a.b": {Kind: plugin.DiffUpdate},
+					"a.c": {Kind: plugin.DiffAdd},
+				},
+			},
+			replaceOnChanges: []string{"a.b", "d"},
+			expected: plugin.DiffResult{
+				Changes:     plugin.DiffSome,
+				ReplaceKeys: []resource.PropertyKey{"a"},
+				ChangedKeys: []resource.PropertyKey{"a"},
+				DetailedDiff: map[string]plugin.PropertyDiff{
+					"a.b": {Kind: plugin.DiffUpdateReplace},
+					"a.c": {Kind: plugin.DiffAdd},
+				},
+			},
+		},
+		{
+			name:             "Added property",
+			oldInputs:        map[string]interface{}{},
+			newInputs:        map[string]interface{}{"a": "foo"},
+			diff:             plugin.DiffResult{Changes: plugin.DiffSome, DetailedDiff: map[string]plugin.PropertyDiff{}},
+			replaceOnChanges: []string{"a"},
+			expected: plugin.DiffResult{
+				Changes:      plugin.DiffSome,
+				ReplaceKeys:  []resource.PropertyKey{"a"},
+				DetailedDiff: map[string]plugin.PropertyDiff{"a": {Kind: plugin.DiffAddReplace, InputDiff: true}},
+			},
+		},
+		{
+			name:             "Invalid path",
+			replaceOnChanges: []string{"a["},
+			expectFailure:    true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			olds, news := resource.NewPropertyMapFromMap(c.oldInputs), resource.NewPropertyMapFromMap(c.newInputs)
+
+			diff, res := applyReplaceOnChanges(c.diff, olds, news, c.replaceOnChanges)
+			if c.expectFailure {
+				assert.NotNil(t, res)
+			} else {
+				assert.Nil(t, res)
+				assert.Equal(t, c.expected, diff)
+			}
+		})
+	}
+}
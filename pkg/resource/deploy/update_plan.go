@@ -0,0 +1,211 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pulumi/pulumi/pkg/resource"
+)
+
+// UpdatePlan records the steps that a preview planned to perform, so that a later update can be constrained to
+// perform only those steps. An update plan is recorded by passing an empty plan in Options.RecordPlan to a preview,
+// and enforced by passing it in Options.Plan to an update.
+type UpdatePlan struct {
+	ResourcePlans map[resource.URN]*ResourcePlan // the planned steps for each resource, keyed by URN.
+}
+
+// ResourcePlan records the steps that an update plan permits for a single resource.
+type ResourcePlan struct {
+	Ops   []StepOp               // the operations planned for the resource, in order.
+	Olds  resource.PropertyMap   // the resource's inputs before the update, if it already existed.
+	News  resource.PropertyMap   // the resource's planned inputs, if it is not being deleted.
+	Diffs []resource.PropertyKey // the keys of the inputs that the plan expects to change.
+}
+
+// NewUpdatePlan creates a new, empty update plan.
+func NewUpdatePlan() *UpdatePlan {
+	return &UpdatePlan{ResourcePlans: make(map[resource.URN]*ResourcePlan)}
+}
+
+// ConstraintViolationError is returned when an update attempts to perform a step that its update plan does not
+// permit.
+type ConstraintViolationError struct {
+	URN    resource.URN // the resource whose step violated the plan.
+	Op     StepOp       // the operation that violated the plan.
+	Reason string       // a description of the violation.
+}
+
+func (e *ConstraintViolationError) Error() string {
+	return fmt.Sprintf("%s of %s violates the update plan: %s", e.Op, e.URN, e.Reason)
+}
+
+// stepDiffs returns the keys of the inputs that the given step changes, if the step is a kind that records them.
+func stepDiffs(step Step) []resource.PropertyKey {
+	if s, ok := step.(interface{ Diffs() []resource.PropertyKey }); ok {
+		return s.Diffs()
+	}
+	return nil
+}
+
+// recordStep adds the given step to the plan. Same steps are not recorded, as they are always permitted.
+func (p *UpdatePlan) recordStep(step Step) {
+	op := step.Op()
+	if op == OpSame {
+		return
+	}
+
+	rp, has := p.ResourcePlans[step.URN()]
+	if !has {
+		rp = &ResourcePlan{}
+		p.ResourcePlans[step.URN()] = rp
+	}
+	rp.Ops = append(rp.Ops, op)
+	if old := step.Old(); old != nil && rp.Olds == nil {
+		rp.Olds = old.Inputs
+	}
+	if new := step.New(); new != nil {
+		rp.News = new.Inputs
+	}
+	for _, k := range stepDiffs(step) {
+		if !containsKey(rp.Diffs, k) {
+			rp.Diffs = append(rp.Diffs, k)
+		}
+	}
+}
+
+// checkStep returns an error if the plan does not permit the given step. Same steps are always permitted. Any other
+// step must have been planned for its resource, must start from the resource's planned inputs, and must produce
+// inputs that match the planned ones, where planned inputs that were unknown during the preview match any value.
+func (p *UpdatePlan) checkStep(step Step) error {
+	op := step.Op()
+	if op == OpSame {
+		return nil
+	}
+	violation := func(format string, args ...interface{}) error {
+		return &ConstraintViolationError{URN: step.URN(), Op: op, Reason: fmt.Sprintf(format, args...)}
+	}
+
+	rp, has := p.ResourcePlans[step.URN()]
+	if !has {
+		return violation("the plan does not include any changes to this resource")
+	}
+	if !containsOp(rp.Ops, op) {
+		planned := make([]string, len(rp.Ops))
+		for i, o := range rp.Ops {
+			planned[i] = string(o)
+		}
+		return violation("the plan only permits %s", strings.Join(planned, ", "))
+	}
+
+	if old := step.Old(); old != nil && rp.Olds != nil && !rp.Olds.DeepEquals(old.Inputs) {
+		return violation("the resource's inputs have changed since the plan was created")
+	}
+
+	if new := step.New(); new != nil {
+		if key, ok := inputsMatch(rp.News, new.Inputs); !ok {
+			return violation("input %q differs from the planned value", key)
+		}
+		for _, k := range stepDiffs(step) {
+			if !containsKey(rp.Diffs, k) && !rp.News[k].ContainsUnknowns() {
+				return violation("input %q was not planned to change", k)
+			}
+		}
+	}
+
+	return nil
+}
+
+// inputsMatch returns true if the given inputs match the planned inputs. If they do not, it also returns the first
+// key that differs.
+func inputsMatch(planned, actual resource.PropertyMap) (resource.PropertyKey, bool) {
+	keys := make(map[resource.PropertyKey]bool)
+	for k := range planned {
+		keys[k] = true
+	}
+	for k := range actual {
+		keys[k] = true
+	}
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, string(k))
+	}
+	sort.Strings(sorted)
+
+	for _, k := range sorted {
+		key := resource.PropertyKey(k)
+		if !valueMatches(planned[key], actual[key]) {
+			return key, false
+		}
+	}
+	return "", true
+}
+
+// valueMatches returns true if the given value matches the planned value. Unknown planned values match anything, as
+// do unknown actual values, which only occur during previews.
+func valueMatches(planned, actual resource.PropertyValue) bool {
+	// Secretness is not a constraint; compare the values that secrets wrap.
+	for planned.IsSecret() {
+		planned = planned.SecretValue().Element
+	}
+	for actual.IsSecret() {
+		actual = actual.SecretValue().Element
+	}
+
+	if planned.IsComputed() || planned.IsOutput() || actual.IsComputed() || actual.IsOutput() {
+		return true
+	}
+
+	switch {
+	case planned.IsArray():
+		if !actual.IsArray() || len(planned.ArrayValue()) != len(actual.ArrayValue()) {
+			return false
+		}
+		for i, elem := range planned.ArrayValue() {
+			if !valueMatches(elem, actual.ArrayValue()[i]) {
+				return false
+			}
+		}
+		return true
+	case planned.IsObject():
+		if !actual.IsObject() {
+			return false
+		}
+		_, ok := inputsMatch(planned.ObjectValue(), actual.ObjectValue())
+		return ok
+	default:
+		return planned.DeepEquals(actual)
+	}
+}
+
+func containsOp(ops []StepOp, op StepOp) bool {
+	for _, o := range ops {
+		if o == op {
+			return true
+		}
+	}
+	return false
+}
+
+func containsKey(keys []resource.PropertyKey, key resource.PropertyKey) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
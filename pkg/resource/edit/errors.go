@@ -39,3 +39,19 @@ type ResourceProtectedError struct {
 func (ResourceProtectedError) Error() string {
 	return "Can't delete protected resource"
 }
+
+// DanglingReference is a reference from one resource to another that would not survive a move between stacks.
+type DanglingReference struct {
+	From resource.URN // the resource holding the reference.
+	To   resource.URN // the resource being referred to.
+}
+
+// ResourcesHaveDanglingReferencesError is returned by MoveResources when moving the requested resources would leave
+// a resource in either stack referring to a resource that is no longer in that stack.
+type ResourcesHaveDanglingReferencesError struct {
+	References []DanglingReference
+}
+
+func (r ResourcesHaveDanglingReferencesError) Error() string {
+	return fmt.Sprintf("Can't move resources due to %d reference(s) that would be left dangling", len(r.References))
+}
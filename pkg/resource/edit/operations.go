@@ -15,6 +15,9 @@
 package edit
 
 import (
+	"container/heap"
+	"strings"
+
 	"github.com/pkg/errors"
 
 	"github.com/pulumi/pulumi/pkg/resource"
@@ -113,47 +116,422 @@ func RenameStack(snap *deploy.Snapshot, newName tokens.QName, newProject tokens.
 		return resource.NewURN(newName, project, "", u.QualifiedType(), u.Name())
 	}
 
-	rewriteState := func(res *resource.State) {
-		contract.Assert(res != nil)
+	if err := snap.VerifyIntegrity(); err != nil {
+		return errors.Wrap(err, "checkpoint is invalid")
+	}
+
+	for _, res := range snap.Resources {
+		rewriteReferences(res, rewriteUrn)
+	}
+
+	for _, ops := range snap.PendingOperations {
+		rewriteReferences(ops.Resource, rewriteUrn)
+	}
+
+	return nil
+}
+
+// rewriteReferences rewrites the URN of the given resource along with every URN it refers to: its parent, its
+// dependencies, its property dependencies and its provider reference.
+func rewriteReferences(res *resource.State, rewriteUrn func(resource.URN) resource.URN) {
+	contract.Assert(res != nil)
+
+	res.URN = rewriteUrn(res.URN)
+
+	if res.Parent != "" {
+		res.Parent = rewriteUrn(res.Parent)
+	}
 
-		res.URN = rewriteUrn(res.URN)
+	for depIdx, dep := range res.Dependencies {
+		res.Dependencies[depIdx] = rewriteUrn(dep)
+	}
 
-		if res.Parent != "" {
-			res.Parent = rewriteUrn(res.Parent)
+	for _, propDeps := range res.PropertyDependencies {
+		for depIdx, dep := range propDeps {
+			propDeps[depIdx] = rewriteUrn(dep)
 		}
+	}
+
+	if res.Provider != "" {
+		providerRef, err := providers.ParseReference(res.Provider)
+		contract.AssertNoErrorf(err, "failed to parse provider reference from validated checkpoint")
+
+		providerRef, err = providers.NewReference(rewriteUrn(providerRef.URN()), providerRef.ID())
+		contract.AssertNoErrorf(err, "failed to generate provider reference from valid reference")
+
+		res.Provider = providerRef.String()
+	}
+}
+
+// MoveOptions controls which resources MoveResources moves in addition to those it is explicitly asked to move.
+type MoveOptions struct {
+	IncludeChildren   bool // move the descendants of the requested resources as well.
+	IncludeDependents bool // move the resources that depend on the requested resources as well.
+}
+
+// MoveResources moves the resources with the given URNs from the source snapshot into the destination snapshot,
+// rewriting their URNs to belong to the given stack and project. Children of the source stack's root resource become
+// children of the destination stack's root resource, if there is one.
+//
+// Providers used by the moved resources that are not themselves being moved are copied into the destination, unless
+// the destination already has the same provider. If the move would leave any resource in either snapshot referring
+// to a resource that is not in the same snapshot, MoveResources returns a `ResourcesHaveDanglingReferencesError` and
+// leaves both snapshots untouched.
+//
+// Snapshots hold secret values in plaintext, so the moved resources' secrets are encrypted with the destination's
+// secrets manager when the destination snapshot is serialized.
+func MoveResources(source, dest *deploy.Snapshot, urns []resource.URN, destStack tokens.QName,
+	destProject tokens.PackageName, opts MoveOptions) ([]*resource.State, error) {
+
+	contract.Require(source != nil, "source")
+	contract.Require(dest != nil, "dest")
+	contract.Require(destStack != "", "destStack")
+	contract.Require(destProject != "", "destProject")
+
+	if err := source.VerifyIntegrity(); err != nil {
+		return nil, errors.Wrap(err, "source checkpoint is invalid")
+	}
+	if err := dest.VerifyIntegrity(); err != nil {
+		return nil, errors.Wrap(err, "destination checkpoint is invalid")
+	}
+
+	sourceRoot, destRoot := rootStackURN(source), rootStackURN(dest)
 
-		for depIdx, dep := range res.Dependencies {
-			res.Dependencies[depIdx] = rewriteUrn(dep)
+	// Determine the full set of resources to move. Snapshots are topologically sorted, so a single pass picks up
+	// transitive children and dependents.
+	moving := make(map[resource.URN]bool)
+	for _, urn := range urns {
+		if len(LocateResource(source, urn)) == 0 {
+			return nil, errors.Errorf("No such resource %q exists in the source state", urn)
 		}
+		if urn == sourceRoot {
+			return nil, errors.New("The root stack resource can't be moved")
+		}
+		moving[urn] = true
+	}
+	for _, res := range source.Resources {
+		if moving[res.URN] || res.URN == sourceRoot {
+			continue
+		}
+		for _, ref := range references(res) {
+			if (opts.IncludeChildren && ref == res.Parent && moving[ref]) ||
+				(opts.IncludeDependents && ref != res.Parent && moving[ref]) {
+				moving[res.URN] = true
+				break
+			}
+		}
+	}
+
+	for _, op := range source.PendingOperations {
+		if moving[op.Resource.URN] {
+			return nil, errors.Errorf("Resource %q has a pending operation and can't be moved", op.Resource.URN)
+		}
+	}
 
-		for _, propDeps := range res.PropertyDependencies {
-			for depIdx, dep := range propDeps {
-				propDeps[depIdx] = rewriteUrn(dep)
+	// Check that no references would be left dangling, and gather the providers that must be copied.
+	var dangling []DanglingReference
+	var providersToCopy []*resource.State
+	copying := make(map[resource.URN]bool)
+	for _, res := range source.Resources {
+		if !moving[res.URN] {
+			for _, ref := range references(res) {
+				if moving[ref] {
+					dangling = append(dangling, DanglingReference{From: res.URN, To: ref})
+				}
 			}
+			continue
 		}
 
-		if res.Provider != "" {
-			providerRef, err := providers.ParseReference(res.Provider)
-			contract.AssertNoErrorf(err, "failed to parse provider reference from validated checkpoint")
+		prov := providerURN(res)
+		for _, ref := range references(res) {
+			switch {
+			case moving[ref] || (ref == sourceRoot && ref == res.Parent):
+				// The reference moves along with the resource.
+			case ref == prov:
+				if !copying[ref] {
+					copying[ref] = true
+					provs := LocateResource(source, ref)
+					contract.Assertf(len(provs) != 0, "provider %v not found in validated checkpoint", ref)
+					providersToCopy = append(providersToCopy, provs[len(provs)-1])
+				}
+			default:
+				dangling = append(dangling, DanglingReference{From: res.URN, To: ref})
+			}
+		}
+	}
+	for _, prov := range providersToCopy {
+		for _, ref := range references(prov) {
+			if ref != sourceRoot || ref != prov.Parent {
+				dangling = append(dangling, DanglingReference{From: prov.URN, To: ref})
+			}
+		}
+	}
+	if len(dangling) != 0 {
+		return nil, ResourcesHaveDanglingReferencesError{References: dangling}
+	}
 
-			providerRef, err = providers.NewReference(rewriteUrn(providerRef.URN()), providerRef.ID())
-			contract.AssertNoErrorf(err, "failed to generate provider reference from valid reference")
+	rewriteUrn := func(u resource.URN) resource.URN {
+		if u == sourceRoot {
+			return destRoot
+		}
+		return resource.NewURN(destStack, destProject, "", u.QualifiedType(), u.Name())
+	}
+
+	existing := make(map[resource.URN]*resource.State)
+	for _, res := range dest.Resources {
+		existing[res.URN] = res
+	}
 
-			res.Provider = providerRef.String()
+	// Copy any providers that the destination does not yet have.
+	var moved []*resource.State
+	for _, prov := range providersToCopy {
+		copied := *prov
+		rewriteReferences(&copied, rewriteUrn)
+
+		if old, has := existing[copied.URN]; has {
+			if old.ID != copied.ID || old.Type != copied.Type {
+				return nil, errors.Errorf("A different resource with URN %q already exists in the destination state",
+					copied.URN)
+			}
+			continue
 		}
+		existing[copied.URN] = &copied
+		moved = append(moved, &copied)
 	}
 
-	if err := snap.VerifyIntegrity(); err != nil {
-		return errors.Wrap(err, "checkpoint is invalid")
+	// Make sure that none of the moved resources collide with the destination's before we start mutating them.
+	for urn := range moving {
+		if old, has := existing[rewriteUrn(urn)]; has && !old.Delete {
+			return nil, errors.Errorf("A resource with URN %q already exists in the destination state", old.URN)
+		}
+	}
+
+	// Then move the resources themselves, preserving their order.
+	var remaining []*resource.State
+	for _, res := range source.Resources {
+		if !moving[res.URN] {
+			remaining = append(remaining, res)
+			continue
+		}
+		rewriteReferences(res, rewriteUrn)
+		moved = append(moved, res)
+	}
+
+	source.Resources = remaining
+	dest.Resources = append(dest.Resources, moved...)
+	return moved, nil
+}
+
+// rootStackURN returns the URN of the given snapshot's root stack resource, if it has one.
+func rootStackURN(snap *deploy.Snapshot) resource.URN {
+	for _, res := range snap.Resources {
+		if res.Type == resource.RootStackType && res.Parent == "" {
+			return res.URN
+		}
+	}
+	return ""
+}
+
+// providerURN returns the URN of the given resource's provider, if it has one.
+func providerURN(res *resource.State) resource.URN {
+	if res.Provider == "" {
+		return ""
+	}
+	ref, err := providers.ParseReference(res.Provider)
+	contract.AssertNoErrorf(err, "failed to parse provider reference from validated checkpoint")
+	return ref.URN()
+}
+
+// references returns the URNs of every resource that the given resource refers to.
+func references(res *resource.State) []resource.URN {
+	var refs []resource.URN
+	if res.Parent != "" {
+		refs = append(refs, res.Parent)
+	}
+	refs = append(refs, res.Dependencies...)
+	for _, deps := range res.PropertyDependencies {
+		refs = append(refs, deps...)
+	}
+	if prov := providerURN(res); prov != "" {
+		refs = append(refs, prov)
+	}
+	return refs
+}
+
+// RenameResource changes the name component of the URN of the given resource, along with that of any other resource
+// in the snapshot that shares its URN (e.g. copies that are pending deletion). Every reference to the resource
+// elsewhere in the snapshot is updated to refer to the new URN.
+func RenameResource(snap *deploy.Snapshot, res *resource.State, newName tokens.QName) error {
+	contract.Require(snap != nil, "snap")
+	contract.Require(res != nil, "res")
+
+	if res.Type == resource.RootStackType {
+		return errors.New("The root stack resource can't be renamed")
+	}
+	if !tokens.IsQName(string(newName)) {
+		return errors.Errorf("%q is not a valid resource name", newName)
+	}
+
+	old := res.URN
+	urn := resource.NewURN(old.Stack(), old.Project(), parentType(old), old.Type(), newName)
+	if len(LocateResource(snap, urn)) != 0 {
+		return errors.Errorf("A resource with URN %q already exists", urn)
+	}
+
+	return rewriteSnapshot(snap, map[resource.URN]resource.URN{old: urn})
+}
+
+// SetParent changes the parent of the given resource. Because a resource's URN includes the types of its ancestors,
+// the URNs of the resource and all of its descendants are rewritten, along with every reference to them elsewhere in
+// the snapshot. If the new parent is empty, the resource is parented to the root stack resource, if there is one.
+func SetParent(snap *deploy.Snapshot, res *resource.State, parent resource.URN) error {
+	contract.Require(snap != nil, "snap")
+	contract.Require(res != nil, "res")
+
+	if res.Type == resource.RootStackType {
+		return errors.New("The root stack resource can't be re-parented")
+	}
+	if parent == "" {
+		parent = rootStackURN(snap)
+	} else if len(LocateResource(snap, parent)) == 0 {
+		return errors.Errorf("No such resource %q exists in the current state", parent)
+	}
+
+	// Compute the new URNs of the resource and its descendants. Parents come before their children in a valid
+	// snapshot, so a single pass suffices.
+	newParentType := tokens.Type("")
+	if parent != "" && parent.Type() != resource.RootStackType {
+		newParentType = parent.QualifiedType()
+	}
+	old := res.URN
+	renames := map[resource.URN]resource.URN{
+		old: resource.NewURN(old.Stack(), old.Project(), newParentType, old.Type(), old.Name()),
+	}
+	for _, other := range snap.Resources {
+		if newParent, ok := renames[other.Parent]; ok && other.URN != old {
+			u := other.URN
+			renames[u] = resource.NewURN(u.Stack(), u.Project(), newParent.QualifiedType(), u.Type(), u.Name())
+		}
+	}
+
+	if _, ok := renames[parent]; ok {
+		return errors.Errorf("Resource %q can't be parented to itself or one of its descendants", old)
+	}
+	for from, to := range renames {
+		if from != to && len(LocateResource(snap, to)) != 0 {
+			return errors.Errorf("A resource with URN %q already exists", to)
+		}
+	}
+
+	for _, other := range LocateResource(snap, old) {
+		other.Parent = parent
+	}
+	return rewriteSnapshot(snap, renames)
+}
+
+// parentType returns the qualified type of the given URN's parent, or the empty type if it has none.
+func parentType(urn resource.URN) tokens.Type {
+	qualified := string(urn.QualifiedType())
+	if idx := strings.LastIndex(qualified, resource.URNTypeDelimiter); idx != -1 {
+		return tokens.Type(qualified[:idx])
+	}
+	return ""
+}
+
+// rewriteSnapshot renames the resources in the given snapshot according to the given map, updating every reference
+// to them. The resources are then reordered as necessary so that each resource comes after everything it refers to,
+// and the resulting snapshot is verified.
+func rewriteSnapshot(snap *deploy.Snapshot, renames map[resource.URN]resource.URN) error {
+	rewriteUrn := func(u resource.URN) resource.URN {
+		if renamed, ok := renames[u]; ok {
+			return renamed
+		}
+		return u
 	}
 
 	for _, res := range snap.Resources {
-		rewriteState(res)
+		rewriteReferences(res, rewriteUrn)
+	}
+	for _, op := range snap.PendingOperations {
+		rewriteReferences(op.Resource, rewriteUrn)
 	}
 
-	for _, ops := range snap.PendingOperations {
-		rewriteState(ops.Resource)
+	sorted, err := sortResources(snap.Resources)
+	if err != nil {
+		return err
 	}
+	snap.Resources = sorted
 
+	if err := snap.VerifyIntegrity(); err != nil {
+		return errors.Wrap(err, "edit produced an invalid snapshot")
+	}
 	return nil
 }
+
+// sortResources returns the given resources reordered such that each resource comes after every resource it refers
+// to. Resources keep their relative order wherever possible: at each step, the earliest resource whose references have
+// all been emitted comes next.
+func sortResources(resources []*resource.State) ([]*resource.State, error) {
+	known := make(map[resource.URN]bool)
+	for _, res := range resources {
+		known[res.URN] = true
+	}
+
+	// Count each resource's outstanding references, and record which resources are waiting on each URN. Several
+	// resources may share a URN (e.g. copies that are pending deletion); a reference to it is satisfied as soon as the
+	// first of them is emitted.
+	waiting := make([]int, len(resources))
+	dependents := make(map[resource.URN][]int)
+	counted := make(map[resource.URN]int) // the index (plus one) of the last resource that counted the reference
+	for i, res := range resources {
+		for _, ref := range references(res) {
+			if !known[ref] || ref == res.URN || counted[ref] == i+1 {
+				continue
+			}
+			counted[ref] = i + 1
+			waiting[i]++
+			dependents[ref] = append(dependents[ref], i)
+		}
+	}
+
+	ready := &indexHeap{}
+	for i := range resources {
+		if waiting[i] == 0 {
+			heap.Push(ready, i)
+		}
+	}
+
+	emitted := make(map[resource.URN]bool)
+	sorted := make([]*resource.State, 0, len(resources))
+	for ready.Len() > 0 {
+		res := resources[heap.Pop(ready).(int)]
+		sorted = append(sorted, res)
+		if emitted[res.URN] {
+			continue
+		}
+		emitted[res.URN] = true
+		for _, j := range dependents[res.URN] {
+			if waiting[j]--; waiting[j] == 0 {
+				heap.Push(ready, j)
+			}
+		}
+	}
+	if len(sorted) < len(resources) {
+		return nil, errors.New("edit would introduce a cycle between resources")
+	}
+	return sorted, nil
+}
+
+// indexHeap is a min-heap of resource indices, used to emit the earliest ready resource first.
+type indexHeap []int
+
+func (h indexHeap) Len() int            { return len(h) }
+func (h indexHeap) Less(i, j int) bool  { return h[i] < h[j] }
+func (h indexHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *indexHeap) Push(x interface{}) { *h = append(*h, x.(int)) }
+func (h *indexHeap) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
@@ -15,6 +15,7 @@
 package edit
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -321,3 +322,191 @@ func TestRenameStack(t *testing.T) {
 		assert.Len(t, LocateResource(snap, updatedResourceURN), 1)
 	})
 }
+
+func TestMoveResources(t *testing.T) {
+	pA := NewProviderResource("a", "p1", "0")
+	a := NewResource("a", pA)
+	b := NewResource("b", pA, a.URN)
+	c := NewResource("c", pA)
+	source := NewSnapshot([]*resource.State{
+		pA,
+		a,
+		b,
+		c,
+	})
+	dest := NewSnapshot(nil)
+
+	moved, err := MoveResources(source, dest, []resource.URN{a.URN}, "dest", "proj",
+		MoveOptions{IncludeDependents: true})
+	assert.NoError(t, err)
+	assert.Len(t, moved, 3)
+
+	// The provider is copied rather than moved, since c still uses it.
+	assert.Equal(t, []*resource.State{pA, c}, source.Resources)
+	assert.Len(t, dest.Resources, 3)
+	assert.NoError(t, source.VerifyIntegrity())
+	assert.NoError(t, dest.VerifyIntegrity())
+
+	newProv := resource.NewURN("dest", "proj", "", pA.Type, "p1")
+	assert.Equal(t, newProv, dest.Resources[0].URN)
+	assert.Equal(t, pA.ID, dest.Resources[0].ID)
+	assert.Equal(t, resource.NewURN("test", "test", "", pA.Type, "p1"), pA.URN)
+
+	newA := resource.NewURN("dest", "proj", "", "a:b:c", "a")
+	assert.Equal(t, newA, a.URN)
+	assert.Equal(t, []resource.URN{newA}, b.Dependencies)
+	ref, err := providers.ParseReference(b.Provider)
+	assert.NoError(t, err)
+	assert.Equal(t, newProv, ref.URN())
+}
+
+func TestMoveResourcesChildren(t *testing.T) {
+	root := &resource.State{
+		Type:    resource.RootStackType,
+		URN:     resource.NewURN("test", "test", "", resource.RootStackType, "test-test"),
+		Inputs:  resource.PropertyMap{},
+		Outputs: resource.PropertyMap{},
+	}
+	a := NewResource("a", nil)
+	a.Parent = root.URN
+	b := NewResource("b", nil)
+	b.URN = resource.NewURN("test", "test", a.Type, "d:e:f", "b")
+	b.Parent = a.URN
+	source := NewSnapshot([]*resource.State{root, a, b})
+
+	destRoot := &resource.State{
+		Type:    resource.RootStackType,
+		URN:     resource.NewURN("dest", "proj", "", resource.RootStackType, "proj-dest"),
+		Inputs:  resource.PropertyMap{},
+		Outputs: resource.PropertyMap{},
+	}
+	dest := NewSnapshot([]*resource.State{destRoot})
+
+	// Moving a without its children would leave b dangling.
+	_, err := MoveResources(source, dest, []resource.URN{a.URN}, "dest", "proj", MoveOptions{})
+	assert.IsType(t, ResourcesHaveDanglingReferencesError{}, err)
+	assert.Len(t, source.Resources, 3)
+	assert.Len(t, dest.Resources, 1)
+
+	_, err = MoveResources(source, dest, []resource.URN{a.URN}, "dest", "proj", MoveOptions{IncludeChildren: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []*resource.State{root}, source.Resources)
+	assert.Equal(t, []*resource.State{destRoot, a, b}, dest.Resources)
+	assert.Equal(t, destRoot.URN, a.Parent)
+	assert.Equal(t, a.URN, b.Parent)
+	assert.Equal(t, resource.NewURN("dest", "proj", a.Type, "d:e:f", "b"), b.URN)
+	assert.NoError(t, dest.VerifyIntegrity())
+}
+
+func TestMoveResourcesConflict(t *testing.T) {
+	a := NewResource("a", nil)
+	source := NewSnapshot([]*resource.State{a})
+
+	existing := NewResource("a", nil)
+	existing.URN = resource.NewURN("dest", "test", "", existing.Type, "a")
+	dest := NewSnapshot([]*resource.State{existing})
+
+	_, err := MoveResources(source, dest, []resource.URN{a.URN}, "dest", "test", MoveOptions{})
+	assert.Error(t, err)
+	assert.Equal(t, resource.NewURN("test", "test", "", "a:b:c", "a"), a.URN)
+	assert.Len(t, source.Resources, 1)
+}
+
+func TestRenameResource(t *testing.T) {
+	pA := NewProviderResource("a", "p1", "0")
+	a := NewResource("a", pA)
+	b := NewResource("b", pA, a.URN)
+	b.Parent = a.URN
+	b.URN = resource.NewURN("test", "test", a.Type, b.Type, "b")
+	b.PropertyDependencies = map[resource.PropertyKey][]resource.URN{"foo": {a.URN}}
+	c := NewResource("c", pA)
+	snap := NewSnapshot([]*resource.State{pA, a, b, c})
+
+	// Renaming to an existing name fails.
+	assert.Error(t, RenameResource(snap, a, "c"))
+
+	err := RenameResource(snap, pA, "p2")
+	assert.NoError(t, err)
+	err = RenameResource(snap, a, "a2")
+	assert.NoError(t, err)
+
+	newA := resource.NewURN("test", "test", "", a.Type, "a2")
+	assert.Equal(t, newA, a.URN)
+	assert.Equal(t, newA, b.Parent)
+	assert.Equal(t, []resource.URN{newA}, b.Dependencies)
+	assert.Equal(t, []resource.URN{newA}, b.PropertyDependencies["foo"])
+
+	ref, err := providers.ParseReference(a.Provider)
+	assert.NoError(t, err)
+	assert.Equal(t, pA.URN, ref.URN())
+	assert.Equal(t, tokens.QName("p2"), pA.URN.Name())
+}
+
+func TestSetParent(t *testing.T) {
+	a := NewResource("a", nil)
+	b := NewResource("b", nil)
+	c := NewResource("c", nil)
+	c.Parent = b.URN
+	c.URN = resource.NewURN("test", "test", b.Type, c.Type, "c")
+	d := NewResource("d", nil, c.URN)
+	snap := NewSnapshot([]*resource.State{b, c, d, a})
+
+	// Parenting b to its own child is a cycle.
+	assert.Error(t, SetParent(snap, b, c.URN))
+
+	err := SetParent(snap, b, a.URN)
+	assert.NoError(t, err)
+
+	// b and its descendants are renamed and moved after their new parent.
+	assert.Equal(t, []*resource.State{a, b, c, d}, snap.Resources)
+	assert.Equal(t, a.URN, b.Parent)
+	assert.Equal(t, resource.NewURN("test", "test", a.Type, b.Type, "b"), b.URN)
+	assert.Equal(t, b.URN, c.Parent)
+	assert.Equal(t, resource.NewURN("test", "test", b.URN.QualifiedType(), c.Type, "c"), c.URN)
+	assert.Equal(t, []resource.URN{c.URN}, d.Dependencies)
+
+	// Clearing the parent restores the original URN.
+	err = SetParent(snap, b, "")
+	assert.NoError(t, err)
+	assert.Equal(t, resource.URN(""), b.Parent)
+	assert.Equal(t, resource.NewURN("test", "test", "", b.Type, "b"), b.URN)
+}
+
+func TestSortResources(t *testing.T) {
+	a := NewResource("a", nil)
+	b := NewResource("b", nil)
+	c := NewResource("c", nil, b.URN)
+	d := NewResource("d", nil)
+	oldB := NewResource("b", nil)
+	oldB.Delete = true
+
+	// Resources that are already in order keep their relative order; c moves only as far as it must.
+	sorted, err := sortResources([]*resource.State{c, a, d, b, oldB})
+	assert.NoError(t, err)
+	assert.Equal(t, []*resource.State{a, d, b, c, oldB}, sorted)
+
+	// A cycle is an error.
+	a.Dependencies = []resource.URN{c.URN}
+	b.Dependencies = []resource.URN{a.URN}
+	_, err = sortResources([]*resource.State{a, b, c})
+	assert.Error(t, err)
+}
+
+func TestSortResourcesLongChain(t *testing.T) {
+	// Each resource depends on the one after it, so the whole list must be reversed.
+	const n = 20000
+	resources := make([]*resource.State, n)
+	for i := n - 1; i >= 0; i-- {
+		var deps []resource.URN
+		if i < n-1 {
+			deps = []resource.URN{resources[i+1].URN}
+		}
+		resources[i] = NewResource(fmt.Sprintf("r%d", i), nil, deps...)
+	}
+
+	sorted, err := sortResources(resources)
+	assert.NoError(t, err)
+	for i, res := range sorted {
+		assert.Equal(t, resources[n-1-i], res)
+	}
+}
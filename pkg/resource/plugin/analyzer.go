@@ -37,6 +37,9 @@ type Analyzer interface {
 	// AnalyzeStack analyzes all resources after a successful preview or update.
 	// Is called after all resources have been processed, and all changes applied.
 	AnalyzeStack(resources []AnalyzerResource) ([]AnalyzeDiagnostic, error)
+	// Remediate is given the opportunity to transform a single resource's inputs before they are checked by its
+	// provider, and returns the remediations that were applied, in order.
+	Remediate(r AnalyzerResource) ([]Remediation, error)
 	// GetAnalyzerInfo returns metadata about the analyzer (e.g., list of policies contained).
 	GetAnalyzerInfo() (AnalyzerInfo, error)
 	// GetPluginInfo returns this plugin's information.
@@ -64,6 +67,16 @@ type AnalyzeDiagnostic struct {
 	URN               resource.URN
 }
 
+// Remediation indicates that a resource remediation took place, and contains the resulting transformed properties
+// and associated metadata.
+type Remediation struct {
+	PolicyName        string
+	PolicyPackName    string
+	PolicyPackVersion string
+	Description       string
+	Properties        resource.PropertyMap
+}
+
 // AnalyzerInfo provides metadata about a PolicyPack inside an analyzer.
 type AnalyzerInfo struct {
 	Name        string
@@ -202,6 +202,62 @@ func (a *analyzer) AnalyzeStack(resources []AnalyzerResource) ([]AnalyzeDiagnost
 	return diags, nil
 }
 
+// Remediate is given the opportunity to transform a single resource's inputs before they are checked by its
+// provider, and returns the remediations that were applied, in order.
+func (a *analyzer) Remediate(r AnalyzerResource) ([]Remediation, error) {
+	urn, t, name, props := r.URN, r.Type, r.Name, r.Properties
+
+	label := fmt.Sprintf("%s.Remediate(%s)", a.label(), t)
+	logging.V(7).Infof("%s executing (#props=%d)", label, len(props))
+	mprops, err := MarshalProperties(props, MarshalOptions{KeepUnknowns: true, KeepSecrets: true})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.client.Remediate(a.ctx.Request(), &pulumirpc.AnalyzeRequest{
+		Urn:        string(urn),
+		Type:       string(t),
+		Name:       string(name),
+		Properties: mprops,
+	})
+	if err != nil {
+		rpcError := rpcerror.Convert(err)
+		// Policy packs built against an older AnalyzerService do not support remediations. Ignore the error as it
+		// just means the analyzer has nothing to transform.
+		if rpcError.Code() == codes.Unimplemented {
+			logging.V(7).Infof("%s is unimplemented, skipping: err=%v", label, rpcError)
+			return nil, nil
+		}
+
+		logging.V(7).Infof("%s failed: err=%v", label, rpcError)
+		return nil, rpcError
+	}
+
+	remediations := resp.GetRemediations()
+	logging.V(7).Infof("%s success: remediations=#%d", label, len(remediations))
+
+	results := make([]Remediation, len(remediations))
+	for i, r := range remediations {
+		// A remediation without properties leaves the resource's inputs untouched.
+		var tprops resource.PropertyMap
+		if r.GetProperties() != nil {
+			tprops, err = UnmarshalProperties(r.GetProperties(), MarshalOptions{KeepUnknowns: true, KeepSecrets: true})
+			if err != nil {
+				return nil, errors.Wrap(err, "converting remediation results")
+			}
+		}
+
+		results[i] = Remediation{
+			PolicyName:        r.GetPolicyName(),
+			PolicyPackName:    r.GetPolicyPackName(),
+			PolicyPackVersion: r.GetPolicyPackVersion(),
+			Description:       r.GetDescription(),
+			Properties:        tprops,
+		}
+	}
+	return results, nil
+}
+
 // GetAnalyzerInfo returns metadata about the policies contained in this analyzer plugin.
 func (a *analyzer) GetAnalyzerInfo() (AnalyzerInfo, error) {
 	label := fmt.Sprintf("%s.GetAnalyzerInfo()", a.label())
@@ -18,6 +18,7 @@ import (
 	"io"
 
 	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/config"
 	"github.com/pulumi/pulumi/pkg/tokens"
 	"github.com/pulumi/pulumi/pkg/util/contract"
 	"github.com/pulumi/pulumi/pkg/workspace"
@@ -39,6 +40,9 @@ type Provider interface {
 	// Pkg fetches this provider's package.
 	Pkg() tokens.Package
 
+	// GetSchema returns the JSON-encoded schema for this provider's package.
+	GetSchema(version int) ([]byte, error)
+
 	// CheckConfig validates the configuration for this resource provider.
 	CheckConfig(urn resource.URN, olds, news resource.PropertyMap,
 		allowUnknowns bool) (resource.PropertyMap, []CheckFailure, error)
@@ -60,15 +64,20 @@ type Provider interface {
 		resource.Status, error)
 	// Read the current live state associated with a resource.  Enough state must be include in the inputs to uniquely
 	// identify the resource; this is typically just the resource ID, but may also include some properties.  If the
-	// resource is missing (for instance, because it has been deleted), the resulting property map will be nil.
+	// resource is missing (for instance, because it has been deleted), the resulting property map will be nil.  If
+	// timeout is positive, the read is cancelled if it has not completed within that many seconds.
 	Read(urn resource.URN, id resource.ID,
-		inputs, state resource.PropertyMap) (ReadResult, resource.Status, error)
+		inputs, state resource.PropertyMap, timeout float64) (ReadResult, resource.Status, error)
 	// Update updates an existing resource with new values.
 	Update(urn resource.URN, id resource.ID,
 		olds resource.PropertyMap, news resource.PropertyMap, timeout float64,
 		ignoreChanges []string) (resource.PropertyMap, resource.Status, error)
 	// Delete tears down an existing resource.
 	Delete(urn resource.URN, id resource.ID, props resource.PropertyMap, timeout float64) (resource.Status, error)
+	// Construct creates a new component resource, registering it and its children with the resource monitor whose
+	// address is given in the construct info.
+	Construct(info ConstructInfo, typ tokens.Type, name tokens.QName, parent resource.URN, inputs resource.PropertyMap,
+		options ConstructOptions) (ConstructResult, error)
 	// Invoke dynamically executes a built-in function in the provider.
 	Invoke(tok tokens.ModuleMember, args resource.PropertyMap) (resource.PropertyMap, []CheckFailure, error)
 	// StreamInvoke dynamically executes a built-in function in the provider, which returns a stream
@@ -206,3 +215,37 @@ type ReadResult struct {
 	// exist.
 	Outputs resource.PropertyMap
 }
+
+// ConstructInfo contains all of the information required to register resources as part of a call to Construct.
+type ConstructInfo struct {
+	Project        string                // the project name housing the program being run.
+	Stack          string                // the stack name being evaluated.
+	Config         map[config.Key]string // the configuration variables to apply before running.
+	DryRun         bool                  // true if we are performing a dry-run (preview).
+	Parallel       int                   // the degree of parallelism for resource operations (<=1 for serial).
+	MonitorAddress string                // the RPC address to the host resource monitor.
+}
+
+// ConstructOptions captures options for a call to Construct.
+type ConstructOptions struct {
+	// Aliases is the set of aliases for the component.
+	Aliases []resource.URN
+	// Dependencies is the list of resources this component depends on.
+	Dependencies []resource.URN
+	// Protect is true if the component is protected.
+	Protect bool
+	// Providers is a map from package name to provider reference.
+	Providers map[string]string
+	// PropertyDependencies is a map from property name to a list of resources that property depends on.
+	PropertyDependencies map[resource.PropertyKey][]resource.URN
+}
+
+// ConstructResult is the result of a call to Construct.
+type ConstructResult struct {
+	// The URN of the constructed component resource.
+	URN resource.URN
+	// The output properties of the component resource.
+	Outputs resource.PropertyMap
+	// The resources that each output property depends on.
+	OutputDependencies map[resource.PropertyKey][]resource.URN
+}
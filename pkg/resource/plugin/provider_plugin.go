@@ -15,9 +15,11 @@
 package plugin
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/blang/semver"
 	pbempty "github.com/golang/protobuf/ptypes/empty"
@@ -99,6 +101,26 @@ func (p *provider) label() string {
 	return fmt.Sprintf("Provider[%s, %p]", p.pkg, p)
 }
 
+// GetSchema fetches the schema for this resource provider, if any.
+func (p *provider) GetSchema(version int) ([]byte, error) {
+	label := fmt.Sprintf("%s.GetSchema(%d)", p.label(), version)
+	logging.V(7).Infof("%s executing", label)
+
+	// Fetching the schema does not require configuration, so we access the clientRaw property rather than calling
+	// getClient.
+	resp, err := p.clientRaw.GetSchema(p.ctx.Request(), &pulumirpc.GetSchemaRequest{
+		Version: int32(version),
+	})
+	if err != nil {
+		rpcError := rpcerror.Convert(err)
+		logging.V(7).Infof("%s failed: err=%v", label, rpcError.Message())
+		return nil, rpcError
+	}
+
+	logging.V(7).Infof("%s success: #schema=%d", label, len(resp.GetSchema()))
+	return []byte(resp.GetSchema()), nil
+}
+
 // isDiffCheckConfigLogicallyUnimplemented returns true when an rpcerror.Error should be treated as if it was an error
 // due to a rpc being unimplemented. Due to past mistakes, different providers returned "Unimplemented" in a variaity of
 // different ways that don't always result in an Uimplemented error code.
@@ -662,7 +684,7 @@ func (p *provider) Create(urn resource.URN, props resource.PropertyMap, timeout
 // read the current live state associated with a resource.  enough state must be include in the inputs to uniquely
 // identify the resource; this is typically just the resource id, but may also include some properties.
 func (p *provider) Read(urn resource.URN, id resource.ID,
-	inputs, state resource.PropertyMap) (ReadResult, resource.Status, error) {
+	inputs, state resource.PropertyMap, timeout float64) (ReadResult, resource.Status, error) {
 
 	contract.Assert(urn != "")
 	contract.Assert(id != "")
@@ -706,19 +728,32 @@ func (p *provider) Read(urn resource.URN, id resource.ID,
 		return ReadResult{}, resource.StatusUnknown, err
 	}
 
+	// If the read is bounded, cancel the request once its deadline passes.
+	ctx := p.ctx.Request()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(timeout*float64(time.Second)))
+		defer cancel()
+	}
+
 	// Now issue the read request over RPC, blocking until it finished.
 	var readID resource.ID
 	var liveObject *_struct.Struct
 	var liveInputs *_struct.Struct
 	var resourceError error
 	var resourceStatus = resource.StatusOK
-	resp, err := client.Read(p.ctx.Request(), &pulumirpc.ReadRequest{
+	resp, err := client.Read(ctx, &pulumirpc.ReadRequest{
 		Id:         string(id),
 		Urn:        string(urn),
 		Properties: mstate,
 		Inputs:     minputs,
 	})
 	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			logging.V(7).Infof("%s timed out: %v", label, err)
+			return ReadResult{}, resource.StatusOK, errors.Errorf("timed out after %v reading resource",
+				time.Duration(timeout*float64(time.Second)))
+		}
 		resourceStatus, readID, liveObject, liveInputs, resourceError = parseError(err)
 		logging.V(7).Infof("%s failed: %v", label, err)
 
@@ -901,6 +936,102 @@ func (p *provider) Delete(urn resource.URN, id resource.ID, props resource.Prope
 	return resource.StatusOK, nil
 }
 
+// Construct creates a new component resource, registering it and its children with the resource monitor whose
+// address is given in the construct info.
+func (p *provider) Construct(info ConstructInfo, typ tokens.Type, name tokens.QName, parent resource.URN,
+	inputs resource.PropertyMap, options ConstructOptions) (ConstructResult, error) {
+	contract.Assert(typ != "")
+	contract.Assert(name != "")
+	contract.Assert(inputs != nil)
+
+	label := fmt.Sprintf("%s.Construct(%s, %s, %s)", p.label(), typ, name, parent)
+	logging.V(7).Infof("%s executing (#inputs=%v)", label, len(inputs))
+
+	// Get the RPC client and ensure it's configured.
+	client, err := p.getClient()
+	if err != nil {
+		return ConstructResult{}, err
+	}
+
+	minputs, err := MarshalProperties(inputs, MarshalOptions{
+		Label:        fmt.Sprintf("%s.inputs", label),
+		KeepUnknowns: true,
+		KeepSecrets:  p.acceptSecrets,
+	})
+	if err != nil {
+		return ConstructResult{}, err
+	}
+
+	config := make(map[string]string)
+	for k, v := range info.Config {
+		config[k.String()] = v
+	}
+	aliases := make([]string, len(options.Aliases))
+	for i, alias := range options.Aliases {
+		aliases[i] = string(alias)
+	}
+	dependencies := make([]string, len(options.Dependencies))
+	for i, dep := range options.Dependencies {
+		dependencies[i] = string(dep)
+	}
+	inputDependencies := make(map[string]*pulumirpc.ConstructRequest_PropertyDependencies)
+	for k, v := range options.PropertyDependencies {
+		urns := make([]string, len(v))
+		for i, urn := range v {
+			urns[i] = string(urn)
+		}
+		inputDependencies[string(k)] = &pulumirpc.ConstructRequest_PropertyDependencies{Urns: urns}
+	}
+
+	resp, err := client.Construct(p.ctx.Request(), &pulumirpc.ConstructRequest{
+		Project:           info.Project,
+		Stack:             info.Stack,
+		Config:            config,
+		DryRun:            info.DryRun,
+		Parallel:          int32(info.Parallel),
+		MonitorEndpoint:   info.MonitorAddress,
+		Type:              string(typ),
+		Name:              string(name),
+		Parent:            string(parent),
+		Inputs:            minputs,
+		InputDependencies: inputDependencies,
+		Protect:           options.Protect,
+		Providers:         options.Providers,
+		Aliases:           aliases,
+		Dependencies:      dependencies,
+	})
+	if err != nil {
+		rpcError := rpcerror.Convert(err)
+		logging.V(7).Infof("%s failed: %v", label, rpcError.Message())
+		return ConstructResult{}, rpcError
+	}
+
+	outputs, err := UnmarshalProperties(resp.GetState(), MarshalOptions{
+		Label:        fmt.Sprintf("%s.outputs", label),
+		KeepUnknowns: info.DryRun,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return ConstructResult{}, err
+	}
+
+	outputDependencies := make(map[resource.PropertyKey][]resource.URN)
+	for k, rpcDeps := range resp.GetStateDependencies() {
+		urns := make([]resource.URN, len(rpcDeps.GetUrns()))
+		for i, urn := range rpcDeps.GetUrns() {
+			urns[i] = resource.URN(urn)
+		}
+		outputDependencies[resource.PropertyKey(k)] = urns
+	}
+
+	logging.V(7).Infof("%s success: #outputs=%d", label, len(outputs))
+	return ConstructResult{
+		URN:                resource.URN(resp.GetUrn()),
+		Outputs:            outputs,
+		OutputDependencies: outputDependencies,
+	}, nil
+}
+
 // Invoke dynamically executes a built-in function in the provider.
 func (p *provider) Invoke(tok tokens.ModuleMember, args resource.PropertyMap) (resource.PropertyMap,
 	[]CheckFailure, error) {
@@ -1,12 +1,20 @@
 package plugin
 
 import (
+	"net"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/util/contract"
+	pulumirpc "github.com/pulumi/pulumi/sdk/proto/go"
 )
 
 func TestAnnotateSecrets(t *testing.T) {
@@ -217,3 +225,108 @@ func TestNestedSecret(t *testing.T) {
 
 	assert.Truef(t, reflect.DeepEqual(to, expected), "did not match expected after annotation")
 }
+
+// schemaProvider is a resource provider server that only implements GetSchema.
+type schemaProvider struct {
+	pulumirpc.ResourceProviderServer
+
+	schema string
+}
+
+func (p *schemaProvider) GetSchema(ctx context.Context,
+	req *pulumirpc.GetSchemaRequest) (*pulumirpc.GetSchemaResponse, error) {
+
+	if req.GetVersion() != 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported schema version %d", req.GetVersion())
+	}
+	return &pulumirpc.GetSchemaResponse{Schema: p.schema}, nil
+}
+
+func TestGetSchema(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if !assert.NoError(t, err) {
+		return
+	}
+	srv := grpc.NewServer()
+	pulumirpc.RegisterResourceProviderServer(srv, &schemaProvider{schema: `{"name":"test"}`})
+	go func() { contract.IgnoreError(srv.Serve(lis)) }()
+	defer srv.Stop()
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer contract.IgnoreClose(conn)
+
+	p := &provider{ctx: &Context{}, pkg: "test", clientRaw: pulumirpc.NewResourceProviderClient(conn)}
+
+	schema, err := p.GetSchema(0)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"name":"test"}`, string(schema))
+
+	_, err = p.GetSchema(1)
+	assert.Error(t, err)
+}
+
+// slowReadProvider is a resource provider server that only implements Read. Reads of the resource with ID "slow"
+// block until they are cancelled.
+type slowReadProvider struct {
+	pulumirpc.ResourceProviderServer
+
+	cancelled chan struct{}
+}
+
+func (p *slowReadProvider) Read(ctx context.Context, req *pulumirpc.ReadRequest) (*pulumirpc.ReadResponse, error) {
+	if req.GetId() == "slow" {
+		select {
+		case <-ctx.Done():
+			close(p.cancelled)
+			return nil, ctx.Err()
+		case <-time.After(time.Minute):
+			return nil, status.Error(codes.Internal, "read was not cancelled")
+		}
+	}
+	return &pulumirpc.ReadResponse{Id: req.GetId(), Properties: req.GetProperties()}, nil
+}
+
+func TestReadTimeout(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if !assert.NoError(t, err) {
+		return
+	}
+	server := &slowReadProvider{cancelled: make(chan struct{})}
+	srv := grpc.NewServer()
+	pulumirpc.RegisterResourceProviderServer(srv, server)
+	go func() { contract.IgnoreError(srv.Serve(lis)) }()
+	defer srv.Stop()
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer contract.IgnoreClose(conn)
+
+	cfgdone := make(chan bool)
+	close(cfgdone)
+	p := &provider{ctx: &Context{}, pkg: "test", clientRaw: pulumirpc.NewResourceProviderClient(conn),
+		cfgknown: true, cfgdone: cfgdone}
+
+	urn := resource.NewURN("stack", "project", "", "test:index:Resource", "res")
+	state := resource.PropertyMap{"foo": resource.NewStringProperty("bar")}
+
+	// A read that completes within its timeout succeeds.
+	result, _, err := p.Read(urn, "fast", nil, state, 60)
+	assert.NoError(t, err)
+	assert.Equal(t, state, result.Outputs)
+
+	// A read that does not is cancelled once its deadline passes, and the provider observes the cancellation.
+	start := time.Now()
+	_, _, err = p.Read(urn, "slow", nil, state, 0.1)
+	assert.EqualError(t, err, "timed out after 100ms reading resource")
+	assert.True(t, time.Since(start) < 30*time.Second, "read took %v", time.Since(start))
+	select {
+	case <-server.cancelled:
+	case <-time.After(30 * time.Second):
+		assert.Fail(t, "the provider's read was not cancelled")
+	}
+}
@@ -37,13 +37,16 @@ type Goal struct {
 	Aliases                 []URN                 // additional URNs that should be aliased to this resource.
 	ID                      ID                    // the expected ID of the resource, if any.
 	CustomTimeouts          CustomTimeouts        // an optional config object for resource options
+	ReplaceOnChanges        []string              // a list of property paths that force a replacement when changed.
+	RetainOnDelete          bool                  // true if the resource should be abandoned rather than deleted.
 }
 
 // NewGoal allocates a new resource goal state.
 func NewGoal(t tokens.Type, name tokens.QName, custom bool, props PropertyMap,
 	parent URN, protect bool, dependencies []URN, provider string, initErrors []string,
 	propertyDependencies map[PropertyKey][]URN, deleteBeforeReplace *bool, ignoreChanges []string,
-	additionalSecretOutputs []PropertyKey, aliases []URN, id ID, customTimeouts *CustomTimeouts) *Goal {
+	additionalSecretOutputs []PropertyKey, aliases []URN, id ID, customTimeouts *CustomTimeouts,
+	replaceOnChanges []string, retainOnDelete bool) *Goal {
 
 	g := &Goal{
 		Type:                    t,
@@ -61,6 +64,8 @@ func NewGoal(t tokens.Type, name tokens.QName, custom bool, props PropertyMap,
 		AdditionalSecretOutputs: additionalSecretOutputs,
 		Aliases:                 aliases,
 		ID:                      id,
+		ReplaceOnChanges:        replaceOnChanges,
+		RetainOnDelete:          retainOnDelete,
 	}
 
 	if customTimeouts != nil {
@@ -42,6 +42,7 @@ type State struct {
 	AdditionalSecretOutputs []PropertyKey         // an additional set of outputs that should be treated as secrets.
 	Aliases                 []URN                 // TODO
 	CustomTimeouts          CustomTimeouts        // A config block that will be used to configure timeouts for CRUD operations
+	RetainOnDelete          bool                  // true if the resource should be abandoned rather than deleted.
 }
 
 // NewState creates a new resource value from existing resource state information.
@@ -49,7 +50,7 @@ func NewState(t tokens.Type, urn URN, custom bool, del bool, id ID,
 	inputs PropertyMap, outputs PropertyMap, parent URN, protect bool,
 	external bool, dependencies []URN, initErrors []string, provider string,
 	propertyDependencies map[PropertyKey][]URN, pendingReplacement bool,
-	additionalSecretOutputs []PropertyKey, aliases []URN, timeouts *CustomTimeouts) *State {
+	additionalSecretOutputs []PropertyKey, aliases []URN, timeouts *CustomTimeouts, retainOnDelete bool) *State {
 
 	contract.Assertf(t != "", "type was empty")
 	contract.Assertf(custom || id == "", "is custom or had empty ID")
@@ -73,6 +74,7 @@ func NewState(t tokens.Type, urn URN, custom bool, del bool, id ID,
 		PendingReplacement:      pendingReplacement,
 		AdditionalSecretOutputs: additionalSecretOutputs,
 		Aliases:                 aliases,
+		RetainOnDelete:          retainOnDelete,
 	}
 
 	if timeouts != nil {
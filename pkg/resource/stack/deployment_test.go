@@ -84,6 +84,7 @@ func TestDeploymentSerialization(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		false,
 	)
 
 	dep, err := SerializeResource(res, config.NopEncrypter)
@@ -0,0 +1,105 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stack
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/apitype"
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/config"
+	"github.com/pulumi/pulumi/pkg/resource/deploy"
+)
+
+// SerializeUpdatePlan serializes an update plan, encrypting any secrets it contains using the given encrypter.
+func SerializeUpdatePlan(plan *deploy.UpdatePlan, enc config.Encrypter) (*apitype.UpdatePlanV1, error) {
+	resourcePlans := make(map[resource.URN]apitype.ResourcePlanV1)
+	for urn, rp := range plan.ResourcePlans {
+		ops := make([]apitype.OpType, len(rp.Ops))
+		for i, op := range rp.Ops {
+			ops[i] = apitype.OpType(op)
+		}
+
+		olds, err := serializeOptionalProperties(rp.Olds, enc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "serializing old inputs of %s", urn)
+		}
+		news, err := serializeOptionalProperties(rp.News, enc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "serializing new inputs of %s", urn)
+		}
+
+		resourcePlans[urn] = apitype.ResourcePlanV1{
+			Ops:   ops,
+			Olds:  olds,
+			News:  news,
+			Diffs: rp.Diffs,
+		}
+	}
+
+	return &apitype.UpdatePlanV1{
+		Version:       apitype.UpdatePlanSchemaVersionCurrent,
+		ResourcePlans: resourcePlans,
+	}, nil
+}
+
+// DeserializeUpdatePlan deserializes an update plan, decrypting any secrets it contains using the given decrypter.
+func DeserializeUpdatePlan(plan apitype.UpdatePlanV1, dec config.Decrypter) (*deploy.UpdatePlan, error) {
+	if plan.Version > apitype.UpdatePlanSchemaVersionCurrent {
+		return nil, errors.Errorf("update plan version %d is newer than the supported version %d",
+			plan.Version, apitype.UpdatePlanSchemaVersionCurrent)
+	}
+
+	result := deploy.NewUpdatePlan()
+	for urn, rp := range plan.ResourcePlans {
+		ops := make([]deploy.StepOp, len(rp.Ops))
+		for i, op := range rp.Ops {
+			ops[i] = deploy.StepOp(op)
+		}
+
+		olds, err := deserializeOptionalProperties(rp.Olds, dec)
+		if err != nil {
+			return nil, errors.Wrapf(err, "deserializing old inputs of %s", urn)
+		}
+		news, err := deserializeOptionalProperties(rp.News, dec)
+		if err != nil {
+			return nil, errors.Wrapf(err, "deserializing new inputs of %s", urn)
+		}
+
+		result.ResourcePlans[urn] = &deploy.ResourcePlan{
+			Ops:   ops,
+			Olds:  olds,
+			News:  news,
+			Diffs: rp.Diffs,
+		}
+	}
+	return result, nil
+}
+
+// serializeOptionalProperties serializes a property map, preserving the distinction between nil and empty maps.
+func serializeOptionalProperties(props resource.PropertyMap, enc config.Encrypter) (map[string]interface{}, error) {
+	if props == nil {
+		return nil, nil
+	}
+	return SerializeProperties(props, enc)
+}
+
+// deserializeOptionalProperties deserializes a property map, preserving the distinction between nil and empty maps.
+func deserializeOptionalProperties(props map[string]interface{}, dec config.Decrypter) (resource.PropertyMap, error) {
+	if props == nil {
+		return nil, nil
+	}
+	return DeserializeProperties(props, dec)
+}
@@ -0,0 +1,82 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stack
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/apitype"
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/config"
+	"github.com/pulumi/pulumi/pkg/resource/deploy"
+)
+
+func TestUpdatePlanSerialization(t *testing.T) {
+	createURN := resource.URN("urn:pulumi:test::test::pkgA:m:typA::resA")
+	deleteURN := resource.URN("urn:pulumi:test::test::pkgA:m:typA::resB")
+
+	plan := deploy.NewUpdatePlan()
+	plan.ResourcePlans[createURN] = &deploy.ResourcePlan{
+		Ops: []deploy.StepOp{deploy.OpCreate},
+		News: resource.PropertyMap{
+			"foo":    resource.NewStringProperty("bar"),
+			"secret": resource.MakeSecret(resource.NewStringProperty("shh")),
+			"output": resource.MakeComputed(resource.NewStringProperty("")),
+		},
+	}
+	plan.ResourcePlans[deleteURN] = &deploy.ResourcePlan{
+		Ops:  []deploy.StepOp{deploy.OpDelete},
+		Olds: resource.PropertyMap{},
+	}
+
+	crypter := config.NewSymmetricCrypter(make([]byte, 32))
+	serialized, err := SerializeUpdatePlan(plan, crypter)
+	assert.NoError(t, err)
+
+	// Round-trip the plan through JSON, as it is when saved to a file.
+	bytes, err := json.Marshal(serialized)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(bytes), "shh")
+	var roundTripped apitype.UpdatePlanV1
+	assert.NoError(t, json.Unmarshal(bytes, &roundTripped))
+
+	deserialized, err := DeserializeUpdatePlan(roundTripped, crypter)
+	assert.NoError(t, err)
+
+	create := deserialized.ResourcePlans[createURN]
+	if assert.NotNil(t, create) {
+		assert.Equal(t, []deploy.StepOp{deploy.OpCreate}, create.Ops)
+		assert.Nil(t, create.Olds)
+		assert.Equal(t, "bar", create.News["foo"].StringValue())
+		assert.True(t, create.News["secret"].IsSecret())
+		assert.Equal(t, "shh", create.News["secret"].SecretValue().Element.StringValue())
+		assert.True(t, create.News["output"].IsComputed())
+	}
+
+	del := deserialized.ResourcePlans[deleteURN]
+	if assert.NotNil(t, del) {
+		assert.Equal(t, []deploy.StepOp{deploy.OpDelete}, del.Ops)
+		assert.NotNil(t, del.Olds)
+		assert.Nil(t, del.News)
+	}
+
+	// Plans from newer versions of the CLI are rejected.
+	roundTripped.Version = apitype.UpdatePlanSchemaVersionCurrent + 1
+	_, err = DeserializeUpdatePlan(roundTripped, crypter)
+	assert.Error(t, err)
+}
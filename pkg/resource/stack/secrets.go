@@ -22,6 +22,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/resource"
 	"github.com/pulumi/pulumi/pkg/resource/config"
 	"github.com/pulumi/pulumi/pkg/secrets"
+	"github.com/pulumi/pulumi/pkg/secrets/age"
 	"github.com/pulumi/pulumi/pkg/secrets/b64"
 	"github.com/pulumi/pulumi/pkg/secrets/cloud"
 	"github.com/pulumi/pulumi/pkg/secrets/passphrase"
@@ -56,6 +57,8 @@ func (defaultSecretsProvider) OfType(ty string, state json.RawMessage) (secrets.
 		sm, err = service.NewServiceSecretsManagerFromState(state)
 	case cloud.Type:
 		sm, err = cloud.NewCloudSecretsManagerFromState(state)
+	case age.Type:
+		sm, err = age.NewAgeSecretsManagerFromState(state)
 	default:
 		return nil, errors.Errorf("no known secrets provider for type %q", ty)
 	}
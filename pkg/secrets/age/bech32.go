@@ -0,0 +1,156 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package age
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// This file implements the Bech32 encoding described in BIP 173, which age uses for its keys. Unlike BIP 173, age
+// places no limit on the length of an encoded string.
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+var bech32Generator = []uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+
+func bech32Polymod(values []byte) uint32 {
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (top>>uint(i))&1 == 1 {
+				chk ^= bech32Generator[i]
+			}
+		}
+	}
+	return chk
+}
+
+func bech32HRPExpand(hrp string) []byte {
+	h := []byte(strings.ToLower(hrp))
+	ret := make([]byte, 0, len(h)*2+1)
+	for _, c := range h {
+		ret = append(ret, c>>5)
+	}
+	ret = append(ret, 0)
+	for _, c := range h {
+		ret = append(ret, c&31)
+	}
+	return ret
+}
+
+// convertBits regroups the given bytes from groups of fromBits bits to groups of toBits bits.
+func convertBits(data []byte, fromBits, toBits uint, pad bool) ([]byte, error) {
+	var ret []byte
+	acc, bits := uint32(0), uint(0)
+	maxv := byte(1<<toBits - 1)
+	for _, value := range data {
+		if value>>fromBits != 0 {
+			return nil, errors.Errorf("invalid data range: %d", value)
+		}
+		acc = acc<<fromBits | uint32(value)
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			ret = append(ret, byte(acc>>bits)&maxv)
+		}
+	}
+	if pad {
+		if bits > 0 {
+			ret = append(ret, byte(acc<<(toBits-bits))&maxv)
+		}
+	} else if bits >= fromBits {
+		return nil, errors.New("illegal zero padding")
+	} else if byte(acc<<(toBits-bits))&maxv != 0 {
+		return nil, errors.New("non-zero padding")
+	}
+	return ret, nil
+}
+
+// bech32Encode encodes the given data with the given human readable part. The result is lower case, unless the human
+// readable part is upper case.
+func bech32Encode(hrp string, data []byte) (string, error) {
+	values, err := convertBits(data, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	if len(hrp) < 1 {
+		return "", errors.Errorf("invalid human readable part: %q", hrp)
+	}
+	for _, c := range hrp {
+		if c < 33 || c > 126 {
+			return "", errors.Errorf("invalid human readable part character: %q", c)
+		}
+	}
+	upper := strings.ToUpper(hrp) == hrp
+	hrp = strings.ToLower(hrp)
+
+	var b strings.Builder
+	b.WriteString(hrp)
+	b.WriteByte('1')
+	for _, v := range values {
+		b.WriteByte(bech32Charset[v])
+	}
+
+	polymod := bech32Polymod(append(append(bech32HRPExpand(hrp), values...), 0, 0, 0, 0, 0, 0)) ^ 1
+	for i := 0; i < 6; i++ {
+		b.WriteByte(bech32Charset[(polymod>>uint(5*(5-i)))&31])
+	}
+
+	if upper {
+		return strings.ToUpper(b.String()), nil
+	}
+	return b.String(), nil
+}
+
+// bech32Decode decodes the given string, returning its human readable part and data.
+func bech32Decode(s string) (string, []byte, error) {
+	if strings.ToLower(s) != s && strings.ToUpper(s) != s {
+		return "", nil, errors.New("mixed case")
+	}
+	pos := strings.LastIndex(s, "1")
+	if pos < 1 || pos+7 > len(s) {
+		return "", nil, errors.New("separator '1' at invalid position")
+	}
+
+	hrp := s[:pos]
+	for _, c := range hrp {
+		if c < 33 || c > 126 {
+			return "", nil, errors.Errorf("invalid character in human readable part: %q", c)
+		}
+	}
+
+	s = strings.ToLower(s)
+	var values []byte
+	for _, c := range s[pos+1:] {
+		d := strings.IndexRune(bech32Charset, c)
+		if d == -1 {
+			return "", nil, errors.Errorf("invalid character in data part: %q", c)
+		}
+		values = append(values, byte(d))
+	}
+	if bech32Polymod(append(bech32HRPExpand(hrp), values...)) != 1 {
+		return "", nil, errors.New("invalid checksum")
+	}
+
+	data, err := convertBits(values[:len(values)-6], 5, 8, false)
+	if err != nil {
+		return "", nil, err
+	}
+	return hrp, data, nil
+}
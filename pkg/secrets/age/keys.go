@@ -0,0 +1,208 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package age
+
+import (
+	"bufio"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/curve25519"
+
+	"github.com/pulumi/pulumi/pkg/util/contract"
+	"github.com/pulumi/pulumi/pkg/workspace"
+)
+
+const (
+	recipientPrefix = "age"
+	identityPrefix  = "AGE-SECRET-KEY-"
+
+	// IdentityFileEnvVar is the environment variable that names the file holding the identities used to decrypt
+	// secrets. If it is not set, DefaultIdentityFile is used.
+	IdentityFileEnvVar = "PULUMI_AGE_IDENTITY_FILE"
+)
+
+// Recipient is an X25519 public key that secrets may be encrypted for. Its string form is the same as that of an
+// age recipient, e.g. `age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p`.
+type Recipient [32]byte
+
+// Identity is an X25519 private key that can decrypt secrets encrypted for its recipient. Its string form is the same
+// as that of an age identity, e.g. `AGE-SECRET-KEY-1...`.
+type Identity [32]byte
+
+// GenerateIdentity generates a new random identity.
+func GenerateIdentity() (Identity, error) {
+	var i Identity
+	if _, err := rand.Read(i[:]); err != nil {
+		return Identity{}, err
+	}
+	return i, nil
+}
+
+// ParseRecipient parses the string form of a recipient.
+func ParseRecipient(s string) (Recipient, error) {
+	hrp, data, err := bech32Decode(s)
+	switch {
+	case err != nil:
+		return Recipient{}, errors.Wrapf(err, "malformed recipient %q", s)
+	case hrp != recipientPrefix:
+		return Recipient{}, errors.Errorf("malformed recipient %q: invalid type %q", s, hrp)
+	case len(data) != len(Recipient{}):
+		return Recipient{}, errors.Errorf("malformed recipient %q: invalid length", s)
+	}
+	var r Recipient
+	copy(r[:], data)
+	return r, nil
+}
+
+// String returns the string form of the recipient.
+func (r Recipient) String() string {
+	s, err := bech32Encode(recipientPrefix, r[:])
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// ParseIdentity parses the string form of an identity.
+func ParseIdentity(s string) (Identity, error) {
+	hrp, data, err := bech32Decode(s)
+	switch {
+	case err != nil:
+		return Identity{}, errors.Wrap(err, "malformed identity")
+	case strings.ToUpper(hrp) != identityPrefix:
+		return Identity{}, errors.Errorf("malformed identity: invalid type %q", hrp)
+	case len(data) != len(Identity{}):
+		return Identity{}, errors.New("malformed identity: invalid length")
+	}
+	var i Identity
+	copy(i[:], data)
+	return i, nil
+}
+
+// String returns the string form of the identity.
+func (i Identity) String() string {
+	s, err := bech32Encode(identityPrefix, i[:])
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// Recipient returns the recipient whose secrets this identity can decrypt.
+func (i Identity) Recipient() Recipient {
+	var r, scalar [32]byte
+	scalar = i
+	curve25519.ScalarBaseMult(&r, &scalar)
+	return Recipient(r)
+}
+
+// ParseKeys parses a file in the format written by `age-keygen`: one identity or recipient per line, with blank lines
+// and lines that start with `#` ignored. Recipients are returned for every identity in the file as well as for
+// every recipient.
+func ParseKeys(r io.Reader) ([]Identity, []Recipient, error) {
+	var identities []Identity
+	var recipients []Recipient
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+			continue
+		case strings.HasPrefix(line, identityPrefix):
+			i, err := ParseIdentity(line)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "line %d", n)
+			}
+			identities, recipients = append(identities, i), append(recipients, i.Recipient())
+		default:
+			r, err := ParseRecipient(line)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "line %d", n)
+			}
+			recipients = append(recipients, r)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+	return identities, recipients, nil
+}
+
+// ReadKeyFile reads the identities and recipients in the given file. See ParseKeys for the file's format.
+func ReadKeyFile(path string) ([]Identity, []Recipient, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer contract.IgnoreClose(f)
+
+	identities, recipients, err := ParseKeys(f)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "reading keys from %s", path)
+	}
+	return identities, recipients, nil
+}
+
+// EnsureKeyFile makes sure that the key file at the given path exists, generating a new identity for it if it does
+// not, and returns its identities and recipients.
+func EnsureKeyFile(path string) ([]Identity, []Recipient, error) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		i, err := GenerateIdentity()
+		if err != nil {
+			return nil, nil, err
+		}
+		contents := fmt.Sprintf("# public key: %s\n%s\n", i.Recipient(), i)
+		if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			return nil, nil, err
+		}
+		if err = ioutil.WriteFile(path, []byte(contents), 0600); err != nil {
+			return nil, nil, errors.Wrapf(err, "writing new key file %s", path)
+		}
+	}
+	return ReadKeyFile(path)
+}
+
+// DefaultIdentityFile returns the path of the file that holds identities if IdentityFileEnvVar is not set.
+func DefaultIdentityFile() (string, error) {
+	return workspace.GetPulumiPath("age", "keys.txt")
+}
+
+// IdentityFile returns the path of the file that holds the identities used to decrypt secrets.
+func IdentityFile() (string, error) {
+	if path := os.Getenv(IdentityFileEnvVar); path != "" {
+		return path, nil
+	}
+	return DefaultIdentityFile()
+}
+
+// LoadIdentities loads the identities used to decrypt secrets from the identity file, if it exists.
+func LoadIdentities() ([]Identity, error) {
+	path, err := IdentityFile()
+	if err != nil {
+		return nil, err
+	}
+	identities, _, err := ReadKeyFile(path)
+	if os.IsNotExist(errors.Cause(err)) {
+		return nil, nil
+	}
+	return identities, err
+}
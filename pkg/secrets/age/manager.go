@@ -0,0 +1,324 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package age implements a secrets provider that encrypts secrets for one or more X25519 recipients, using the same
+// key formats as the age file encryption tool. Secrets are encrypted with a random data key, which is in turn
+// encrypted separately for each recipient; anyone holding the identity (private key) of any recipient can decrypt
+// them. Recipients can therefore be added or removed by re-encrypting the data key alone; see RewrapDataKey.
+package age
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"net/url"
+	"os"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/hkdf"
+
+	"github.com/pulumi/pulumi/pkg/resource/config"
+	"github.com/pulumi/pulumi/pkg/secrets"
+)
+
+// Type is the type of secrets managed by this secrets provider
+const Type = "age"
+
+// wrapInfo is the HKDF info string used to derive the keys that wrap data keys.
+const wrapInfo = "pulumi-age-x25519"
+
+type ageSecretsManagerState struct {
+	URL          string `json:"url"`
+	EncryptedKey []byte `json:"encryptedkey"`
+}
+
+// stanza holds a data key encrypted for a single recipient.
+type stanza struct {
+	Recipient string `json:"recipient"`
+	Ephemeral []byte `json:"ephemeral"`
+	Body      []byte `json:"body"`
+}
+
+// NewAgeSecretsManagerFromState deserializes configuration from state and returns a secrets manager that decrypts
+// the data key using the identities in the identity file.
+func NewAgeSecretsManagerFromState(state json.RawMessage) (secrets.Manager, error) {
+	var s ageSecretsManagerState
+	if err := json.Unmarshal(state, &s); err != nil {
+		return nil, errors.Wrap(err, "unmarshalling state")
+	}
+
+	return NewAgeSecretsManager(s.URL, s.EncryptedKey)
+}
+
+// ParseURL returns the key file and the recipients named by the given secrets provider URL, which has one of the
+// following forms:
+//
+//     age                                        - the identity file, see IdentityFile
+//     age:///path/to/keys.txt                    - the given key file
+//     age://?recipient=age1...&recipient=age1... - the given recipients
+//
+// The key file form may be combined with explicit recipients.
+func ParseURL(providerURL string) (string, []Recipient, error) {
+	if providerURL == Type {
+		providerURL = Type + "://"
+	}
+	u, err := url.Parse(providerURL)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "parsing secrets provider URL %q", providerURL)
+	}
+	if u.Scheme != Type || u.Host != "" {
+		return "", nil, errors.Errorf("invalid secrets provider URL %q", providerURL)
+	}
+
+	var recipients []Recipient
+	for _, s := range u.Query()["recipient"] {
+		r, err := ParseRecipient(s)
+		if err != nil {
+			return "", nil, err
+		}
+		recipients = append(recipients, r)
+	}
+
+	keyFile := u.Path
+	if keyFile == "" && len(recipients) == 0 {
+		if keyFile, err = IdentityFile(); err != nil {
+			return "", nil, err
+		}
+	}
+	return keyFile, recipients, nil
+}
+
+// GenerateNewDataKey generates a new data key seeded by a fresh random 32-byte key and encrypted for each recipient
+// named by the given URL. If the URL names a key file that does not exist, a new identity is generated and written
+// to it.
+func GenerateNewDataKey(providerURL string) ([]byte, error) {
+	recipients, err := urlRecipients(providerURL)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintextDataKey := make([]byte, 32)
+	if _, err = rand.Read(plaintextDataKey); err != nil {
+		return nil, err
+	}
+	return wrapDataKey(plaintextDataKey, recipients)
+}
+
+// RecipientsChanged returns true if the given data key is not encrypted for exactly the recipients named by the
+// given URL, in which case it should be re-encrypted with RewrapDataKey.
+func RecipientsChanged(providerURL string, encryptedDataKey []byte) (bool, error) {
+	recipients, err := urlRecipients(providerURL)
+	if err != nil {
+		return false, err
+	}
+	var stanzas []stanza
+	if err = json.Unmarshal(encryptedDataKey, &stanzas); err != nil {
+		return false, errors.Wrap(err, "unmarshalling encrypted data key")
+	}
+
+	want := make(map[string]bool)
+	for _, r := range recipients {
+		want[r.String()] = true
+	}
+	have := make(map[string]bool)
+	for _, s := range stanzas {
+		if !want[s.Recipient] {
+			return true, nil
+		}
+		have[s.Recipient] = true
+	}
+	return len(have) != len(want), nil
+}
+
+// RewrapDataKey decrypts the given data key using the identities available to NewAgeSecretsManager and encrypts it
+// again for exactly the recipients named by the given URL. Secrets encrypted with the data key remain valid, so
+// recipients can be added or removed without re-encrypting them. Note that a removed recipient who kept a copy of
+// the old encrypted data key can still decrypt existing secrets; rotate the data key with
+// `pulumi stack change-secrets-provider` to revoke their access.
+func RewrapDataKey(providerURL string, encryptedDataKey []byte) ([]byte, error) {
+	identities, err := urlIdentities(providerURL)
+	if err != nil {
+		return nil, err
+	}
+	plaintextDataKey, err := unwrapDataKey(encryptedDataKey, identities)
+	if err != nil {
+		return nil, err
+	}
+	recipients, err := urlRecipients(providerURL)
+	if err != nil {
+		return nil, err
+	}
+	return wrapDataKey(plaintextDataKey, recipients)
+}
+
+// NewAgeSecretsManager returns a secrets manager that decrypts the given data key using the identities in the
+// identity file and the key file named by the given URL, if any, and then uses it to encrypt and decrypt secrets.
+func NewAgeSecretsManager(providerURL string, encryptedDataKey []byte) (*Manager, error) {
+	identities, err := urlIdentities(providerURL)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintextDataKey, err := unwrapDataKey(encryptedDataKey, identities)
+	if err != nil {
+		return nil, err
+	}
+	crypter := config.NewSymmetricCrypter(plaintextDataKey)
+	return &Manager{
+		crypter: crypter,
+		state: ageSecretsManagerState{
+			URL:          providerURL,
+			EncryptedKey: encryptedDataKey,
+		},
+	}, nil
+}
+
+// Manager is the secrets.Manager implementation for age recipients
+type Manager struct {
+	state   ageSecretsManagerState
+	crypter config.Crypter
+}
+
+func (m *Manager) Type() string                         { return Type }
+func (m *Manager) State() interface{}                   { return m.state }
+func (m *Manager) Encrypter() (config.Encrypter, error) { return m.crypter, nil }
+func (m *Manager) Decrypter() (config.Decrypter, error) { return m.crypter, nil }
+func (m *Manager) EncryptedKey() []byte                 { return m.state.EncryptedKey }
+
+// urlRecipients returns the recipients named by the given URL, including those of its key file, if any. If the key
+// file does not exist, a new identity is generated and written to it.
+func urlRecipients(providerURL string) ([]Recipient, error) {
+	keyFile, recipients, err := ParseURL(providerURL)
+	if err != nil {
+		return nil, err
+	}
+	if keyFile != "" {
+		_, fileRecipients, err := EnsureKeyFile(keyFile)
+		if err != nil {
+			return nil, err
+		}
+		recipients = append(fileRecipients, recipients...)
+	}
+	if len(recipients) == 0 {
+		return nil, errors.Errorf("secrets provider URL %q does not name any recipients", providerURL)
+	}
+	return recipients, nil
+}
+
+// urlIdentities returns the identities in the identity file and in the key file named by the given URL, if any.
+func urlIdentities(providerURL string) ([]Identity, error) {
+	identities, err := LoadIdentities()
+	if err != nil {
+		return nil, err
+	}
+	keyFile, _, err := ParseURL(providerURL)
+	if err != nil {
+		return nil, err
+	}
+	if keyFile != "" {
+		fileIdentities, _, err := ReadKeyFile(keyFile)
+		if err != nil && !os.IsNotExist(errors.Cause(err)) {
+			return nil, err
+		}
+		identities = append(identities, fileIdentities...)
+	}
+	return identities, nil
+}
+
+// wrapDataKey encrypts the given data key for each of the given recipients.
+func wrapDataKey(dataKey []byte, recipients []Recipient) ([]byte, error) {
+	stanzas := make([]stanza, 0, len(recipients))
+	seen := make(map[Recipient]bool)
+	for _, r := range recipients {
+		if seen[r] {
+			continue
+		}
+		seen[r] = true
+
+		ephemeral, err := GenerateIdentity()
+		if err != nil {
+			return nil, err
+		}
+		ephemeralRecipient := ephemeral.Recipient()
+
+		aead, err := newWrapCipher(ephemeral, r, ephemeralRecipient, r)
+		if err != nil {
+			return nil, err
+		}
+		stanzas = append(stanzas, stanza{
+			Recipient: r.String(),
+			Ephemeral: ephemeralRecipient[:],
+			Body:      aead.Seal(nil, make([]byte, chacha20poly1305.NonceSize), dataKey, nil),
+		})
+	}
+	return json.Marshal(stanzas)
+}
+
+// unwrapDataKey decrypts the given data key using the first of the given identities that it was encrypted for.
+func unwrapDataKey(encryptedDataKey []byte, identities []Identity) ([]byte, error) {
+	var stanzas []stanza
+	if err := json.Unmarshal(encryptedDataKey, &stanzas); err != nil {
+		return nil, errors.Wrap(err, "unmarshalling encrypted data key")
+	}
+
+	for _, i := range identities {
+		recipient := i.Recipient()
+		for _, s := range stanzas {
+			if s.Recipient != recipient.String() || len(s.Ephemeral) != len(Recipient{}) {
+				continue
+			}
+
+			var ephemeral Recipient
+			copy(ephemeral[:], s.Ephemeral)
+			aead, err := newWrapCipher(i, ephemeral, ephemeral, recipient)
+			if err != nil {
+				return nil, err
+			}
+			dataKey, err := aead.Open(nil, make([]byte, chacha20poly1305.NonceSize), s.Body, nil)
+			if err != nil {
+				return nil, errors.Wrapf(err, "decrypting data key for recipient %s", recipient)
+			}
+			return dataKey, nil
+		}
+	}
+
+	path, err := IdentityFile()
+	if err != nil {
+		return nil, errors.New("no identity available to decrypt the data key")
+	}
+	return nil, errors.Errorf("none of the identities available can decrypt the data key; add an identity for one "+
+		"of the stack's recipients to %s or set %s", path, IdentityFileEnvVar)
+}
+
+// newWrapCipher derives the cipher that wraps a data key from the X25519 shared secret of the given identity and
+// peer, and from the ephemeral and recipient public keys involved in the exchange.
+func newWrapCipher(identity Identity, peer, ephemeral, recipient Recipient) (cipher.AEAD, error) {
+	var shared, scalar, point [32]byte
+	scalar, point = identity, peer
+	curve25519.ScalarMult(&shared, &scalar, &point)
+	if shared == ([32]byte{}) {
+		return nil, errors.New("invalid X25519 recipient")
+	}
+
+	salt := make([]byte, 0, 64)
+	salt = append(append(salt, ephemeral[:]...), recipient[:]...)
+	key := make([]byte, chacha20poly1305.KeySize)
+	if _, err := hkdf.New(sha256.New, shared[:], salt, []byte(wrapInfo)).Read(key); err != nil {
+		return nil, err
+	}
+	return chacha20poly1305.New(key)
+}
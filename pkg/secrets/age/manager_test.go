@@ -0,0 +1,239 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package age
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyRoundTrip(t *testing.T) {
+	i, err := GenerateIdentity()
+	assert.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(i.String(), "AGE-SECRET-KEY-1"))
+	parsed, err := ParseIdentity(i.String())
+	assert.NoError(t, err)
+	assert.Equal(t, i, parsed)
+
+	r := i.Recipient()
+	assert.True(t, strings.HasPrefix(r.String(), "age1"))
+	parsedRecipient, err := ParseRecipient(r.String())
+	assert.NoError(t, err)
+	assert.Equal(t, r, parsedRecipient)
+
+	// Corrupting a character breaks the checksum.
+	s := []byte(r.String())
+	if s[10] == 'q' {
+		s[10] = 'p'
+	} else {
+		s[10] = 'q'
+	}
+	_, err = ParseRecipient(string(s))
+	assert.Error(t, err)
+
+	// Keys generated by age itself are understood.
+	const ageRecipient = "age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p"
+	parsedRecipient, err = ParseRecipient(ageRecipient)
+	assert.NoError(t, err)
+	assert.Equal(t, ageRecipient, parsedRecipient.String())
+
+	// Identities are not recipients and vice versa.
+	_, err = ParseRecipient(i.String())
+	assert.Error(t, err)
+	_, err = ParseIdentity(r.String())
+	assert.Error(t, err)
+}
+
+func TestParseKeys(t *testing.T) {
+	i, err := GenerateIdentity()
+	assert.NoError(t, err)
+	other, err := GenerateIdentity()
+	assert.NoError(t, err)
+
+	identities, recipients, err := ParseKeys(strings.NewReader(
+		"# created: 2019-12-01\n# public key: " + i.Recipient().String() + "\n" + i.String() + "\n\n" +
+			other.Recipient().String() + "\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, []Identity{i}, identities)
+	assert.Equal(t, []Recipient{i.Recipient(), other.Recipient()}, recipients)
+
+	_, _, err = ParseKeys(strings.NewReader("not a key\n"))
+	assert.Error(t, err)
+}
+
+func TestManagerMultipleRecipients(t *testing.T) {
+	dir, err := ioutil.TempDir("", "age")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	alice, err := GenerateIdentity()
+	assert.NoError(t, err)
+	bob, err := GenerateIdentity()
+	assert.NoError(t, err)
+	eve, err := GenerateIdentity()
+	assert.NoError(t, err)
+
+	url := "age://?recipient=" + alice.Recipient().String() + "&recipient=" + bob.Recipient().String()
+	identityFile := filepath.Join(dir, "keys.txt")
+	defer os.Unsetenv(IdentityFileEnvVar)
+	assert.NoError(t, os.Setenv(IdentityFileEnvVar, identityFile))
+
+	encryptedKey, err := GenerateNewDataKey(url)
+	assert.NoError(t, err)
+
+	var ciphertext string
+	for idx, identity := range []Identity{alice, bob} {
+		assert.NoError(t, ioutil.WriteFile(identityFile, []byte(identity.String()+"\n"), 0600))
+
+		m, err := NewAgeSecretsManager(url, encryptedKey)
+		assert.NoError(t, err)
+		if idx == 0 {
+			enc, err := m.Encrypter()
+			assert.NoError(t, err)
+			ciphertext, err = enc.EncryptValue("hunter2")
+			assert.NoError(t, err)
+		}
+
+		// Either recipient can decrypt what the other encrypted, including via the state in a checkpoint.
+		state, err := json.Marshal(m.State())
+		assert.NoError(t, err)
+		fromState, err := NewAgeSecretsManagerFromState(state)
+		assert.NoError(t, err)
+		dec, err := fromState.Decrypter()
+		assert.NoError(t, err)
+		plaintext, err := dec.DecryptValue(ciphertext)
+		assert.NoError(t, err)
+		assert.Equal(t, "hunter2", plaintext)
+	}
+
+	// Someone who is not a recipient can't.
+	assert.NoError(t, ioutil.WriteFile(identityFile, []byte(eve.String()+"\n"), 0600))
+	_, err = NewAgeSecretsManager(url, encryptedKey)
+	assert.Error(t, err)
+}
+
+func TestManagerKeyFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "age")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	defer os.Unsetenv(IdentityFileEnvVar)
+	assert.NoError(t, os.Setenv(IdentityFileEnvVar, filepath.Join(dir, "missing.txt")))
+
+	// A key file is generated on demand, and its identities are used to decrypt.
+	keyFile := filepath.Join(dir, "stack-keys.txt")
+	url := "age://" + keyFile
+	encryptedKey, err := GenerateNewDataKey(url)
+	assert.NoError(t, err)
+
+	identities, _, err := ReadKeyFile(keyFile)
+	assert.NoError(t, err)
+	assert.Len(t, identities, 1)
+
+	_, err = NewAgeSecretsManager(url, encryptedKey)
+	assert.NoError(t, err)
+}
+
+func TestRewrapDataKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "age")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	alice, err := GenerateIdentity()
+	assert.NoError(t, err)
+	bob, err := GenerateIdentity()
+	assert.NoError(t, err)
+
+	identityFile := filepath.Join(dir, "keys.txt")
+	defer os.Unsetenv(IdentityFileEnvVar)
+	assert.NoError(t, os.Setenv(IdentityFileEnvVar, identityFile))
+	useIdentity := func(i Identity) {
+		assert.NoError(t, ioutil.WriteFile(identityFile, []byte(i.String()+"\n"), 0600))
+	}
+	decrypt := func(url string, encryptedKey []byte, ciphertext string) (string, error) {
+		m, err := NewAgeSecretsManager(url, encryptedKey)
+		if err != nil {
+			return "", err
+		}
+		dec, err := m.Decrypter()
+		assert.NoError(t, err)
+		return dec.DecryptValue(ciphertext)
+	}
+
+	aliceURL := "age://?recipient=" + alice.Recipient().String()
+	bothURL := aliceURL + "&recipient=" + bob.Recipient().String()
+	bobURL := "age://?recipient=" + bob.Recipient().String()
+
+	// Encrypt a secret for alice alone.
+	useIdentity(alice)
+	encryptedKey, err := GenerateNewDataKey(aliceURL)
+	assert.NoError(t, err)
+	m, err := NewAgeSecretsManager(aliceURL, encryptedKey)
+	assert.NoError(t, err)
+	enc, err := m.Encrypter()
+	assert.NoError(t, err)
+	ciphertext, err := enc.EncryptValue("hunter2")
+	assert.NoError(t, err)
+
+	changed, err := RecipientsChanged(aliceURL, encryptedKey)
+	assert.NoError(t, err)
+	assert.False(t, changed)
+
+	// Add bob. Until the data key is rewrapped he can't decrypt; afterwards both can, without re-encrypting the
+	// secret itself.
+	changed, err = RecipientsChanged(bothURL, encryptedKey)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	useIdentity(bob)
+	_, err = decrypt(bothURL, encryptedKey, ciphertext)
+	assert.Error(t, err)
+
+	useIdentity(alice)
+	encryptedKey, err = RewrapDataKey(bothURL, encryptedKey)
+	assert.NoError(t, err)
+	changed, err = RecipientsChanged(bothURL, encryptedKey)
+	assert.NoError(t, err)
+	assert.False(t, changed)
+	for _, i := range []Identity{alice, bob} {
+		useIdentity(i)
+		plaintext, err := decrypt(bothURL, encryptedKey, ciphertext)
+		assert.NoError(t, err)
+		assert.Equal(t, "hunter2", plaintext)
+	}
+
+	// Remove alice. Bob can rewrap the key, after which only he can decrypt.
+	changed, err = RecipientsChanged(bobURL, encryptedKey)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	useIdentity(bob)
+	encryptedKey, err = RewrapDataKey(bobURL, encryptedKey)
+	assert.NoError(t, err)
+
+	plaintext, err := decrypt(bobURL, encryptedKey, ciphertext)
+	assert.NoError(t, err)
+	assert.Equal(t, "hunter2", plaintext)
+	useIdentity(alice)
+	_, err = decrypt(bobURL, encryptedKey, ciphertext)
+	assert.Error(t, err)
+	_, err = RewrapDataKey(bothURL, encryptedKey)
+	assert.Error(t, err)
+}
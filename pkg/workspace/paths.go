@@ -39,6 +39,8 @@ const (
 	GitDir = ".git"
 	// HistoryDir is the name of the directory that holds historical information for projects.
 	HistoryDir = "history"
+	// LockDir is the name of the directory that holds locks on stacks for self-managed backends.
+	LockDir = "locks"
 	// PluginDir is the name of the directory containing plugins.
 	PluginDir = "plugins"
 	// PolicyDir is the name of the directory that holds policy packs.
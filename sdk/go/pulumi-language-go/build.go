@@ -0,0 +1,271 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/util/contract"
+	"github.com/pulumi/pulumi/pkg/util/logging"
+)
+
+// buildProgram compiles the Go program in the given directory, returning the path to the resulting binary. Binaries are
+// cached, keyed by a hash of everything that affects the build (see buildKey), so the program is only rebuilt when one
+// of those has changed.
+func buildProgram(dir, name string) (string, error) {
+	goFileSearchPattern := filepath.Join(dir, "*.go")
+	if matches, err := filepath.Glob(goFileSearchPattern); err != nil || len(matches) == 0 {
+		return "", errors.Errorf("Failed to find go files for 'go build' matching %s", goFileSearchPattern)
+	}
+
+	gobin, err := exec.LookPath("go")
+	if err != nil {
+		return "", errors.Wrap(err, "unable to find 'go' to build the program")
+	}
+
+	hash, err := buildKey(gobin, dir, os.Environ())
+	if err != nil {
+		return "", err
+	}
+	cacheDir, err := programCacheDir(dir)
+	if err != nil {
+		return "", err
+	}
+
+	binary := filepath.Join(cacheDir, name+"-"+hash[:16])
+	if runtime.GOOS == "windows" {
+		binary += ".exe"
+	}
+	if _, err = os.Stat(binary); err == nil {
+		logging.V(5).Infof("reusing cached build of program %s: %s", name, binary)
+		return binary, nil
+	}
+
+	// Build into a temporary file and then rename it, so that a concurrent run never observes a partial binary.
+	if err = os.MkdirAll(cacheDir, 0700); err != nil {
+		return "", errors.Wrap(err, "creating program cache directory")
+	}
+	tmp, err := ioutil.TempFile(cacheDir, ".build-")
+	if err != nil {
+		return "", errors.Wrap(err, "creating program binary")
+	}
+	contract.IgnoreClose(tmp)
+	defer func() {
+		// After a successful build, the temporary file will have been renamed, so failing to remove it is expected.
+		contract.IgnoreError(os.Remove(tmp.Name()))
+	}()
+
+	logging.V(5).Infof("building program %s: %s", name, binary)
+	cmd := exec.Command(gobin, "build", "-o", tmp.Name(), ".")
+	cmd.Dir = dir
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	if err = cmd.Run(); err != nil {
+		return "", errors.Wrap(err, "unable to build program")
+	}
+	if err = os.Rename(tmp.Name(), binary); err != nil {
+		return "", errors.Wrap(err, "caching program binary")
+	}
+
+	// Remove any binaries built from earlier versions of the program's sources.
+	stale, err := filepath.Glob(filepath.Join(cacheDir, name+"-*"))
+	if err == nil {
+		for _, path := range stale {
+			if path != binary {
+				contract.IgnoreError(os.Remove(path))
+			}
+		}
+	}
+
+	return binary, nil
+}
+
+// runGo runs the go tool with the given arguments in the given directory and environment, returning its output. The
+// tool's diagnostics are only reported, as part of the returned error, if it fails.
+func runGo(gobin, dir string, env []string, args ...string) ([]byte, error) {
+	var stderr bytes.Buffer
+	cmd := exec.Command(gobin, args...)
+	cmd.Dir = dir
+	cmd.Env = env
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, errors.Wrapf(err, "running 'go %s': %s", strings.Join(args, " "), msg)
+		}
+		return nil, errors.Wrapf(err, "running 'go %s'", strings.Join(args, " "))
+	}
+	return out, nil
+}
+
+// buildKey returns the key under which builds of the program in the given directory are cached. It is a hash of the
+// Go toolchain's version and target platform, the program's sources (see hashSources), and the sources of any modules
+// that the program's go.mod replaces with local directories.
+func buildKey(gobin, dir string, env []string) (string, error) {
+	// `go version` reports the toolchain's version on every release of Go, unlike `go env GOVERSION`.
+	version, err := runGo(gobin, dir, env, "version")
+	if err != nil {
+		return "", errors.Wrap(err, "querying the go version")
+	}
+	goEnv, err := runGo(gobin, dir, env, "env", "-json", "GOOS", "GOARCH", "GOMOD")
+	if err != nil {
+		return "", errors.Wrap(err, "querying the go environment")
+	}
+	var vars struct {
+		GOOS   string
+		GOARCH string
+		GOMOD  string // empty in GOPATH mode
+	}
+	if err = json.Unmarshal(goEnv, &vars); err != nil {
+		return "", errors.Wrapf(err, "parsing the go environment %q", goEnv)
+	}
+
+	hash := sha256.New()
+	write := func(s string) {
+		_, err := io.WriteString(hash, s+"\x00")
+		contract.IgnoreError(err) // hashes never fail to write
+	}
+	write(strings.TrimSpace(string(version)))
+	write(vars.GOOS)
+	write(vars.GOARCH)
+
+	sources, err := hashSources(dir)
+	if err != nil {
+		return "", errors.Wrap(err, "hashing program sources")
+	}
+	write(sources)
+
+	replaced, err := replacedDirs(gobin, dir, env, vars.GOMOD)
+	if err != nil {
+		return "", err
+	}
+	for _, replacedDir := range replaced {
+		sources, err := hashSources(replacedDir)
+		if err != nil {
+			return "", errors.Wrapf(err, "hashing sources of replaced module %s", replacedDir)
+		}
+		write(replacedDir)
+		write(sources)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// replacedDirs returns the local directories that the given go.mod file's replace directives point to, in the order
+// in which they appear. If gomod is empty, e.g. because the program is built in GOPATH mode, there are none.
+func replacedDirs(gobin, dir string, env []string, gomod string) ([]string, error) {
+	if gomod == "" || gomod == os.DevNull {
+		return nil, nil
+	}
+
+	out, err := runGo(gobin, dir, env, "mod", "edit", "-json", gomod)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading go.mod")
+	}
+	var modFile struct {
+		Replace []struct {
+			New struct {
+				Path    string
+				Version string
+			}
+		}
+	}
+	if err = json.Unmarshal(out, &modFile); err != nil {
+		return nil, errors.Wrap(err, "parsing go.mod")
+	}
+
+	var dirs []string
+	for _, r := range modFile.Replace {
+		// Replacements by other module versions are already covered by go.sum; only local directories are not.
+		if r.New.Version != "" {
+			continue
+		}
+		path := r.New.Path
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(filepath.Dir(gomod), path)
+		}
+		dirs = append(dirs, path)
+	}
+	return dirs, nil
+}
+
+// programCacheDir returns the directory in which builds of the program in the given directory are cached.
+func programCacheDir(dir string) (string, error) {
+	cacheRoot, err := os.UserCacheDir()
+	if err != nil {
+		return "", errors.Wrap(err, "locating cache directory")
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256([]byte(abs))
+	return filepath.Join(cacheRoot, "pulumi", "go-programs", hex.EncodeToString(sum[:8])), nil
+}
+
+// hashSources returns a hash of the Go sources, go.mod, and go.sum files in the given directory and its
+// subdirectories. Hidden directories are skipped.
+func hashSources(dir string) (string, error) {
+	hash := sha256.New()
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if path != dir && strings.HasPrefix(info.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		name := info.Name()
+		if !info.Mode().IsRegular() || (filepath.Ext(name) != ".go" && name != "go.mod" && name != "go.sum") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if _, err = io.WriteString(hash, filepath.ToSlash(rel)+"\x00"); err != nil {
+			return err
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer contract.IgnoreClose(f)
+		_, err = io.Copy(hash, f)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
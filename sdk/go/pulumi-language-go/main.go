@@ -15,16 +15,21 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"syscall"
 
+	"github.com/blang/semver"
 	pbempty "github.com/golang/protobuf/ptypes/empty"
+	multierror "github.com/hashicorp/go-multierror"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 
@@ -41,6 +46,9 @@ func main() {
 	var tracing string
 	flag.StringVar(&tracing, "tracing", "", "Emit tracing to a Zipkin-compatible tracing endpoint")
 
+	var binary string
+	flag.StringVar(&binary, "binary", "", "A prebuilt program binary to run instead of building the program")
+
 	flag.Parse()
 	args := flag.Args()
 	logging.InitLogging(false, 0, false)
@@ -55,7 +63,7 @@ func main() {
 	// Fire up a gRPC server, letting the kernel choose a free port.
 	port, done, err := rpcutil.Serve(0, nil, []func(*grpc.Server) error{
 		func(srv *grpc.Server) error {
-			host := newLanguageHost(engineAddress, tracing)
+			host := newLanguageHost(engineAddress, tracing, binary)
 			pulumirpc.RegisterLanguageRuntimeServer(srv, host)
 			return nil
 		},
@@ -77,19 +85,101 @@ func main() {
 type goLanguageHost struct {
 	engineAddress string
 	tracing       string
+	binary        string
 }
 
-func newLanguageHost(engineAddress, tracing string) pulumirpc.LanguageRuntimeServer {
+func newLanguageHost(engineAddress, tracing, binary string) pulumirpc.LanguageRuntimeServer {
 	return &goLanguageHost{
 		engineAddress: engineAddress,
 		tracing:       tracing,
+		binary:        binary,
+	}
+}
+
+// modInfo is the subset of the information reported by `go list -m -json` that is needed to discover plugins.
+type modInfo struct {
+	Path    string
+	Version string
+	Main    bool
+}
+
+// nonPluginModules are modules published by Pulumi that match the naming convention of provider SDKs, but are not
+// provider SDKs.
+var nonPluginModules = map[string]bool{
+	"terraform":        true,
+	"terraform-bridge": true,
+}
+
+// getPlugin returns the plugin that the given module requires, if any. Provider SDKs are published as modules named
+// github.com/pulumi/pulumi-<name>, versioned in lockstep with the provider plugin.
+func (m *modInfo) getPlugin() (*pulumirpc.PluginDependency, error) {
+	const prefix = "github.com/pulumi/pulumi-"
+	if m.Main || !strings.HasPrefix(m.Path, prefix) {
+		return nil, nil
 	}
+
+	name := strings.SplitN(strings.TrimPrefix(m.Path, prefix), "/", 2)[0]
+	if nonPluginModules[name] {
+		return nil, nil
+	}
+
+	version, err := semver.ParseTolerant(strings.TrimSuffix(m.Version, "+incompatible"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not understand version %s of module %s", m.Version, m.Path)
+	}
+
+	return &pulumirpc.PluginDependency{
+		Name:    name,
+		Kind:    "resource",
+		Version: "v" + version.String(),
+	}, nil
+}
+
+// getPlugins returns the plugins required by the modules in the given program directory's dependency graph.
+func getPlugins(dir string) ([]*pulumirpc.PluginDependency, error) {
+	gobin, err := exec.LookPath("go")
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to find 'go' to list modules")
+	}
+
+	out, err := runGo(gobin, dir, os.Environ(), "list", "-m", "-json", "all")
+	if err != nil {
+		return nil, errors.Wrap(err, "listing modules")
+	}
+
+	var plugins []*pulumirpc.PluginDependency
+	var allErrors *multierror.Error
+	for dec := json.NewDecoder(bytes.NewReader(out)); ; {
+		var m modInfo
+		if err := dec.Decode(&m); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, errors.Wrap(err, "parsing module list")
+		}
+
+		plugin, err := m.getPlugin()
+		if err != nil {
+			allErrors = multierror.Append(allErrors, err)
+		} else if plugin != nil {
+			plugins = append(plugins, plugin)
+		}
+	}
+
+	return plugins, allErrors.ErrorOrNil()
 }
 
 // GetRequiredPlugins computes the complete set of anticipated plugins required by a program.
 func (host *goLanguageHost) GetRequiredPlugins(ctx context.Context,
 	req *pulumirpc.GetRequiredPluginsRequest) (*pulumirpc.GetRequiredPluginsResponse, error) {
-	return &pulumirpc.GetRequiredPluginsResponse{}, nil
+	// The program's plugins are found by walking its module's dependency graph for provider SDKs. Programs that are not
+	// modules (e.g. those built in GOPATH mode) cannot be inspected this way, and report no plugins.
+	plugins, err := getPlugins(req.GetPwd())
+	if err != nil {
+		logging.V(3).Infof("one or more errors while discovering plugins: %s", err)
+	}
+	return &pulumirpc.GetRequiredPluginsResponse{
+		Plugins: plugins,
+	}, nil
 }
 
 const unableToFindProgramTemplate = "unable to find program: %s"
@@ -128,6 +218,27 @@ func findProgram(program string) (string, error) {
 	return "", errors.Errorf(unableToFindProgramTemplate, program)
 }
 
+// findProgram returns the path of the prebuilt binary to run for the given project: either the binary named by the
+// `binary` runtime option, or a binary named after the project. If there is no such binary, it returns "".
+func (host *goLanguageHost) findProgram(project string) (string, error) {
+	if host.binary != "" {
+		program, err := filepath.Abs(host.binary)
+		if err != nil {
+			return "", err
+		}
+		if info, err := os.Stat(program); err != nil || info.IsDir() {
+			return "", errors.Errorf("unable to find prebuilt binary %s", host.binary)
+		}
+		return program, nil
+	}
+
+	program, err := findProgram(project)
+	if err != nil && err.Error() == fmt.Sprintf(unableToFindProgramTemplate, project) {
+		return "", nil
+	}
+	return program, err
+}
+
 // RPC endpoint for LanguageRuntimeServer::Run
 func (host *goLanguageHost) Run(ctx context.Context, req *pulumirpc.RunRequest) (*pulumirpc.RunResponse, error) {
 	// Create the environment we'll use to run the process.  This is how we pass the RunInfo to the actual
@@ -137,51 +248,30 @@ func (host *goLanguageHost) Run(ctx context.Context, req *pulumirpc.RunRequest)
 		return nil, errors.Wrap(err, "failed to prepare environment")
 	}
 
-	// by default we try to run a named executable on the path, but we will fallback to 'go run' style execution
-	goRunInvoke := false
-
 	// The program to execute is simply the name of the project.  This ensures good Go toolability, whereby
 	// you can simply run `go install .` to build a Pulumi program prior to running it, among other benefits.
-	// For ease of use, if we don't find a pre-built program, we attempt to invoke via 'go run' on behalf of the user.
-	program, err := findProgram(req.GetProject())
+	// A prebuilt binary may also be named using the `binary` runtime option.  If neither is found, we build the
+	// program on behalf of the user, reusing an earlier build if the program's sources have not changed.
+	program, err := host.findProgram(req.GetProject())
 	if err != nil {
-		const message = "problem executing program (could not run language executor)"
-		if err.Error() == fmt.Sprintf(unableToFindProgramTemplate, req.GetProject()) {
-			logging.V(5).Infof("Unable to find program %s in $PATH, attempting invocation via 'go run'", program)
-			program, err = findProgram("go")
-			if err != nil {
-				return nil, errors.Wrap(err, message)
-			}
-			goRunInvoke = true
-		} else {
-			return nil, errors.Wrap(err, message)
-		}
-	}
-
-	logging.V(5).Infof("language host launching process: %s", program)
-
-	// Now simply spawn a process to execute the requested program, wiring up stdout/stderr directly.
-	var errResult string
-	var cmd *exec.Cmd
-
-	if goRunInvoke {
+		return nil, errors.Wrap(err, "problem executing program (could not run language executor)")
+	} else if program == "" {
 		cwd, err := os.Getwd()
 		if err != nil {
 			return nil, errors.Wrap(err, "unable to get current working directory")
 		}
 
-		goFileSearchPattern := filepath.Join(cwd, "*.go")
-		if matches, err := filepath.Glob(goFileSearchPattern); err != nil || len(matches) == 0 {
-			return nil, errors.Errorf("Failed to find go files for 'go run' matching %s", goFileSearchPattern)
+		logging.V(5).Infof("Unable to find program %s in $PATH, building it from source", req.GetProject())
+		if program, err = buildProgram(cwd, req.GetProject()); err != nil {
+			return &pulumirpc.RunResponse{Error: err.Error()}, nil
 		}
-
-		args := []string{"run", cwd}
-		// go run $cwd
-		cmd = exec.Command(program, args...)
-	} else {
-		cmd = exec.Command(program)
 	}
 
+	logging.V(5).Infof("language host launching process: %s", program)
+
+	// Now simply spawn a process to execute the requested program, wiring up stdout/stderr directly.
+	var errResult string
+	cmd := exec.Command(program)
 	cmd.Env = env
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
@@ -0,0 +1,164 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pulumirpc "github.com/pulumi/pulumi/sdk/proto/go"
+)
+
+func TestGetPlugin(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		mod      modInfo
+		expected *pulumirpc.PluginDependency
+		err      bool
+	}{
+		{
+			mod:      modInfo{Path: "github.com/pulumi/pulumi-aws", Version: "v1.20.0"},
+			expected: &pulumirpc.PluginDependency{Name: "aws", Kind: "resource", Version: "v1.20.0"},
+		},
+		{
+			mod:      modInfo{Path: "github.com/pulumi/pulumi-kubernetes/sdk/v2", Version: "v2.0.0-beta.1"},
+			expected: &pulumirpc.PluginDependency{Name: "kubernetes", Kind: "resource", Version: "v2.0.0-beta.1"},
+		},
+		{
+			mod:      modInfo{Path: "github.com/pulumi/pulumi-gcp", Version: "v3.0.0+incompatible"},
+			expected: &pulumirpc.PluginDependency{Name: "gcp", Kind: "resource", Version: "v3.0.0"},
+		},
+		{mod: modInfo{Path: "github.com/pulumi/pulumi", Version: "v1.6.1"}},
+		{mod: modInfo{Path: "github.com/pulumi/pulumi-terraform-bridge", Version: "v1.4.3"}},
+		{mod: modInfo{Path: "github.com/pulumi/pulumi-myprogram", Main: true}},
+		{mod: modInfo{Path: "github.com/pkg/errors", Version: "v0.8.1"}},
+		{mod: modInfo{Path: "github.com/pulumi/pulumi-azure", Version: "latest"}, err: true},
+	}
+	for _, c := range cases {
+		plugin, err := c.mod.getPlugin()
+		if c.err {
+			assert.Error(t, err, c.mod.Path)
+			continue
+		}
+		assert.NoError(t, err, c.mod.Path)
+		assert.Equal(t, c.expected, plugin, c.mod.Path)
+	}
+}
+
+func TestHashSources(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "pulumi-language-go")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	write := func(name, contents string) {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		assert.NoError(t, ioutil.WriteFile(path, []byte(contents), 0600))
+	}
+	hash := func() string {
+		h, err := hashSources(dir)
+		assert.NoError(t, err)
+		return h
+	}
+
+	write("main.go", "package main\n")
+	write("go.mod", "module example.com/program\n")
+	original := hash()
+
+	// Files that do not affect the build do not affect the hash.
+	write("Pulumi.yaml", "name: program\n")
+	write(".git/main.go", "package main\n")
+	assert.Equal(t, original, hash())
+
+	// Sources in any package, go.mod, and go.sum do.
+	write("pkg/util.go", "package pkg\n")
+	withPackage := hash()
+	assert.NotEqual(t, original, withPackage)
+
+	write("go.sum", "example.com/dep v1.0.0 h1:abc=\n")
+	assert.NotEqual(t, withPackage, hash())
+}
+
+func TestBuildKey(t *testing.T) {
+	t.Parallel()
+
+	gobin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go is not installed")
+	}
+
+	root, err := ioutil.TempDir("", "pulumi-language-go")
+	assert.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	write := func(name, contents string) {
+		path := filepath.Join(root, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		assert.NoError(t, ioutil.WriteFile(path, []byte(contents), 0600))
+	}
+	program := filepath.Join(root, "program")
+	key := func(env ...string) string {
+		k, err := buildKey(gobin, program, append(os.Environ(), env...))
+		assert.NoError(t, err)
+		return k
+	}
+
+	write("program/main.go", "package main\n")
+	write("program/go.mod", "module example.com/program\n\nrequire example.com/dep v1.0.0\n\n"+
+		"replace example.com/dep => ../dep\n")
+	write("dep/go.mod", "module example.com/dep\n")
+	write("dep/dep.go", "package dep\n")
+	original := key()
+	assert.Equal(t, original, key())
+
+	// Each of the program's sources, the sources of modules replaced by local directories, and the target platform
+	// cause a cache miss.
+	keys := map[string]string{"original": original}
+	write("program/main.go", "package main\n\nfunc main() {}\n")
+	keys["program source"] = key()
+	write("dep/dep.go", "package dep\n\nconst X = 1\n")
+	keys["replaced source"] = key()
+	keys["GOOS"] = key("GOOS=windows")
+	keys["GOARCH"] = key("GOARCH=arm64")
+
+	seen := make(map[string]string)
+	for change, k := range keys {
+		if other, has := seen[k]; has {
+			t.Errorf("%s and %s have the same build key", change, other)
+		}
+		seen[k] = change
+	}
+
+	// In GOPATH mode there is no go.mod, so replace directives are ignored, but sources still affect the key.
+	gopath := []string{"GO111MODULE=off", "GOPATH=" + filepath.Join(root, "gopath")}
+	gopathKey := key(gopath...)
+	write("program/main.go", "package main\n\nfunc main() { println() }\n")
+	assert.NotEqual(t, gopathKey, key(gopath...))
+
+	// Errors from the go tool are reported.
+	write("program/go.mod", "not a go.mod\n")
+	_, err = buildKey(gobin, program, os.Environ())
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "go.mod")
+	}
+}
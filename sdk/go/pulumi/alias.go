@@ -0,0 +1,127 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumi
+
+import (
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/tokens"
+)
+
+// Alias is a partial description of a prior identity of a resource. Any fields that are left empty default to the
+// corresponding values of the resource being registered, so an alias need only describe what has changed.
+type Alias struct {
+	// URN is the complete URN of the prior resource. If set, all other fields are ignored.
+	URN URN
+	// Name is the previous name of the resource.
+	Name string
+	// Type is the previous type of the resource.
+	Type string
+	// Parent is the previous parent of the resource.
+	Parent Resource
+	// ParentURN is the URN of the previous parent of the resource. It is ignored if Parent is set.
+	ParentURN URN
+	// NoParent indicates that the resource previously had no parent other than the stack itself.
+	NoParent bool
+	// Stack is the name of the previous stack of the resource.
+	Stack string
+	// Project is the name of the previous project of the resource.
+	Project string
+}
+
+// newURN computes the URN of a resource, as the engine would.
+func newURN(stack, project string, parent URN, typ, name string) URN {
+	parentType := tokens.Type("")
+	if parentURN := resource.URN(parent); parentURN != "" && parentURN.Type() != resource.RootStackType {
+		parentType = parentURN.QualifiedType()
+	}
+
+	return URN(resource.NewURN(tokens.QName(stack), tokens.PackageName(project), parentType,
+		tokens.Type(typ), tokens.QName(name)))
+}
+
+// collapseAlias computes the URN that the given alias describes for a resource with the given type, name, and parent.
+func (ctx *Context) collapseAlias(alias Alias, t, name string, parent URN) (URN, error) {
+	if alias.URN != "" {
+		return alias.URN, nil
+	}
+
+	if alias.Name != "" {
+		name = alias.Name
+	}
+	if alias.Type != "" {
+		t = alias.Type
+	}
+	stack, project := ctx.Stack(), ctx.Project()
+	if alias.Stack != "" {
+		stack = alias.Stack
+	}
+	if alias.Project != "" {
+		project = alias.Project
+	}
+
+	switch {
+	case alias.NoParent:
+		parent = ""
+	case alias.Parent != nil:
+		urn, _, err := alias.Parent.URN().await(context.TODO())
+		if err != nil {
+			return "", err
+		}
+		parent = urn
+	case alias.ParentURN != "":
+		parent = alias.ParentURN
+	}
+
+	return newURN(stack, project, parent, t, name), nil
+}
+
+// getAliases returns the URNs of the aliases of a resource with the given type, name, and parent. In addition to the
+// aliases given in the resource's options, a resource inherits an alias for each of its parent's aliases, so that
+// renaming or re-parenting a component does not replace its children.
+func (ctx *Context) getAliases(t, name string, parentURN URN, opts ...ResourceOpt) ([]URN, error) {
+	var parent Resource
+	var aliases []URN
+	for _, opt := range opts {
+		if parent == nil && opt.Parent != nil {
+			parent = opt.Parent
+		}
+		for _, alias := range opt.Aliases {
+			urn, err := ctx.collapseAlias(alias, t, name, parentURN)
+			if err != nil {
+				return nil, err
+			}
+			aliases = append(aliases, urn)
+		}
+	}
+
+	// Note that the parent's aliases are recorded before its URN resolves, and its URN has already been awaited.
+	if rs, ok := parent.(resourceStater); ok {
+		parentName := string(resource.URN(parentURN).Name())
+		for _, parentAlias := range rs.resourceState().aliases {
+			// If the child's name is prefixed by its parent's name, the prefix is replaced with the alias's name.
+			aliasName := name
+			if strings.HasPrefix(name, parentName) {
+				aliasName = string(resource.URN(parentAlias).Name()) + strings.TrimPrefix(name, parentName)
+			}
+			aliases = append(aliases, newURN(ctx.Stack(), ctx.Project(), parentAlias, t, aliasName))
+		}
+	}
+
+	return aliases, nil
+}
@@ -0,0 +1,197 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumi
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/plugin"
+	pulumirpc "github.com/pulumi/pulumi/sdk/proto/go"
+)
+
+type componentMonitor struct {
+	m         sync.Mutex
+	providers map[string]string
+}
+
+func (c *componentMonitor) Call(token string, args resource.PropertyMap,
+	provider string) (resource.PropertyMap, error) {
+
+	return nil, nil
+}
+
+func (c *componentMonitor) NewResource(typeToken, name string, inputs resource.PropertyMap,
+	provider, id string) (string, resource.PropertyMap, error) {
+
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.providers[name] = provider
+	return name + "_id", inputs, nil
+}
+
+// outputsRecorder records the outputs registered for each resource.
+type outputsRecorder struct {
+	pulumirpc.ResourceMonitorClient
+
+	m       sync.Mutex
+	outputs map[string]resource.PropertyMap
+}
+
+func (r *outputsRecorder) RegisterResourceOutputs(ctx context.Context, req *pulumirpc.RegisterResourceOutputsRequest,
+	opts ...grpc.CallOption) (*empty.Empty, error) {
+
+	outs, err := plugin.UnmarshalProperties(req.GetOutputs(), plugin.MarshalOptions{KeepSecrets: true})
+	if err != nil {
+		return nil, err
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.outputs[req.GetUrn()] = outs
+	return &empty.Empty{}, nil
+}
+
+type testComponent struct {
+	ResourceState
+
+	Endpoint StringOutput `pulumi:"endpoint"`
+	Unset    StringOutput `pulumi:"unset"`
+	Tags     StringMap    `pulumi:"tags"`
+}
+
+func TestRegisterComponentResource(t *testing.T) {
+	mocks := &componentMonitor{providers: make(map[string]string)}
+	ctx, err := NewContext(context.Background(), RunInfo{Project: "project", Stack: "stack", Mocks: mocks})
+	assert.NoError(t, err)
+	recorder := &outputsRecorder{ResourceMonitorClient: ctx.monitor, outputs: make(map[string]resource.PropertyMap)}
+	ctx.monitor = recorder
+
+	var explicitURN URN
+	err = RunWithContext(ctx, func(ctx *Context) error {
+		prov := &ResourceState{}
+		if err := ctx.RegisterResource("pulumi:providers:test", "prov", true, nil, prov); err != nil {
+			return err
+		}
+
+		comp := &testComponent{}
+		err := ctx.RegisterComponentResource("test:index:Component", "comp", comp, ResourceOpt{
+			Providers: map[string]ProviderResource{"test": prov},
+		})
+		if err != nil {
+			return err
+		}
+
+		child := &ResourceState{}
+		err = ctx.RegisterResource("test:index:Child", "child", true, map[string]interface{}{
+			"name": "child",
+		}, child, ResourceOpt{Parent: comp})
+		if err != nil {
+			return err
+		}
+		comp.Endpoint = StringOutput(child.State["name"])
+
+		// A component that registers its own outputs should not have them registered automatically.
+		explicit := &testComponent{}
+		if err = ctx.RegisterComponentResource("test:index:Component", "explicit", explicit); err != nil {
+			return err
+		}
+		if explicitURN, _, err = explicit.URN().await(context.Background()); err != nil {
+			return err
+		}
+		return ctx.RegisterResourceOutputs(explicitURN, map[string]interface{}{"custom": "value"})
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "urn:pulumi:stack::project::pulumi:providers:test::prov::prov_id", mocks.providers["child"])
+
+	compURN := "urn:pulumi:stack::project::test:index:Component::comp"
+	assert.Equal(t, resource.PropertyMap{"endpoint": resource.NewStringProperty("child")}, recorder.outputs[compURN])
+	assert.Equal(t, resource.PropertyMap{"custom": resource.NewStringProperty("value")},
+		recorder.outputs[string(explicitURN)])
+}
+
+func TestRegisterComponentResourceWithoutState(t *testing.T) {
+	type bareComponent struct{ Resource }
+
+	err := RunErr(func(ctx *Context) error {
+		return ctx.RegisterComponentResource("test:index:Component", "comp", bareComponent{})
+	}, WithMocks("project", "stack", &componentMonitor{providers: make(map[string]string)}))
+	assert.Error(t, err)
+}
+
+// TestRegisterComponentResourceConcurrently registers components and exports from goroutines that may still be running
+// when the program's body returns; run it with -race to check that their outputs are registered safely.
+func TestRegisterComponentResourceConcurrently(t *testing.T) {
+	const n = 10
+
+	mocks := &componentMonitor{providers: make(map[string]string)}
+	ctx, err := NewContext(context.Background(), RunInfo{Project: "project", Stack: "stack", Mocks: mocks})
+	assert.NoError(t, err)
+	recorder := &outputsRecorder{ResourceMonitorClient: ctx.monitor, outputs: make(map[string]resource.PropertyMap)}
+	ctx.monitor = recorder
+
+	err = RunWithContext(ctx, func(ctx *Context) error {
+		base := &ResourceState{}
+		if err := ctx.RegisterResource("test:index:Base", "base", true, nil, base); err != nil {
+			return err
+		}
+
+		var wg sync.WaitGroup
+		for i := 0; i < n; i++ {
+			name := fmt.Sprintf("comp-%d", i)
+
+			// Register a component from an applier whose result is an input to a pending registration.
+			id := base.ID().ApplyT(func(id ID) (ID, error) {
+				comp := &testComponent{}
+				if err := ctx.RegisterComponentResource("test:index:Component", name, comp); err != nil {
+					return "", err
+				}
+				comp.Endpoint = String(name).ToStringOutput()
+				return id, nil
+			})
+			err := ctx.RegisterResource("test:index:Child", name+"-child", true, map[string]interface{}{
+				"base": id,
+			}, &ResourceState{})
+			if err != nil {
+				return err
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				ctx.Export(name, String(name))
+			}()
+		}
+		wg.Wait()
+		return nil
+	})
+	assert.NoError(t, err)
+
+	stackOutputs := recorder.outputs["urn:pulumi:stack::project::pulumi:pulumi:Stack::project-stack"]
+	for i := 0; i < n; i++ {
+		name := fmt.Sprintf("comp-%d", i)
+		assert.Equal(t, resource.PropertyMap{"endpoint": resource.NewStringProperty(name)},
+			recorder.outputs["urn:pulumi:stack::project::test:index:Component::"+name])
+		assert.Equal(t, resource.NewStringProperty(name), stackOutputs[resource.PropertyKey(name)])
+	}
+}
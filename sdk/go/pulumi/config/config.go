@@ -267,3 +267,259 @@ func (c *Config) TryUint32(key string) (uint32, error) {
 func (c *Config) TryUint64(key string) (uint64, error) {
 	return TryUint64(c.ctx, c.fullKey(key))
 }
+
+// GetSecret loads an optional configuration value by its key as a secret output, or returns a secret "" if it doesn't
+// exist.
+func (c *Config) GetSecret(key string) pulumi.StringOutput {
+	return GetSecret(c.ctx, c.fullKey(key))
+}
+
+// GetSecretBool loads an optional bool configuration value by its key as a secret output, or returns a secret false if
+// it doesn't exist.
+func (c *Config) GetSecretBool(key string) pulumi.BoolOutput {
+	return GetSecretBool(c.ctx, c.fullKey(key))
+}
+
+// GetSecretFloat32 loads an optional float32 configuration value by its key as a secret output, or returns a secret 0.0
+// if it doesn't exist.
+func (c *Config) GetSecretFloat32(key string) pulumi.Float32Output {
+	return GetSecretFloat32(c.ctx, c.fullKey(key))
+}
+
+// GetSecretFloat64 loads an optional float64 configuration value by its key as a secret output, or returns a secret 0.0
+// if it doesn't exist.
+func (c *Config) GetSecretFloat64(key string) pulumi.Float64Output {
+	return GetSecretFloat64(c.ctx, c.fullKey(key))
+}
+
+// GetSecretInt loads an optional int configuration value by its key as a secret output, or returns a secret 0 if it
+// doesn't exist.
+func (c *Config) GetSecretInt(key string) pulumi.IntOutput {
+	return GetSecretInt(c.ctx, c.fullKey(key))
+}
+
+// GetSecretInt8 loads an optional int8 configuration value by its key as a secret output, or returns a secret 0 if it
+// doesn't exist.
+func (c *Config) GetSecretInt8(key string) pulumi.Int8Output {
+	return GetSecretInt8(c.ctx, c.fullKey(key))
+}
+
+// GetSecretInt16 loads an optional int16 configuration value by its key as a secret output, or returns a secret 0 if it
+// doesn't exist.
+func (c *Config) GetSecretInt16(key string) pulumi.Int16Output {
+	return GetSecretInt16(c.ctx, c.fullKey(key))
+}
+
+// GetSecretInt32 loads an optional int32 configuration value by its key as a secret output, or returns a secret 0 if it
+// doesn't exist.
+func (c *Config) GetSecretInt32(key string) pulumi.Int32Output {
+	return GetSecretInt32(c.ctx, c.fullKey(key))
+}
+
+// GetSecretInt64 loads an optional int64 configuration value by its key as a secret output, or returns a secret 0 if it
+// doesn't exist.
+func (c *Config) GetSecretInt64(key string) pulumi.Int64Output {
+	return GetSecretInt64(c.ctx, c.fullKey(key))
+}
+
+// GetSecretObject loads an optional configuration value into the specified output by its key, returning the result as a
+// secret output, or returns an error if unable to do so.
+func (c *Config) GetSecretObject(key string, output interface{}) (pulumi.Output, error) {
+	return GetSecretObject(c.ctx, c.fullKey(key), output)
+}
+
+// GetSecretUint loads an optional uint configuration value by its key as a secret output, or returns a secret 0 if it
+// doesn't exist.
+func (c *Config) GetSecretUint(key string) pulumi.UintOutput {
+	return GetSecretUint(c.ctx, c.fullKey(key))
+}
+
+// GetSecretUint8 loads an optional uint8 configuration value by its key as a secret output, or returns a secret 0 if it
+// doesn't exist.
+func (c *Config) GetSecretUint8(key string) pulumi.Uint8Output {
+	return GetSecretUint8(c.ctx, c.fullKey(key))
+}
+
+// GetSecretUint16 loads an optional uint16 configuration value by its key as a secret output, or returns a secret 0 if
+// it doesn't exist.
+func (c *Config) GetSecretUint16(key string) pulumi.Uint16Output {
+	return GetSecretUint16(c.ctx, c.fullKey(key))
+}
+
+// GetSecretUint32 loads an optional uint32 configuration value by its key as a secret output, or returns a secret 0 if
+// it doesn't exist.
+func (c *Config) GetSecretUint32(key string) pulumi.Uint32Output {
+	return GetSecretUint32(c.ctx, c.fullKey(key))
+}
+
+// GetSecretUint64 loads an optional uint64 configuration value by its key as a secret output, or returns a secret 0 if
+// it doesn't exist.
+func (c *Config) GetSecretUint64(key string) pulumi.Uint64Output {
+	return GetSecretUint64(c.ctx, c.fullKey(key))
+}
+
+// RequireSecret loads a configuration value by its key as a secret output, or panics if it doesn't exist.
+func (c *Config) RequireSecret(key string) pulumi.StringOutput {
+	return RequireSecret(c.ctx, c.fullKey(key))
+}
+
+// RequireSecretBool loads a bool configuration value by its key as a secret output, or panics if it doesn't exist.
+func (c *Config) RequireSecretBool(key string) pulumi.BoolOutput {
+	return RequireSecretBool(c.ctx, c.fullKey(key))
+}
+
+// RequireSecretFloat32 loads a float32 configuration value by its key as a secret output, or panics if it doesn't
+// exist.
+func (c *Config) RequireSecretFloat32(key string) pulumi.Float32Output {
+	return RequireSecretFloat32(c.ctx, c.fullKey(key))
+}
+
+// RequireSecretFloat64 loads a float64 configuration value by its key as a secret output, or panics if it doesn't
+// exist.
+func (c *Config) RequireSecretFloat64(key string) pulumi.Float64Output {
+	return RequireSecretFloat64(c.ctx, c.fullKey(key))
+}
+
+// RequireSecretInt loads a int configuration value by its key as a secret output, or panics if it doesn't exist.
+func (c *Config) RequireSecretInt(key string) pulumi.IntOutput {
+	return RequireSecretInt(c.ctx, c.fullKey(key))
+}
+
+// RequireSecretInt8 loads a int8 configuration value by its key as a secret output, or panics if it doesn't exist.
+func (c *Config) RequireSecretInt8(key string) pulumi.Int8Output {
+	return RequireSecretInt8(c.ctx, c.fullKey(key))
+}
+
+// RequireSecretInt16 loads a int16 configuration value by its key as a secret output, or panics if it doesn't exist.
+func (c *Config) RequireSecretInt16(key string) pulumi.Int16Output {
+	return RequireSecretInt16(c.ctx, c.fullKey(key))
+}
+
+// RequireSecretInt32 loads a int32 configuration value by its key as a secret output, or panics if it doesn't exist.
+func (c *Config) RequireSecretInt32(key string) pulumi.Int32Output {
+	return RequireSecretInt32(c.ctx, c.fullKey(key))
+}
+
+// RequireSecretInt64 loads a int64 configuration value by its key as a secret output, or panics if it doesn't exist.
+func (c *Config) RequireSecretInt64(key string) pulumi.Int64Output {
+	return RequireSecretInt64(c.ctx, c.fullKey(key))
+}
+
+// RequireSecretObject loads a required configuration value into the specified output by its key, returning the result
+// as a secret output, or panics if unable to do so.
+func (c *Config) RequireSecretObject(key string, output interface{}) pulumi.Output {
+	return RequireSecretObject(c.ctx, c.fullKey(key), output)
+}
+
+// RequireSecretUint loads a uint configuration value by its key as a secret output, or panics if it doesn't exist.
+func (c *Config) RequireSecretUint(key string) pulumi.UintOutput {
+	return RequireSecretUint(c.ctx, c.fullKey(key))
+}
+
+// RequireSecretUint8 loads a uint8 configuration value by its key as a secret output, or panics if it doesn't exist.
+func (c *Config) RequireSecretUint8(key string) pulumi.Uint8Output {
+	return RequireSecretUint8(c.ctx, c.fullKey(key))
+}
+
+// RequireSecretUint16 loads a uint16 configuration value by its key as a secret output, or panics if it doesn't exist.
+func (c *Config) RequireSecretUint16(key string) pulumi.Uint16Output {
+	return RequireSecretUint16(c.ctx, c.fullKey(key))
+}
+
+// RequireSecretUint32 loads a uint32 configuration value by its key as a secret output, or panics if it doesn't exist.
+func (c *Config) RequireSecretUint32(key string) pulumi.Uint32Output {
+	return RequireSecretUint32(c.ctx, c.fullKey(key))
+}
+
+// RequireSecretUint64 loads a uint64 configuration value by its key as a secret output, or panics if it doesn't exist.
+func (c *Config) RequireSecretUint64(key string) pulumi.Uint64Output {
+	return RequireSecretUint64(c.ctx, c.fullKey(key))
+}
+
+// TrySecret loads a configuration value by its key as a secret output, or returns an error if it doesn't exist.
+func (c *Config) TrySecret(key string) (pulumi.StringOutput, error) {
+	return TrySecret(c.ctx, c.fullKey(key))
+}
+
+// TrySecretBool loads a bool configuration value by its key as a secret output, or returns an error if it doesn't
+// exist.
+func (c *Config) TrySecretBool(key string) (pulumi.BoolOutput, error) {
+	return TrySecretBool(c.ctx, c.fullKey(key))
+}
+
+// TrySecretFloat32 loads a float32 configuration value by its key as a secret output, or returns an error if it doesn't
+// exist.
+func (c *Config) TrySecretFloat32(key string) (pulumi.Float32Output, error) {
+	return TrySecretFloat32(c.ctx, c.fullKey(key))
+}
+
+// TrySecretFloat64 loads a float64 configuration value by its key as a secret output, or returns an error if it doesn't
+// exist.
+func (c *Config) TrySecretFloat64(key string) (pulumi.Float64Output, error) {
+	return TrySecretFloat64(c.ctx, c.fullKey(key))
+}
+
+// TrySecretInt loads a int configuration value by its key as a secret output, or returns an error if it doesn't exist.
+func (c *Config) TrySecretInt(key string) (pulumi.IntOutput, error) {
+	return TrySecretInt(c.ctx, c.fullKey(key))
+}
+
+// TrySecretInt8 loads a int8 configuration value by its key as a secret output, or returns an error if it doesn't
+// exist.
+func (c *Config) TrySecretInt8(key string) (pulumi.Int8Output, error) {
+	return TrySecretInt8(c.ctx, c.fullKey(key))
+}
+
+// TrySecretInt16 loads a int16 configuration value by its key as a secret output, or returns an error if it doesn't
+// exist.
+func (c *Config) TrySecretInt16(key string) (pulumi.Int16Output, error) {
+	return TrySecretInt16(c.ctx, c.fullKey(key))
+}
+
+// TrySecretInt32 loads a int32 configuration value by its key as a secret output, or returns an error if it doesn't
+// exist.
+func (c *Config) TrySecretInt32(key string) (pulumi.Int32Output, error) {
+	return TrySecretInt32(c.ctx, c.fullKey(key))
+}
+
+// TrySecretInt64 loads a int64 configuration value by its key as a secret output, or returns an error if it doesn't
+// exist.
+func (c *Config) TrySecretInt64(key string) (pulumi.Int64Output, error) {
+	return TrySecretInt64(c.ctx, c.fullKey(key))
+}
+
+// TrySecretObject loads an optional configuration value into the specified output by its key, returning the result as a
+// secret output, or returns an error if unable to do so.
+func (c *Config) TrySecretObject(key string, output interface{}) (pulumi.Output, error) {
+	return TrySecretObject(c.ctx, c.fullKey(key), output)
+}
+
+// TrySecretUint loads a uint configuration value by its key as a secret output, or returns an error if it doesn't
+// exist.
+func (c *Config) TrySecretUint(key string) (pulumi.UintOutput, error) {
+	return TrySecretUint(c.ctx, c.fullKey(key))
+}
+
+// TrySecretUint8 loads a uint8 configuration value by its key as a secret output, or returns an error if it doesn't
+// exist.
+func (c *Config) TrySecretUint8(key string) (pulumi.Uint8Output, error) {
+	return TrySecretUint8(c.ctx, c.fullKey(key))
+}
+
+// TrySecretUint16 loads a uint16 configuration value by its key as a secret output, or returns an error if it doesn't
+// exist.
+func (c *Config) TrySecretUint16(key string) (pulumi.Uint16Output, error) {
+	return TrySecretUint16(c.ctx, c.fullKey(key))
+}
+
+// TrySecretUint32 loads a uint32 configuration value by its key as a secret output, or returns an error if it doesn't
+// exist.
+func (c *Config) TrySecretUint32(key string) (pulumi.Uint32Output, error) {
+	return TrySecretUint32(c.ctx, c.fullKey(key))
+}
+
+// TrySecretUint64 loads a uint64 configuration value by its key as a secret output, or returns an error if it doesn't
+// exist.
+func (c *Config) TrySecretUint64(key string) (pulumi.Uint64Output, error) {
+	return TrySecretUint64(c.ctx, c.fullKey(key))
+}
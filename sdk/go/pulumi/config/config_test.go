@@ -149,3 +149,62 @@ func TestConfig(t *testing.T) {
 	_, err = cfg.Try("missing")
 	assert.NotNil(t, err)
 }
+
+func awaitSecret(t *testing.T, out pulumi.TypedOutput) interface{} {
+	assert.True(t, out.IsSecret())
+
+	result := make(chan interface{}, 1)
+	out.ApplyT(func(v interface{}) interface{} {
+		result <- v
+		return v
+	})
+	return <-result
+}
+
+// TestSecretConfig tests the secret config wrappers.
+func TestSecretConfig(t *testing.T) {
+	ctx, err := pulumi.NewContext(context.Background(), pulumi.RunInfo{
+		Config: map[string]string{
+			"testpkg:sss":    "a string value",
+			"testpkg:bbb":    "true",
+			"testpkg:intint": "42",
+			"testpkg:obj":    `{"foo": {"a": "1"}, "bar": "abc"}`,
+			"testpkg:malobj": "not_a_struct",
+		},
+	})
+	assert.Nil(t, err)
+
+	cfg := New(ctx, "testpkg")
+
+	assert.Equal(t, "a string value", awaitSecret(t, cfg.GetSecret("sss")))
+	assert.Equal(t, true, awaitSecret(t, cfg.GetSecretBool("bbb")))
+	assert.Equal(t, 42, awaitSecret(t, cfg.GetSecretInt("intint")))
+	assert.Equal(t, "", awaitSecret(t, cfg.GetSecret("missing")))
+	assert.Equal(t, "a string value", awaitSecret(t, cfg.RequireSecret("sss")))
+	assert.Equal(t, uint64(42), awaitSecret(t, cfg.RequireSecretUint64("intint")))
+	assert.Panics(t, func() { cfg.RequireSecret("missing") })
+
+	s, err := cfg.TrySecret("sss")
+	assert.Nil(t, err)
+	assert.Equal(t, "a string value", awaitSecret(t, s))
+	_, err = cfg.TrySecretInt("missing")
+	assert.NotNil(t, err)
+
+	expectedTestStruct := TestStruct{Foo: map[string]string{"a": "1"}, Bar: "abc"}
+
+	var testStruct TestStruct
+	obj, err := cfg.GetSecretObject("obj", &testStruct)
+	assert.Nil(t, err)
+	assert.Equal(t, &expectedTestStruct, awaitSecret(t, obj))
+
+	testStruct = TestStruct{}
+	obj = cfg.RequireSecretObject("obj", &testStruct)
+	assert.Equal(t, &expectedTestStruct, awaitSecret(t, obj))
+	assert.Panics(t, func() { cfg.RequireSecretObject("malobj", &testStruct) })
+
+	testStruct = TestStruct{}
+	_, err = cfg.TrySecretObject("malobj", &testStruct)
+	assert.NotNil(t, err)
+	_, err = cfg.TrySecretObject("missing", &testStruct)
+	assert.NotNil(t, err)
+}
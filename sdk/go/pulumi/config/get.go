@@ -142,3 +142,95 @@ func GetUint64(ctx *pulumi.Context, key string) uint64 {
 	}
 	return 0
 }
+
+// GetSecret loads an optional configuration value by its key, as a secret, or returns a secret "" if it doesn't exist.
+func GetSecret(ctx *pulumi.Context, key string) pulumi.StringOutput {
+	return pulumi.StringOutput(pulumi.ToSecret(Get(ctx, key)))
+}
+
+// GetSecretBool loads an optional configuration value by its key, as a secret bool, or returns a secret false if it
+// doesn't exist.
+func GetSecretBool(ctx *pulumi.Context, key string) pulumi.BoolOutput {
+	return pulumi.BoolOutput(pulumi.ToSecret(GetBool(ctx, key)))
+}
+
+// GetSecretFloat32 loads an optional configuration value by its key, as a secret float32, or returns a secret 0.0 if it
+// doesn't exist.
+func GetSecretFloat32(ctx *pulumi.Context, key string) pulumi.Float32Output {
+	return pulumi.Float32Output(pulumi.ToSecret(GetFloat32(ctx, key)))
+}
+
+// GetSecretFloat64 loads an optional configuration value by its key, as a secret float64, or returns a secret 0.0 if it
+// doesn't exist.
+func GetSecretFloat64(ctx *pulumi.Context, key string) pulumi.Float64Output {
+	return pulumi.Float64Output(pulumi.ToSecret(GetFloat64(ctx, key)))
+}
+
+// GetSecretInt loads an optional configuration value by its key, as a secret int, or returns a secret 0 if it doesn't
+// exist.
+func GetSecretInt(ctx *pulumi.Context, key string) pulumi.IntOutput {
+	return pulumi.IntOutput(pulumi.ToSecret(GetInt(ctx, key)))
+}
+
+// GetSecretInt8 loads an optional configuration value by its key, as a secret int8, or returns a secret 0 if it doesn't
+// exist.
+func GetSecretInt8(ctx *pulumi.Context, key string) pulumi.Int8Output {
+	return pulumi.Int8Output(pulumi.ToSecret(GetInt8(ctx, key)))
+}
+
+// GetSecretInt16 loads an optional configuration value by its key, as a secret int16, or returns a secret 0 if it
+// doesn't exist.
+func GetSecretInt16(ctx *pulumi.Context, key string) pulumi.Int16Output {
+	return pulumi.Int16Output(pulumi.ToSecret(GetInt16(ctx, key)))
+}
+
+// GetSecretInt32 loads an optional configuration value by its key, as a secret int32, or returns a secret 0 if it
+// doesn't exist.
+func GetSecretInt32(ctx *pulumi.Context, key string) pulumi.Int32Output {
+	return pulumi.Int32Output(pulumi.ToSecret(GetInt32(ctx, key)))
+}
+
+// GetSecretInt64 loads an optional configuration value by its key, as a secret int64, or returns a secret 0 if it
+// doesn't exist.
+func GetSecretInt64(ctx *pulumi.Context, key string) pulumi.Int64Output {
+	return pulumi.Int64Output(pulumi.ToSecret(GetInt64(ctx, key)))
+}
+
+// GetSecretObject attempts to load an optional configuration value by its key into the specified output variable, and
+// returns the result as a secret output.
+func GetSecretObject(ctx *pulumi.Context, key string, output interface{}) (pulumi.Output, error) {
+	if err := GetObject(ctx, key, output); err != nil {
+		return pulumi.Output{}, err
+	}
+	return pulumi.ToSecret(output), nil
+}
+
+// GetSecretUint loads an optional configuration value by its key, as a secret uint, or returns a secret 0 if it doesn't
+// exist.
+func GetSecretUint(ctx *pulumi.Context, key string) pulumi.UintOutput {
+	return pulumi.UintOutput(pulumi.ToSecret(GetUint(ctx, key)))
+}
+
+// GetSecretUint8 loads an optional configuration value by its key, as a secret uint8, or returns a secret 0 if it
+// doesn't exist.
+func GetSecretUint8(ctx *pulumi.Context, key string) pulumi.Uint8Output {
+	return pulumi.Uint8Output(pulumi.ToSecret(GetUint8(ctx, key)))
+}
+
+// GetSecretUint16 loads an optional configuration value by its key, as a secret uint16, or returns a secret 0 if it
+// doesn't exist.
+func GetSecretUint16(ctx *pulumi.Context, key string) pulumi.Uint16Output {
+	return pulumi.Uint16Output(pulumi.ToSecret(GetUint16(ctx, key)))
+}
+
+// GetSecretUint32 loads an optional configuration value by its key, as a secret uint32, or returns a secret 0 if it
+// doesn't exist.
+func GetSecretUint32(ctx *pulumi.Context, key string) pulumi.Uint32Output {
+	return pulumi.Uint32Output(pulumi.ToSecret(GetUint32(ctx, key)))
+}
+
+// GetSecretUint64 loads an optional configuration value by its key, as a secret uint64, or returns a secret 0 if it
+// doesn't exist.
+func GetSecretUint64(ctx *pulumi.Context, key string) pulumi.Uint64Output {
+	return pulumi.Uint64Output(pulumi.ToSecret(GetUint64(ctx, key)))
+}
@@ -118,3 +118,80 @@ func RequireUint64(ctx *pulumi.Context, key string) uint64 {
 	v := Require(ctx, key)
 	return cast.ToUint64(v)
 }
+
+// RequireSecret loads a configuration value by its key, as a secret, or panics if it doesn't exist.
+func RequireSecret(ctx *pulumi.Context, key string) pulumi.StringOutput {
+	return pulumi.StringOutput(pulumi.ToSecret(Require(ctx, key)))
+}
+
+// RequireSecretBool loads a configuration value by its key, as a secret bool, or panics if it doesn't exist.
+func RequireSecretBool(ctx *pulumi.Context, key string) pulumi.BoolOutput {
+	return pulumi.BoolOutput(pulumi.ToSecret(RequireBool(ctx, key)))
+}
+
+// RequireSecretFloat32 loads a configuration value by its key, as a secret float32, or panics if it doesn't exist.
+func RequireSecretFloat32(ctx *pulumi.Context, key string) pulumi.Float32Output {
+	return pulumi.Float32Output(pulumi.ToSecret(RequireFloat32(ctx, key)))
+}
+
+// RequireSecretFloat64 loads a configuration value by its key, as a secret float64, or panics if it doesn't exist.
+func RequireSecretFloat64(ctx *pulumi.Context, key string) pulumi.Float64Output {
+	return pulumi.Float64Output(pulumi.ToSecret(RequireFloat64(ctx, key)))
+}
+
+// RequireSecretInt loads a configuration value by its key, as a secret int, or panics if it doesn't exist.
+func RequireSecretInt(ctx *pulumi.Context, key string) pulumi.IntOutput {
+	return pulumi.IntOutput(pulumi.ToSecret(RequireInt(ctx, key)))
+}
+
+// RequireSecretInt8 loads a configuration value by its key, as a secret int8, or panics if it doesn't exist.
+func RequireSecretInt8(ctx *pulumi.Context, key string) pulumi.Int8Output {
+	return pulumi.Int8Output(pulumi.ToSecret(RequireInt8(ctx, key)))
+}
+
+// RequireSecretInt16 loads a configuration value by its key, as a secret int16, or panics if it doesn't exist.
+func RequireSecretInt16(ctx *pulumi.Context, key string) pulumi.Int16Output {
+	return pulumi.Int16Output(pulumi.ToSecret(RequireInt16(ctx, key)))
+}
+
+// RequireSecretInt32 loads a configuration value by its key, as a secret int32, or panics if it doesn't exist.
+func RequireSecretInt32(ctx *pulumi.Context, key string) pulumi.Int32Output {
+	return pulumi.Int32Output(pulumi.ToSecret(RequireInt32(ctx, key)))
+}
+
+// RequireSecretInt64 loads a configuration value by its key, as a secret int64, or panics if it doesn't exist.
+func RequireSecretInt64(ctx *pulumi.Context, key string) pulumi.Int64Output {
+	return pulumi.Int64Output(pulumi.ToSecret(RequireInt64(ctx, key)))
+}
+
+// RequireSecretObject loads a configuration value by its key into the output variable, and returns the result as a
+// secret output, or panics if unable to do so.
+func RequireSecretObject(ctx *pulumi.Context, key string, output interface{}) pulumi.Output {
+	RequireObject(ctx, key, output)
+	return pulumi.ToSecret(output)
+}
+
+// RequireSecretUint loads a configuration value by its key, as a secret uint, or panics if it doesn't exist.
+func RequireSecretUint(ctx *pulumi.Context, key string) pulumi.UintOutput {
+	return pulumi.UintOutput(pulumi.ToSecret(RequireUint(ctx, key)))
+}
+
+// RequireSecretUint8 loads a configuration value by its key, as a secret uint8, or panics if it doesn't exist.
+func RequireSecretUint8(ctx *pulumi.Context, key string) pulumi.Uint8Output {
+	return pulumi.Uint8Output(pulumi.ToSecret(RequireUint8(ctx, key)))
+}
+
+// RequireSecretUint16 loads a configuration value by its key, as a secret uint16, or panics if it doesn't exist.
+func RequireSecretUint16(ctx *pulumi.Context, key string) pulumi.Uint16Output {
+	return pulumi.Uint16Output(pulumi.ToSecret(RequireUint16(ctx, key)))
+}
+
+// RequireSecretUint32 loads a configuration value by its key, as a secret uint32, or panics if it doesn't exist.
+func RequireSecretUint32(ctx *pulumi.Context, key string) pulumi.Uint32Output {
+	return pulumi.Uint32Output(pulumi.ToSecret(RequireUint32(ctx, key)))
+}
+
+// RequireSecretUint64 loads a configuration value by its key, as a secret uint64, or panics if it doesn't exist.
+func RequireSecretUint64(ctx *pulumi.Context, key string) pulumi.Uint64Output {
+	return pulumi.Uint64Output(pulumi.ToSecret(RequireUint64(ctx, key)))
+}
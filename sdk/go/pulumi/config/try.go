@@ -159,3 +159,140 @@ func TryUint64(ctx *pulumi.Context, key string) (uint64, error) {
 	}
 	return cast.ToUint64(v), nil
 }
+
+// TrySecret loads a configuration value by its key, as a secret, or returns an error if it doesn't exist.
+func TrySecret(ctx *pulumi.Context, key string) (pulumi.StringOutput, error) {
+	v, err := Try(ctx, key)
+	if err != nil {
+		return pulumi.StringOutput{}, err
+	}
+	return pulumi.StringOutput(pulumi.ToSecret(v)), nil
+}
+
+// TrySecretBool loads a configuration value by its key, as a secret bool, or returns an error if it doesn't exist.
+func TrySecretBool(ctx *pulumi.Context, key string) (pulumi.BoolOutput, error) {
+	v, err := TryBool(ctx, key)
+	if err != nil {
+		return pulumi.BoolOutput{}, err
+	}
+	return pulumi.BoolOutput(pulumi.ToSecret(v)), nil
+}
+
+// TrySecretFloat32 loads a configuration value by its key, as a secret float32, or returns an error if it doesn't
+// exist.
+func TrySecretFloat32(ctx *pulumi.Context, key string) (pulumi.Float32Output, error) {
+	v, err := TryFloat32(ctx, key)
+	if err != nil {
+		return pulumi.Float32Output{}, err
+	}
+	return pulumi.Float32Output(pulumi.ToSecret(v)), nil
+}
+
+// TrySecretFloat64 loads a configuration value by its key, as a secret float64, or returns an error if it doesn't
+// exist.
+func TrySecretFloat64(ctx *pulumi.Context, key string) (pulumi.Float64Output, error) {
+	v, err := TryFloat64(ctx, key)
+	if err != nil {
+		return pulumi.Float64Output{}, err
+	}
+	return pulumi.Float64Output(pulumi.ToSecret(v)), nil
+}
+
+// TrySecretInt loads a configuration value by its key, as a secret int, or returns an error if it doesn't exist.
+func TrySecretInt(ctx *pulumi.Context, key string) (pulumi.IntOutput, error) {
+	v, err := TryInt(ctx, key)
+	if err != nil {
+		return pulumi.IntOutput{}, err
+	}
+	return pulumi.IntOutput(pulumi.ToSecret(v)), nil
+}
+
+// TrySecretInt8 loads a configuration value by its key, as a secret int8, or returns an error if it doesn't exist.
+func TrySecretInt8(ctx *pulumi.Context, key string) (pulumi.Int8Output, error) {
+	v, err := TryInt8(ctx, key)
+	if err != nil {
+		return pulumi.Int8Output{}, err
+	}
+	return pulumi.Int8Output(pulumi.ToSecret(v)), nil
+}
+
+// TrySecretInt16 loads a configuration value by its key, as a secret int16, or returns an error if it doesn't exist.
+func TrySecretInt16(ctx *pulumi.Context, key string) (pulumi.Int16Output, error) {
+	v, err := TryInt16(ctx, key)
+	if err != nil {
+		return pulumi.Int16Output{}, err
+	}
+	return pulumi.Int16Output(pulumi.ToSecret(v)), nil
+}
+
+// TrySecretInt32 loads a configuration value by its key, as a secret int32, or returns an error if it doesn't exist.
+func TrySecretInt32(ctx *pulumi.Context, key string) (pulumi.Int32Output, error) {
+	v, err := TryInt32(ctx, key)
+	if err != nil {
+		return pulumi.Int32Output{}, err
+	}
+	return pulumi.Int32Output(pulumi.ToSecret(v)), nil
+}
+
+// TrySecretInt64 loads a configuration value by its key, as a secret int64, or returns an error if it doesn't exist.
+func TrySecretInt64(ctx *pulumi.Context, key string) (pulumi.Int64Output, error) {
+	v, err := TryInt64(ctx, key)
+	if err != nil {
+		return pulumi.Int64Output{}, err
+	}
+	return pulumi.Int64Output(pulumi.ToSecret(v)), nil
+}
+
+// TrySecretObject loads a configuration value by its key into the output variable, and returns the result as a secret
+// output, or returns an error if unable to do so.
+func TrySecretObject(ctx *pulumi.Context, key string, output interface{}) (pulumi.Output, error) {
+	if err := TryObject(ctx, key, output); err != nil {
+		return pulumi.Output{}, err
+	}
+	return pulumi.ToSecret(output), nil
+}
+
+// TrySecretUint loads a configuration value by its key, as a secret uint, or returns an error if it doesn't exist.
+func TrySecretUint(ctx *pulumi.Context, key string) (pulumi.UintOutput, error) {
+	v, err := TryUint(ctx, key)
+	if err != nil {
+		return pulumi.UintOutput{}, err
+	}
+	return pulumi.UintOutput(pulumi.ToSecret(v)), nil
+}
+
+// TrySecretUint8 loads a configuration value by its key, as a secret uint8, or returns an error if it doesn't exist.
+func TrySecretUint8(ctx *pulumi.Context, key string) (pulumi.Uint8Output, error) {
+	v, err := TryUint8(ctx, key)
+	if err != nil {
+		return pulumi.Uint8Output{}, err
+	}
+	return pulumi.Uint8Output(pulumi.ToSecret(v)), nil
+}
+
+// TrySecretUint16 loads a configuration value by its key, as a secret uint16, or returns an error if it doesn't exist.
+func TrySecretUint16(ctx *pulumi.Context, key string) (pulumi.Uint16Output, error) {
+	v, err := TryUint16(ctx, key)
+	if err != nil {
+		return pulumi.Uint16Output{}, err
+	}
+	return pulumi.Uint16Output(pulumi.ToSecret(v)), nil
+}
+
+// TrySecretUint32 loads a configuration value by its key, as a secret uint32, or returns an error if it doesn't exist.
+func TrySecretUint32(ctx *pulumi.Context, key string) (pulumi.Uint32Output, error) {
+	v, err := TryUint32(ctx, key)
+	if err != nil {
+		return pulumi.Uint32Output{}, err
+	}
+	return pulumi.Uint32Output(pulumi.ToSecret(v)), nil
+}
+
+// TrySecretUint64 loads a configuration value by its key, as a secret uint64, or returns an error if it doesn't exist.
+func TrySecretUint64(ctx *pulumi.Context, key string) (pulumi.Uint64Output, error) {
+	v, err := TryUint64(ctx, key)
+	if err != nil {
+		return pulumi.Uint64Output{}, err
+	}
+	return pulumi.Uint64Output(pulumi.ToSecret(v)), nil
+}
@@ -15,6 +15,7 @@
 package pulumi
 
 import (
+	"reflect"
 	"sort"
 	"strings"
 	"sync"
@@ -25,6 +26,7 @@ import (
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 
+	"github.com/pulumi/pulumi/pkg/resource/plugin"
 	"github.com/pulumi/pulumi/pkg/util/logging"
 	pulumirpc "github.com/pulumi/pulumi/sdk/proto/go"
 )
@@ -35,6 +37,7 @@ type Context struct {
 	info        RunInfo
 	stackR      URN
 	exports     map[string]interface{}
+	exportsLock sync.Mutex // a lock protecting the stack's exports.
 	monitor     pulumirpc.ResourceMonitorClient
 	monitorConn *grpc.ClientConn
 	engine      pulumirpc.EngineClient
@@ -42,6 +45,13 @@ type Context struct {
 	rpcs        int         // the number of outstanding RPC requests.
 	rpcsDone    *sync.Cond  // an event signaling completion of RPCs.
 	rpcsLock    *sync.Mutex // a lock protecting the RPC count and event.
+
+	componentsLock    sync.Mutex          // a lock protecting the component registrations.
+	components        []ComponentResource // the component resources registered by the program.
+	registeredOutputs map[URN]bool        // the URNs of resources whose outputs have been registered.
+
+	transformationsLock  sync.Mutex               // a lock protecting the stack transformations.
+	stackTransformations []ResourceTransformation // the transformations applied to every resource in the stack.
 }
 
 // NewContext creates a fresh run context out of the given metadata.
@@ -49,7 +59,11 @@ func NewContext(ctx context.Context, info RunInfo) (*Context, error) {
 	// Connect to the gRPC endpoints if we have addresses for them.
 	var monitorConn *grpc.ClientConn
 	var monitor pulumirpc.ResourceMonitorClient
-	if addr := info.MonitorAddr; addr != "" {
+	if info.Mocks != nil {
+		monitor = &monitorServerClient{
+			server: &mockMonitor{project: info.Project, stack: info.Stack, mocks: info.Mocks},
+		}
+	} else if addr := info.MonitorAddr; addr != "" {
 		conn, err := grpc.Dial(info.MonitorAddr, grpc.WithInsecure())
 		if err != nil {
 			return nil, errors.Wrap(err, "connecting to resource monitor over RPC")
@@ -81,6 +95,8 @@ func NewContext(ctx context.Context, info RunInfo) (*Context, error) {
 		rpcs:        0,
 		rpcsLock:    mutex,
 		rpcsDone:    sync.NewCond(mutex),
+
+		registeredOutputs: make(map[URN]bool),
 	}, nil
 }
 
@@ -117,8 +133,69 @@ func (ctx *Context) GetConfig(key string) (string, bool) {
 	return v, ok
 }
 
-// Invoke will invoke a provider's function, identified by its token tok.  This function call is synchronous.
+// Invoke will invoke a provider's function, identified by its token tok.  This function call is synchronous.  Results
+// that are (or contain) secrets are returned as secret outputs, so that they remain secret when passed to resources.
 func (ctx *Context) Invoke(tok string, args map[string]interface{}, opts ...InvokeOpt) (map[string]interface{}, error) {
+	ret, err := ctx.invoke(tok, args, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Otherwsie, simply unmarshal the output properties and return the result.
+	outs, secrets, err := unmarshalOutputs(ret)
+	logging.V(9).Infof("Invoke(%s, ...): success: w/ %d outs (err=%v)", tok, len(outs), err)
+	if err != nil {
+		return nil, err
+	}
+	for k := range secrets {
+		outs[k] = ToSecret(outs[k])
+	}
+	return outs, nil
+}
+
+// InvokeTyped will invoke a provider's function, identified by its token tok. The function's arguments are read from
+// args, which must be a struct (or a pointer to one) whose fields are tagged with `pulumi:"name"`, and its results are
+// decoded into result, which must be a pointer to a struct whose fields are tagged in the same way. A result field is
+// required unless its tag includes the "optional" flag, as in `pulumi:"name,optional"`. A result that is secret or
+// unknown must be decoded into an Output (or a typed output such as StringOutput), which keeps its secretness; it is
+// an error to decode one into any other type, as neither can be represented by a plain Go value.
+func (ctx *Context) InvokeTyped(tok string, args interface{}, result interface{}, opts ...InvokeOpt) error {
+	rv := reflect.ValueOf(result)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.Errorf("result must be a non-nil pointer to a struct, not a %T", result)
+	}
+
+	var argsMap map[string]interface{}
+	if args != nil {
+		v, _, err := marshalInput(args)
+		if err != nil {
+			return errors.Wrap(err, "marshaling arguments")
+		}
+		m, ok := v.(map[string]interface{})
+		if v != nil && !ok {
+			return errors.Errorf("arguments must be a struct or a map, not a %T", args)
+		}
+		argsMap = m
+	}
+
+	ret, err := ctx.invoke(tok, argsMap, opts...)
+	if err != nil {
+		return err
+	}
+
+	props, err := plugin.UnmarshalProperties(ret, plugin.MarshalOptions{KeepSecrets: true, KeepUnknowns: true})
+	if err != nil {
+		return err
+	}
+	if err = unmarshalStruct(props, result); err != nil {
+		return errors.Wrapf(err, "decoding result of %s", tok)
+	}
+	logging.V(9).Infof("InvokeTyped(%s, ...): success: w/ %d outs", tok, len(props))
+	return nil
+}
+
+// invoke makes the invoke RPC for the function identified by tok, returning its raw results.
+func (ctx *Context) invoke(tok string, args map[string]interface{}, opts ...InvokeOpt) (*structpb.Struct, error) {
 	if tok == "" {
 		return nil, errors.New("invoke token must not be empty")
 	}
@@ -128,9 +205,8 @@ func (ctx *Context) Invoke(tok string, args map[string]interface{}, opts ...Invo
 	for _, opt := range opts {
 		if opt.Parent != nil && opt.Provider == nil {
 			// attempt to use parent provider if no other is specified.
-			v, ok := opt.Parent.(*ResourceState)
-			if ok {
-				opt.Provider = v.GetProvider(tok)
+			if rs, ok := opt.Parent.(resourceStater); ok {
+				opt.Provider = rs.resourceState().GetProvider(tok)
 			}
 		}
 		if opt.Provider != nil {
@@ -179,10 +255,7 @@ func (ctx *Context) Invoke(tok string, args map[string]interface{}, opts ...Invo
 		return nil, ferr
 	}
 
-	// Otherwsie, simply unmarshal the output properties and return the result.
-	outs, err := unmarshalOutputs(resp.Return)
-	logging.V(9).Infof("Invoke(%s, ...): success: w/ %d outs (err=%v)", tok, len(outs), err)
-	return outs, err
+	return resp.Return, nil
 }
 
 // ReadResource reads an existing custom resource's state from the resource monitor.  Note that resources read in this
@@ -197,6 +270,8 @@ func (ctx *Context) ReadResource(
 		return nil, errors.New("resource ID is required for lookup and cannot be empty")
 	}
 
+	props, opts, transformations, parent := ctx.applyTransformations(t, name, props, opts)
+
 	// Note that we're about to make an outstanding RPC request, so that we can rendezvous during shutdown.
 	if err := ctx.beginRPC(); err != nil {
 		return nil, err
@@ -206,6 +281,7 @@ func (ctx *Context) ReadResource(
 	res := makeResourceState(true, props)
 
 	res.providers = mergeProviders(t, opts...)
+	res.transformations, res.parent = transformations, parent
 
 	// Kick off the resource read operation.  This will happen asynchronously and resolve the above properties.
 	go func() {
@@ -219,19 +295,23 @@ func (ctx *Context) ReadResource(
 		}()
 
 		// Prepare the inputs for an impending operation.
-		inputs, err := ctx.prepareResourceInputs(props, t, res.providers, opts...)
+		inputs, err := ctx.prepareResourceInputs(props, t, name, res.providers, opts...)
 		if err != nil {
 			return
 		}
+		res.aliases = inputs.aliases
 
 		logging.V(9).Infof("ReadResource(%s, %s): Goroutine spawned, RPC call being made", t, name)
 		resp, err := ctx.monitor.ReadResource(ctx.ctx, &pulumirpc.ReadResourceRequest{
-			Type:       t,
-			Name:       name,
-			Parent:     inputs.parent,
-			Properties: inputs.rpcProps,
-			Provider:   inputs.provider,
-			Id:         string(id),
+			Type:                    t,
+			Name:                    name,
+			Parent:                  inputs.parent,
+			Properties:              inputs.rpcProps,
+			Provider:                inputs.provider,
+			Id:                      string(id),
+			AcceptSecrets:           true,
+			AdditionalSecretOutputs: inputs.additionalSecretOutputs,
+			Aliases:                 inputs.rpcAliases(),
 		})
 		if err != nil {
 			logging.V(9).Infof("RegisterResource(%s, %s): error: %v", t, name, err)
@@ -250,22 +330,120 @@ func (ctx *Context) ReadResource(
 // RegisterResource creates and registers a new resource object.  t is the fully qualified type token and name is
 // the "name" part to use in creating a stable and globally unique URN for the object.  state contains the goal state
 // for the resource object and opts contains optional settings that govern the way the resource is created.
+//
+// resource must be a pointer to a ResourceState or to a struct that embeds one, which is initialized by this call.
+// Each of the struct's fields that has a `pulumi:"name"` tag and an Output type, such as StringOutput, is set to an
+// output that resolves to the named property of the resource once registration completes.
 func (ctx *Context) RegisterResource(
-	t, name string, custom bool, props map[string]interface{}, opts ...ResourceOpt) (*ResourceState, error) {
+	t, name string, custom bool, props map[string]interface{}, resource Resource, opts ...ResourceOpt) error {
+
+	rs, ok := resource.(resourceStater)
+	if !ok {
+		return errors.Errorf("resource %s (%T) must embed pulumi.ResourceState", name, resource)
+	}
+
+	props, opts, transformations, parent := ctx.applyTransformations(t, name, props, opts)
+
+	// Create resolvers for the resource's outputs.
+	state := rs.resourceState()
+	initResourceState(state, custom, props)
+	if err := bindResourceOutputs(resource, state); err != nil {
+		return err
+	}
+	state.transformations, state.parent = transformations, parent
+	return ctx.registerResource(state, t, name, custom, props, opts...)
+}
+
+// RegisterComponentResource registers a new component resource. The component must embed ResourceState, which is
+// initialized by this call; the component's URN resolves once registration completes. Resources created with the
+// component as their Parent inherit the providers passed in ResourceOpt.Providers. If the component's outputs have not
+// been registered by the time the program completes, they are registered automatically from those of the component's
+// fields that carry a `pulumi:"name"` tag.
+func (ctx *Context) RegisterComponentResource(
+	t, name string, component ComponentResource, opts ...ResourceOpt) error {
+
+	rs, ok := component.(resourceStater)
+	if !ok {
+		return errors.Errorf("component resource %s (%T) must embed pulumi.ResourceState", name, component)
+	}
+
+	props, opts, transformations, parent := ctx.applyTransformations(t, name, nil, opts)
+
+	state := rs.resourceState()
+	initResourceState(state, false, props)
+	state.transformations, state.parent = transformations, parent
+	if err := ctx.registerResource(state, t, name, false, props, opts...); err != nil {
+		return err
+	}
+
+	ctx.componentsLock.Lock()
+	ctx.components = append(ctx.components, component)
+	ctx.componentsLock.Unlock()
+	return nil
+}
+
+// RegisterStackTransformation adds a transformation to every resource that is subsequently registered in the stack,
+// including the children of resources that were registered before it. Stack transformations run after the
+// transformations of the resource and of its parents.
+func (ctx *Context) RegisterStackTransformation(t ResourceTransformation) {
+	ctx.transformationsLock.Lock()
+	defer ctx.transformationsLock.Unlock()
+	ctx.stackTransformations = append(ctx.stackTransformations, t)
+}
+
+// applyTransformations applies the resource's transformations, followed by those of each of its ancestors and then
+// those of the stack, to its props and opts. The ancestors' and stack's transformations are looked up now, rather than
+// when the ancestors were registered, so that stack transformations registered in the meantime also apply. It returns
+// the transformed props and opts, the resource's own transformations, and the state of its parent, if any, which its
+// children use to look up the transformations they inherit.
+func (ctx *Context) applyTransformations(t, name string, props map[string]interface{},
+	opts []ResourceOpt) (map[string]interface{}, []ResourceOpt, []ResourceTransformation, *ResourceState) {
+
+	var parent Resource
+	var own []ResourceTransformation
+	for _, opt := range opts {
+		if parent == nil && opt.Parent != nil {
+			parent = opt.Parent
+		}
+		own = append(own, opt.ResourceTransformations...)
+	}
+
+	var parentState *ResourceState
+	if rs, ok := parent.(resourceStater); ok {
+		parentState = rs.resourceState()
+	}
+
+	transformations := append([]ResourceTransformation(nil), own...)
+	for ancestor := parentState; ancestor != nil; ancestor = ancestor.parent {
+		transformations = append(transformations, ancestor.transformations...)
+	}
+	ctx.transformationsLock.Lock()
+	transformations = append(transformations, ctx.stackTransformations...)
+	ctx.transformationsLock.Unlock()
+
+	for _, transformation := range transformations {
+		result := transformation(&ResourceTransformationArgs{Type: t, Name: name, Props: props, Opts: opts})
+		if result != nil {
+			props, opts = result.Props, result.Opts
+		}
+	}
+	return props, opts, own, parentState
+}
+
+// registerResource registers a new resource object whose outputs will be resolved using the given resource state.
+func (ctx *Context) registerResource(res *ResourceState,
+	t, name string, custom bool, props map[string]interface{}, opts ...ResourceOpt) error {
 	if t == "" {
-		return nil, errors.New("resource type argument cannot be empty")
+		return errors.New("resource type argument cannot be empty")
 	} else if name == "" {
-		return nil, errors.New("resource name argument (for URN creation) cannot be empty")
+		return errors.New("resource name argument (for URN creation) cannot be empty")
 	}
 
 	// Note that we're about to make an outstanding RPC request, so that we can rendezvous during shutdown.
 	if err := ctx.beginRPC(); err != nil {
-		return nil, err
+		return err
 	}
 
-	// Create resolvers for the resource's outputs.
-	res := makeResourceState(custom, props)
-
 	res.providers = mergeProviders(t, opts...)
 
 	// Kick off the resource registration.  If we are actually performing a deployment, the resulting properties
@@ -281,26 +459,32 @@ func (ctx *Context) RegisterResource(
 		}()
 
 		// Prepare the inputs for an impending operation.
-		inputs, err := ctx.prepareResourceInputs(props, t, res.providers, opts...)
+		inputs, err := ctx.prepareResourceInputs(props, t, name, res.providers, opts...)
 		if err != nil {
 			return
 		}
+		res.aliases = inputs.aliases
 
 		logging.V(9).Infof("RegisterResource(%s, %s): Goroutine spawned, RPC call being made", t, name)
 		resp, err := ctx.monitor.RegisterResource(ctx.ctx, &pulumirpc.RegisterResourceRequest{
-			Type:                 t,
-			Name:                 name,
-			Parent:               inputs.parent,
-			Object:               inputs.rpcProps,
-			Custom:               custom,
-			Protect:              inputs.protect,
-			Dependencies:         inputs.deps,
-			Provider:             inputs.provider,
-			PropertyDependencies: inputs.rpcPropertyDeps,
-			DeleteBeforeReplace:  inputs.deleteBeforeReplace,
-			ImportId:             inputs.importID,
-			CustomTimeouts:       inputs.customTimeouts,
-			IgnoreChanges:        inputs.ignoreChanges,
+			Type:                    t,
+			Name:                    name,
+			Parent:                  inputs.parent,
+			Object:                  inputs.rpcProps,
+			Custom:                  custom,
+			Protect:                 inputs.protect,
+			Dependencies:            inputs.deps,
+			Provider:                inputs.provider,
+			PropertyDependencies:    inputs.rpcPropertyDeps,
+			DeleteBeforeReplace:     inputs.deleteBeforeReplace,
+			ImportId:                inputs.importID,
+			CustomTimeouts:          inputs.customTimeouts,
+			IgnoreChanges:           inputs.ignoreChanges,
+			ReplaceOnChanges:        inputs.replaceOnChanges,
+			RetainOnDelete:          inputs.retainOnDelete,
+			AcceptSecrets:           true,
+			AdditionalSecretOutputs: inputs.additionalSecretOutputs,
+			Aliases:                 inputs.rpcAliases(),
 		})
 		if err != nil {
 			logging.V(9).Infof("RegisterResource(%s, %s): error: %v", t, name, err)
@@ -313,7 +497,7 @@ func (ctx *Context) RegisterResource(
 		}
 	}()
 
-	return res, nil
+	return nil
 }
 
 // ResourceState contains the results of a resource registration operation.
@@ -326,6 +510,12 @@ type ResourceState struct {
 	State Outputs
 	// Map from pkg to provider
 	providers map[string]ProviderResource
+	// aliases are the URNs of the resource's aliases, which its children inherit.
+	aliases []URN
+	// transformations are the transformations passed to the resource itself, which its children inherit.
+	transformations []ResourceTransformation
+	// parent is the state of the resource's parent, if any, whose transformations the resource's children inherit.
+	parent *ResourceState
 }
 
 // URN will resolve to the resource's URN after registration has completed.
@@ -359,9 +549,9 @@ func mergeProviders(t string, opts ...ResourceOpt) map[string]ProviderResource {
 
 	// copy parent providers, giving precedence to existing providers
 	if parent != nil {
-		rs, ok := parent.(*ResourceState)
+		rs, ok := parent.(resourceStater)
 		if ok {
-			for k, v := range rs.providers {
+			for k, v := range rs.resourceState().providers {
 				if _, has := providers[k]; !has {
 					providers[k] = v
 				}
@@ -380,6 +570,15 @@ func mergeProviders(t string, opts ...ResourceOpt) map[string]ProviderResource {
 	return providers
 }
 
+// resourceStater is implemented by ResourceState and, by way of embedding, by component resources.
+type resourceStater interface {
+	resourceState() *ResourceState
+}
+
+func (state *ResourceState) resourceState() *ResourceState {
+	return state
+}
+
 // GetProvider takes a URN and returns the associated provider
 func (state *ResourceState) GetProvider(t string) ProviderResource {
 	pkg := getPackage(t)
@@ -399,7 +598,12 @@ func getPackage(t string) string {
 // properties.
 func makeResourceState(custom bool, props map[string]interface{}) *ResourceState {
 	state := &ResourceState{}
+	initResourceState(state, custom, props)
+	return state
+}
 
+// initResourceState initializes the resolvers of an existing resource state.
+func initResourceState(state *ResourceState, custom bool, props map[string]interface{}) {
 	state.urn = URNOutput(newOutput(state))
 
 	if custom {
@@ -412,16 +616,45 @@ func makeResourceState(custom bool, props map[string]interface{}) *ResourceState
 	}
 
 	state.providers = make(map[string]ProviderResource)
+}
 
-	return state
+// bindResourceOutputs sets each of the resource's fields that has a `pulumi:"name"` tag and an Output type to the
+// output that will resolve to the named property of the given resource state.
+func bindResourceOutputs(resource Resource, state *ResourceState) error {
+	v := reflect.ValueOf(resource)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	v = v.Elem()
+
+	typ := v.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		name := fieldName(field)
+		if name == "" {
+			continue
+		}
+		if field.Type.Kind() != reflect.Struct || !field.Type.ConvertibleTo(outputType) {
+			return errors.Errorf("field %s.%s must have an Output type, not %v", typ, field.Name, field.Type)
+		}
+
+		out, has := state.State[name]
+		if !has {
+			out = newOutput(state)
+			state.State[name] = out
+		}
+		v.Field(i).Set(reflect.ValueOf(out).Convert(field.Type))
+	}
+	return nil
 }
 
 // resolve resolves the resource outputs using the given error and/or values.
 func (state *ResourceState) resolve(dryrun bool, err error, inputs map[string]interface{}, urn, id string,
 	result *structpb.Struct) {
 	var outprops map[string]interface{}
+	var secrets map[string]bool
 	if err == nil {
-		outprops, err = unmarshalOutputs(result)
+		outprops, secrets, err = unmarshalOutputs(result)
 	}
 	if err != nil {
 		// If there was an error, we must reject everything: URN, ID, and state properties.
@@ -436,10 +669,10 @@ func (state *ResourceState) resolve(dryrun bool, err error, inputs map[string]in
 	}
 
 	// Resolve the URN and ID.
-	state.urn.s.resolve(URN(urn), true)
+	state.urn.s.resolve(URN(urn), true, false)
 	if state.id.s != nil {
 		known := id != "" || !dryrun
-		state.id.s.resolve(ID(id), known)
+		state.id.s.resolve(ID(id), known, false)
 	}
 
 	// During previews, it's possible that nils will be returned due to unknown values.  This function
@@ -456,26 +689,39 @@ func (state *ResourceState) resolve(dryrun bool, err error, inputs map[string]in
 			// if any exists.
 			v = inputs[k]
 		}
-		o.s.resolve(v, isKnown(v))
+		o.s.resolve(v, isKnown(v), secrets[k])
 	}
 }
 
 // resourceInputs reflects all of the inputs necessary to perform core resource RPC operations.
 type resourceInputs struct {
-	parent              string
-	deps                []string
-	protect             bool
-	provider            string
-	rpcProps            *structpb.Struct
-	rpcPropertyDeps     map[string]*pulumirpc.RegisterResourceRequest_PropertyDependencies
-	deleteBeforeReplace bool
-	importID            string
-	customTimeouts      *pulumirpc.RegisterResourceRequest_CustomTimeouts
-	ignoreChanges       []string
+	parent                  string
+	deps                    []string
+	protect                 bool
+	provider                string
+	rpcProps                *structpb.Struct
+	rpcPropertyDeps         map[string]*pulumirpc.RegisterResourceRequest_PropertyDependencies
+	deleteBeforeReplace     bool
+	importID                string
+	customTimeouts          *pulumirpc.RegisterResourceRequest_CustomTimeouts
+	ignoreChanges           []string
+	replaceOnChanges        []string
+	retainOnDelete          bool
+	additionalSecretOutputs []string
+	aliases                 []URN
+}
+
+// rpcAliases returns the resource's aliases as strings, for RPC.
+func (inputs *resourceInputs) rpcAliases() []string {
+	var aliases []string
+	for _, alias := range inputs.aliases {
+		aliases = append(aliases, string(alias))
+	}
+	return aliases
 }
 
 // prepareResourceInputs prepares the inputs for a resource operation, shared between read and register.
-func (ctx *Context) prepareResourceInputs(props map[string]interface{}, t string,
+func (ctx *Context) prepareResourceInputs(props map[string]interface{}, t, name string,
 	providers map[string]ProviderResource, opts ...ResourceOpt) (*resourceInputs, error) {
 	// Get the parent and dependency URNs from the options, in addition to the protection bit.  If there wasn't an
 	// explicit parent, and a root stack resource exists, we will automatically parent to that.
@@ -487,6 +733,19 @@ func (ctx *Context) prepareResourceInputs(props map[string]interface{}, t string
 
 	timeouts := ctx.getTimeouts(opts...)
 
+	aliases, err := ctx.getAliases(t, name, parent, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "resolving aliases")
+	}
+
+	var additionalSecretOutputs, replaceOnChanges []string
+	var retainOnDelete bool
+	for _, opt := range opts {
+		additionalSecretOutputs = append(additionalSecretOutputs, opt.AdditionalSecretOutputs...)
+		replaceOnChanges = append(replaceOnChanges, opt.ReplaceOnChanges...)
+		retainOnDelete = retainOnDelete || opt.RetainOnDelete
+	}
+
 	// Serialize all properties, first by awaiting them, and then marshaling them to the requisite gRPC values.
 	keepUnknowns := ctx.DryRun()
 	rpcProps, propertyDeps, rpcDeps, err := marshalInputs(props, keepUnknowns)
@@ -524,16 +783,20 @@ func (ctx *Context) prepareResourceInputs(props map[string]interface{}, t string
 	sort.Strings(deps)
 
 	return &resourceInputs{
-		parent:              string(parent),
-		deps:                deps,
-		protect:             protect,
-		provider:            provider,
-		rpcProps:            rpcProps,
-		rpcPropertyDeps:     rpcPropertyDeps,
-		deleteBeforeReplace: deleteBeforeReplace,
-		importID:            string(importID),
-		customTimeouts:      timeouts,
-		ignoreChanges:       ignoreChanges,
+		parent:                  string(parent),
+		deps:                    deps,
+		protect:                 protect,
+		provider:                provider,
+		rpcProps:                rpcProps,
+		rpcPropertyDeps:         rpcPropertyDeps,
+		deleteBeforeReplace:     deleteBeforeReplace,
+		importID:                string(importID),
+		customTimeouts:          timeouts,
+		ignoreChanges:           ignoreChanges,
+		replaceOnChanges:        replaceOnChanges,
+		retainOnDelete:          retainOnDelete,
+		additionalSecretOutputs: additionalSecretOutputs,
+		aliases:                 aliases,
 	}, nil
 }
 
@@ -544,6 +807,7 @@ func (ctx *Context) getTimeouts(opts ...ResourceOpt) *pulumirpc.RegisterResource
 			timeouts.Update = opt.CustomTimeouts.Update
 			timeouts.Create = opt.CustomTimeouts.Create
 			timeouts.Delete = opt.CustomTimeouts.Delete
+			timeouts.Read = opt.CustomTimeouts.Read
 		}
 	}
 
@@ -669,6 +933,16 @@ func (ctx *Context) endRPC() {
 	}
 }
 
+// waitForPendingRPCs awaits the completion of any outstanding RPCs. Unlike waitForRPCs, it permits subsequent RPCs.
+func (ctx *Context) waitForPendingRPCs() {
+	ctx.rpcsLock.Lock()
+	defer ctx.rpcsLock.Unlock()
+
+	for ctx.rpcs > 0 {
+		ctx.rpcsDone.Wait()
+	}
+}
+
 // waitForRPCs awaits the completion of any outstanding RPCs and then leaves behind a sentinel to prevent
 // any subsequent ones from starting.  This is often used during the shutdown of a program to ensure no RPCs
 // go missing due to the program exiting prior to their completion.
@@ -692,6 +966,26 @@ var _ ProviderResource = (*ResourceState)(nil)
 
 // RegisterResourceOutputs completes the resource registration, attaching an optional set of computed outputs.
 func (ctx *Context) RegisterResourceOutputs(urn URN, outs map[string]interface{}) error {
+	// Claim the outputs first, so that they are not also registered automatically while this call is in flight.
+	ctx.claimOutputs(urn)
+	return ctx.registerResourceOutputs(urn, outs)
+}
+
+// claimOutputs records that the outputs of the resource with the given URN are being registered. It returns false if
+// they have already been claimed.
+func (ctx *Context) claimOutputs(urn URN) bool {
+	ctx.componentsLock.Lock()
+	defer ctx.componentsLock.Unlock()
+
+	if ctx.registeredOutputs[urn] {
+		return false
+	}
+	ctx.registeredOutputs[urn] = true
+	return true
+}
+
+// registerResourceOutputs makes the RPC that attaches the given outputs to the resource with the given URN.
+func (ctx *Context) registerResourceOutputs(urn URN, outs map[string]interface{}) error {
 	keepUnknowns := ctx.DryRun()
 	outsMarshalled, _, _, err := marshalInputs(outs, keepUnknowns)
 	if err != nil {
@@ -702,6 +996,7 @@ func (ctx *Context) RegisterResourceOutputs(urn URN, outs map[string]interface{}
 	if err = ctx.beginRPC(); err != nil {
 		return err
 	}
+	defer ctx.endRPC()
 
 	// Register the outputs
 	logging.V(9).Infof("RegisterResourceOutputs(%s): RPC call being made", urn)
@@ -714,13 +1009,85 @@ func (ctx *Context) RegisterResourceOutputs(urn URN, outs map[string]interface{}
 	}
 
 	logging.V(9).Infof("RegisterResourceOutputs(%s): success", urn)
-
-	// Signal the completion of this RPC and notify any potential awaiters.
-	ctx.endRPC()
 	return nil
 }
 
+// registerComponentOutputs registers the outputs of each component resource whose outputs have not already been
+// registered, using the component's tagged fields. Because components may be registered by goroutines that are still
+// running when the program's body returns (e.g. by appliers whose results are inputs to pending registrations), this
+// waits for outstanding RPCs to complete before registering outputs, and repeats until no new components appear.
+func (ctx *Context) registerComponentOutputs() error {
+	var result error
+	for registered := 0; ; {
+		ctx.waitForPendingRPCs()
+
+		ctx.componentsLock.Lock()
+		components := ctx.components[registered:]
+		ctx.componentsLock.Unlock()
+		if len(components) == 0 {
+			return result
+		}
+		registered += len(components)
+
+		for _, component := range components {
+			// If the component failed to register, the failure has already been reported.
+			urn, _, err := component.URN().await(context.TODO())
+			if err != nil || !ctx.claimOutputs(urn) {
+				continue
+			}
+			if err = ctx.registerResourceOutputs(urn, componentOutputs(component)); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+}
+
+// componentOutputs returns the values of the given component's fields that carry a `pulumi:"name"` tag, omitting
+// nil values and uninitialized outputs.
+func componentOutputs(component ComponentResource) map[string]interface{} {
+	outs := make(map[string]interface{})
+
+	rv := reflect.ValueOf(component)
+	for rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return outs
+	}
+
+	typ := rv.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		name := fieldName(typ.Field(i))
+		if name == "" {
+			continue
+		}
+		field := rv.Field(i)
+		if isNilValue(field) {
+			continue
+		}
+		if out, ok := isOutput(field.Interface()); ok && out.s == nil {
+			continue
+		}
+		outs[name] = field.Interface()
+	}
+	return outs
+}
+
 // Export registers a key and value pair with the current context's stack.
 func (ctx *Context) Export(name string, value interface{}) {
+	ctx.exportsLock.Lock()
+	defer ctx.exportsLock.Unlock()
 	ctx.exports[name] = value
 }
+
+// stackOutputs returns a copy of the stack's exports.
+func (ctx *Context) stackOutputs() map[string]interface{} {
+	ctx.exportsLock.Lock()
+	defer ctx.exportsLock.Unlock()
+
+	outs := make(map[string]interface{}, len(ctx.exports))
+	for k, v := range ctx.exports {
+		outs[k] = v
+	}
+	return outs
+}
@@ -0,0 +1,165 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/resource"
+)
+
+type invokeMonitor struct{}
+
+func (invokeMonitor) Call(token string, args resource.PropertyMap, provider string) (resource.PropertyMap, error) {
+	switch token {
+	case "test:index:getAmi":
+		return resource.PropertyMap{
+			"id":   resource.NewStringProperty("ami-" + args["region"].StringValue()),
+			"tags": resource.NewArrayProperty([]resource.PropertyValue{resource.NewStringProperty("prod")}),
+		}, nil
+	case "test:index:getPassword":
+		return resource.PropertyMap{
+			"password": resource.MakeSecret(resource.NewStringProperty("hunter2")),
+			"hints": resource.NewArrayProperty([]resource.PropertyValue{
+				resource.NewStringProperty("hunter"), resource.MakeSecret(resource.NewStringProperty("2")),
+			}),
+			"length": resource.NewNumberProperty(7),
+		}, nil
+	case "test:index:getNothing":
+		return resource.PropertyMap{}, nil
+	default:
+		return nil, errors.Errorf("unknown function %s", token)
+	}
+}
+
+func (invokeMonitor) NewResource(typeToken, name string, inputs resource.PropertyMap,
+	provider, id string) (string, resource.PropertyMap, error) {
+
+	return name + "_id", inputs, nil
+}
+
+func TestInvokeSecret(t *testing.T) {
+	err := RunErr(func(ctx *Context) error {
+		result, err := ctx.Invoke("test:index:getPassword", nil)
+		assert.NoError(t, err)
+
+		// Secret results, including those that merely contain secrets, are returned as secret outputs.
+		password, ok := result["password"].(Output)
+		if assert.True(t, ok, "password is a %T", result["password"]) {
+			v, known, secret, err := password.s.await(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, "hunter2", v)
+			assert.True(t, known)
+			assert.True(t, secret)
+		}
+		hints, ok := result["hints"].(Output)
+		if assert.True(t, ok, "hints is a %T", result["hints"]) {
+			v, _, secret, err := hints.s.await(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, []interface{}{"hunter", "2"}, v)
+			assert.True(t, secret)
+		}
+		assert.Equal(t, float64(7), result["length"])
+
+		// Passing a secret result to a resource keeps it secret.
+		res := &ResourceState{}
+		err = ctx.RegisterResource("test:index:User", "user", true, map[string]interface{}{
+			"password": result["password"],
+		}, res)
+		assert.NoError(t, err)
+		_, _, secret, err := res.State["password"].s.await(context.Background())
+		assert.NoError(t, err)
+		assert.True(t, secret)
+		return nil
+	}, WithMocks("project", "stack", invokeMonitor{}))
+	assert.NoError(t, err)
+}
+
+func TestInvokeTyped(t *testing.T) {
+	type getAmiArgs struct {
+		Region string `pulumi:"region"`
+	}
+	type getAmiResult struct {
+		ID   string   `pulumi:"id"`
+		Tags []string `pulumi:"tags"`
+	}
+	type getPasswordResult struct {
+		Password string `pulumi:"password"`
+	}
+	type getSecretPasswordResult struct {
+		Password StringOutput `pulumi:"password"`
+		Hints    Output       `pulumi:"hints"`
+		Length   Output       `pulumi:"length"`
+	}
+	type getNothingResult struct {
+		Value *string `pulumi:"value,optional"`
+	}
+
+	err := RunErr(func(ctx *Context) error {
+		var ami getAmiResult
+		err := ctx.InvokeTyped("test:index:getAmi", &getAmiArgs{Region: "us-west-2"}, &ami)
+		assert.NoError(t, err)
+		assert.Equal(t, getAmiResult{ID: "ami-us-west-2", Tags: []string{"prod"}}, ami)
+
+		// Arguments may also be given as a map.
+		ami = getAmiResult{}
+		err = ctx.InvokeTyped("test:index:getAmi", map[string]interface{}{"region": String("us-east-1")}, &ami)
+		assert.NoError(t, err)
+		assert.Equal(t, "ami-us-east-1", ami.ID)
+
+		var nothing getNothingResult
+		assert.NoError(t, ctx.InvokeTyped("test:index:getNothing", nil, &nothing))
+		assert.Nil(t, nothing.Value)
+
+		var password getPasswordResult
+		err = ctx.InvokeTyped("test:index:getPassword", nil, &password)
+		if assert.Error(t, err) {
+			assert.Equal(t, "decoding result of test:index:getPassword: password: unexpected secret value", err.Error())
+		}
+
+		// Secrets may be decoded into outputs, which keep their secretness.
+		var secretPassword getSecretPasswordResult
+		assert.NoError(t, ctx.InvokeTyped("test:index:getPassword", nil, &secretPassword))
+		v, known, secret, err := secretPassword.Password.s.await(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "hunter2", v)
+		assert.True(t, known)
+		assert.True(t, secret)
+		v, _, secret, err = secretPassword.Hints.s.await(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{"hunter", "2"}, v)
+		assert.True(t, secret)
+		v, _, secret, err = secretPassword.Length.s.await(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, float64(7), v)
+		assert.False(t, secret)
+
+		var missing getAmiResult
+		err = ctx.InvokeTyped("test:index:getNothing", nil, &missing)
+		if assert.Error(t, err) {
+			assert.Equal(t, "decoding result of test:index:getNothing: id: missing required property", err.Error())
+		}
+
+		assert.Error(t, ctx.InvokeTyped("test:index:getAmi", "us-west-2", &ami))
+		assert.Error(t, ctx.InvokeTyped("test:index:getAmi", &getAmiArgs{Region: "us-west-2"}, ami))
+		assert.Error(t, ctx.InvokeTyped("test:index:getUnknown", nil, &nothing))
+		return nil
+	}, WithMocks("project", "stack", invokeMonitor{}))
+	assert.NoError(t, err)
+}
@@ -0,0 +1,368 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// generate produces the typed inputs and outputs for each of the Go SDK's builtin types. It is run by `go generate`
+// from the sdk/go/pulumi directory, and writes types_builtins.go there.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"text/template"
+)
+
+// builtin describes a builtin type for which typed inputs and outputs are generated.
+type builtin struct {
+	Name        string // the name of the builtin, e.g. String. The typed output is named NameOutput.
+	Type        string // the Go type of the builtin's values, e.g. string.
+	VarName     string // the prefix of the variable that holds the builtin's reflect.Type.
+	PromptType  string // the underlying type of the prompt input type, if one is to be declared.
+	PromptValue string // the expression that converts a prompt input `in` into an output, if there is a prompt type.
+	Collections bool   // true if typed arrays and maps of the builtin are to be generated.
+}
+
+func scalar(name, typ, varName string) builtin {
+	return builtin{
+		Name:        name,
+		Type:        typ,
+		VarName:     varName,
+		PromptType:  typ,
+		PromptValue: fmt.Sprintf("newResolvedOutput(%s(in))", typ),
+		Collections: true,
+	}
+}
+
+var builtins = []builtin{
+	{Name: "Archive", Type: "asset.Archive", VarName: "archive"},
+	{Name: "Array", Type: "[]interface{}", VarName: "array", PromptType: "[]interface{}",
+		PromptValue: "Any([]interface{}(in))"},
+	{Name: "Asset", Type: "asset.Asset", VarName: "asset"},
+	scalar("Bool", "bool", "bool"),
+	scalar("Float32", "float32", "float32"),
+	scalar("Float64", "float64", "float64"),
+	// ID and URN are declared by hand alongside the resource types.
+	{Name: "ID", Type: "ID", VarName: "id", PromptValue: "newResolvedOutput(in)", Collections: true},
+	scalar("Int", "int", "int"),
+	scalar("Int8", "int8", "int8"),
+	scalar("Int16", "int16", "int16"),
+	scalar("Int32", "int32", "int32"),
+	scalar("Int64", "int64", "int64"),
+	{Name: "Map", Type: "map[string]interface{}", VarName: "map", PromptType: "map[string]interface{}",
+		PromptValue: "Any(map[string]interface{}(in))"},
+	scalar("String", "string", "string"),
+	scalar("Uint", "uint", "uint"),
+	scalar("Uint8", "uint8", "uint8"),
+	scalar("Uint16", "uint16", "uint16"),
+	scalar("Uint32", "uint32", "uint32"),
+	scalar("Uint64", "uint64", "uint64"),
+	{Name: "URN", Type: "URN", VarName: "urn", PromptValue: "newResolvedOutput(in)", Collections: true},
+}
+
+const header = `// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go generate; DO NOT EDIT.
+
+// nolint: lll
+package pulumi
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/go/pulumi/asset"
+)
+
+func init() {
+{{- range .}}
+	RegisterOutputType({{.Name}}Output{})
+{{- if .Collections}}
+	RegisterOutputType({{.Name}}ArrayOutput{})
+	RegisterOutputType({{.Name}}MapOutput{})
+{{- end}}
+{{- end}}
+}
+`
+
+// nolint: lll
+const outputTemplate = `
+var {{.VarName}}Type = reflect.TypeOf((*{{.Type}})(nil)).Elem()
+
+// {{.Name}}Input is an input that resolves to {{.Type}} values.
+type {{.Name}}Input interface {
+	Input
+
+	To{{.Name}}Output() {{.Name}}Output
+}
+{{if .PromptType}}
+// {{.Name}} is a prompt input of {{.Type}} values.
+type {{.Name}} {{.PromptType}}
+{{end}}
+{{- if .PromptValue}}
+// ElementType returns the element type of this input ({{.Type}}).
+func ({{.Name}}) ElementType() reflect.Type {
+	return {{.VarName}}Type
+}
+
+// To{{.Name}}Output returns an output that resolves to this input's value.
+func (in {{.Name}}) To{{.Name}}Output() {{.Name}}Output {
+	return {{.Name}}Output({{.PromptValue}})
+}
+{{end}}
+// {{.Name}}Output is an Output that is typed to return {{.Type}} values.
+type {{.Name}}Output Output
+
+// ElementType returns the element type of this output ({{.Type}}).
+func ({{.Name}}Output) ElementType() reflect.Type {
+	return {{.VarName}}Type
+}
+
+// To{{.Name}}Output returns this output.
+func (out {{.Name}}Output) To{{.Name}}Output() {{.Name}}Output {
+	return out
+}
+
+// IsSecret returns true if the output's value is secret. This function blocks until the output has been fulfilled.
+func (out {{.Name}}Output) IsSecret() bool {
+	return Output(out).IsSecret()
+}
+
+func (out {{.Name}}Output) getState() *outputState {
+	return out.s
+}
+
+// Apply applies a transformation to the {{.Type}} value when it is available.
+func (out {{.Name}}Output) Apply(applier func({{.Type}}) (interface{}, error)) Output {
+	return out.ApplyWithContext(context.Background(), func(_ context.Context, v {{.Type}}) (interface{}, error) {
+		return applier(v)
+	})
+}
+
+// ApplyWithContext applies a transformation to the {{.Type}} value when it is available.
+func (out {{.Name}}Output) ApplyWithContext(ctx context.Context, applier func(context.Context, {{.Type}}) (interface{}, error)) Output {
+	return Output(out).ApplyWithContext(ctx, func(ctx context.Context, v interface{}) (interface{}, error) {
+		return applier(ctx, convert(v, {{.VarName}}Type).({{.Type}}))
+	})
+}
+
+// ApplyT applies a transformation to the {{.Type}} value when it is available. See Output.ApplyT.
+func (out {{.Name}}Output) ApplyT(applier interface{}) TypedOutput {
+	return applyT(Output(out), {{.VarName}}Type, applier)
+}
+
+// ApplyTWithContext applies a transformation to the {{.Type}} value when it is available. See Output.ApplyTWithContext.
+func (out {{.Name}}Output) ApplyTWithContext(ctx context.Context, applier interface{}) TypedOutput {
+	return applyTWithContext(ctx, Output(out), {{.VarName}}Type, applier)
+}
+{{if .Collections}}
+var {{.VarName}}ArrayType = reflect.TypeOf((*[]{{.Type}})(nil)).Elem()
+
+// {{.Name}}ArrayInput is an input that resolves to []{{.Type}} values.
+type {{.Name}}ArrayInput interface {
+	Input
+
+	To{{.Name}}ArrayOutput() {{.Name}}ArrayOutput
+}
+
+// {{.Name}}Array is a prompt input of {{.Name}}Input values.
+type {{.Name}}Array []{{.Name}}Input
+
+// ElementType returns the element type of this input ([]{{.Type}}).
+func ({{.Name}}Array) ElementType() reflect.Type {
+	return {{.VarName}}ArrayType
+}
+
+// To{{.Name}}ArrayOutput returns an output that resolves to this input's values once they have all resolved.
+func (in {{.Name}}Array) To{{.Name}}ArrayOutput() {{.Name}}ArrayOutput {
+	return {{.Name}}ArrayOutput(Any([]{{.Name}}Input(in)))
+}
+
+// {{.Name}}ArrayOutput is an Output that is typed to return []{{.Type}} values.
+type {{.Name}}ArrayOutput Output
+
+// ElementType returns the element type of this output ([]{{.Type}}).
+func ({{.Name}}ArrayOutput) ElementType() reflect.Type {
+	return {{.VarName}}ArrayType
+}
+
+// To{{.Name}}ArrayOutput returns this output.
+func (out {{.Name}}ArrayOutput) To{{.Name}}ArrayOutput() {{.Name}}ArrayOutput {
+	return out
+}
+
+// IsSecret returns true if the output's value is secret. This function blocks until the output has been fulfilled.
+func (out {{.Name}}ArrayOutput) IsSecret() bool {
+	return Output(out).IsSecret()
+}
+
+func (out {{.Name}}ArrayOutput) getState() *outputState {
+	return out.s
+}
+
+// Apply applies a transformation to the []{{.Type}} value when it is available.
+func (out {{.Name}}ArrayOutput) Apply(applier func([]{{.Type}}) (interface{}, error)) Output {
+	return out.ApplyWithContext(context.Background(), func(_ context.Context, v []{{.Type}}) (interface{}, error) {
+		return applier(v)
+	})
+}
+
+// ApplyWithContext applies a transformation to the []{{.Type}} value when it is available.
+func (out {{.Name}}ArrayOutput) ApplyWithContext(ctx context.Context, applier func(context.Context, []{{.Type}}) (interface{}, error)) Output {
+	return Output(out).ApplyWithContext(ctx, func(ctx context.Context, v interface{}) (interface{}, error) {
+		return applier(ctx, convert(v, {{.VarName}}ArrayType).([]{{.Type}}))
+	})
+}
+
+// ApplyT applies a transformation to the []{{.Type}} value when it is available. See Output.ApplyT.
+func (out {{.Name}}ArrayOutput) ApplyT(applier interface{}) TypedOutput {
+	return applyT(Output(out), {{.VarName}}ArrayType, applier)
+}
+
+// ApplyTWithContext applies a transformation to the []{{.Type}} value when it is available. See
+// Output.ApplyTWithContext.
+func (out {{.Name}}ArrayOutput) ApplyTWithContext(ctx context.Context, applier interface{}) TypedOutput {
+	return applyTWithContext(ctx, Output(out), {{.VarName}}ArrayType, applier)
+}
+
+// Index returns an output that resolves to the element at the given index, or to the zero value if the index is out
+// of range.
+func (out {{.Name}}ArrayOutput) Index(i int) {{.Name}}Output {
+	return {{.Name}}Output(out.Apply(func(arr []{{.Type}}) (interface{}, error) {
+		if i < 0 || i >= len(arr) {
+			var zero {{.Type}}
+			return zero, nil
+		}
+		return arr[i], nil
+	}))
+}
+
+var {{.VarName}}MapType = reflect.TypeOf((*map[string]{{.Type}})(nil)).Elem()
+
+// {{.Name}}MapInput is an input that resolves to map[string]{{.Type}} values.
+type {{.Name}}MapInput interface {
+	Input
+
+	To{{.Name}}MapOutput() {{.Name}}MapOutput
+}
+
+// {{.Name}}Map is a prompt input of {{.Name}}Input values keyed by string.
+type {{.Name}}Map map[string]{{.Name}}Input
+
+// ElementType returns the element type of this input (map[string]{{.Type}}).
+func ({{.Name}}Map) ElementType() reflect.Type {
+	return {{.VarName}}MapType
+}
+
+// To{{.Name}}MapOutput returns an output that resolves to this input's values once they have all resolved.
+func (in {{.Name}}Map) To{{.Name}}MapOutput() {{.Name}}MapOutput {
+	return {{.Name}}MapOutput(Any(map[string]{{.Name}}Input(in)))
+}
+
+// {{.Name}}MapOutput is an Output that is typed to return map[string]{{.Type}} values.
+type {{.Name}}MapOutput Output
+
+// ElementType returns the element type of this output (map[string]{{.Type}}).
+func ({{.Name}}MapOutput) ElementType() reflect.Type {
+	return {{.VarName}}MapType
+}
+
+// To{{.Name}}MapOutput returns this output.
+func (out {{.Name}}MapOutput) To{{.Name}}MapOutput() {{.Name}}MapOutput {
+	return out
+}
+
+// IsSecret returns true if the output's value is secret. This function blocks until the output has been fulfilled.
+func (out {{.Name}}MapOutput) IsSecret() bool {
+	return Output(out).IsSecret()
+}
+
+func (out {{.Name}}MapOutput) getState() *outputState {
+	return out.s
+}
+
+// Apply applies a transformation to the map[string]{{.Type}} value when it is available.
+func (out {{.Name}}MapOutput) Apply(applier func(map[string]{{.Type}}) (interface{}, error)) Output {
+	return out.ApplyWithContext(context.Background(), func(_ context.Context, v map[string]{{.Type}}) (interface{}, error) {
+		return applier(v)
+	})
+}
+
+// ApplyWithContext applies a transformation to the map[string]{{.Type}} value when it is available.
+func (out {{.Name}}MapOutput) ApplyWithContext(ctx context.Context, applier func(context.Context, map[string]{{.Type}}) (interface{}, error)) Output {
+	return Output(out).ApplyWithContext(ctx, func(ctx context.Context, v interface{}) (interface{}, error) {
+		return applier(ctx, convert(v, {{.VarName}}MapType).(map[string]{{.Type}}))
+	})
+}
+
+// ApplyT applies a transformation to the map[string]{{.Type}} value when it is available. See Output.ApplyT.
+func (out {{.Name}}MapOutput) ApplyT(applier interface{}) TypedOutput {
+	return applyT(Output(out), {{.VarName}}MapType, applier)
+}
+
+// ApplyTWithContext applies a transformation to the map[string]{{.Type}} value when it is available. See
+// Output.ApplyTWithContext.
+func (out {{.Name}}MapOutput) ApplyTWithContext(ctx context.Context, applier interface{}) TypedOutput {
+	return applyTWithContext(ctx, Output(out), {{.VarName}}MapType, applier)
+}
+
+// MapIndex returns an output that resolves to the value with the given key, or to the zero value if there is no such
+// key.
+func (out {{.Name}}MapOutput) MapIndex(key string) {{.Name}}Output {
+	return {{.Name}}Output(out.Apply(func(m map[string]{{.Type}}) (interface{}, error) {
+		return m[key], nil
+	}))
+}
+{{end}}`
+
+func main() {
+	headerT := template.Must(template.New("header").Parse(header))
+	outputT := template.Must(template.New("output").Parse(outputTemplate))
+
+	var buf bytes.Buffer
+	if err := headerT.Execute(&buf, builtins); err != nil {
+		fail(err)
+	}
+	for _, b := range builtins {
+		if err := outputT.Execute(&buf, b); err != nil {
+			fail(err)
+		}
+	}
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		fail(err)
+	}
+	if err = ioutil.WriteFile("types_builtins.go", source, 0600); err != nil {
+		fail(err)
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintf(os.Stderr, "error: %v\n", err)
+	os.Exit(1)
+}
@@ -0,0 +1,186 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumi
+
+import (
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"github.com/pulumi/pulumi/pkg/resource"
+	"github.com/pulumi/pulumi/pkg/resource/plugin"
+	pulumirpc "github.com/pulumi/pulumi/sdk/proto/go"
+)
+
+// MockResourceMonitor supplies the results of resource operations for a program run with mocks. This allows a
+// program to be unit tested without a Pulumi engine, a resource provider, or a cloud.
+type MockResourceMonitor interface {
+	// Call returns the result of invoking the provider function identified by token with the given arguments.
+	// provider is the reference to the provider that would service the call, if one was specified.
+	Call(token string, args resource.PropertyMap, provider string) (resource.PropertyMap, error)
+	// NewResource returns the ID and output properties of a resource that is being registered or read. id is the ID
+	// of the resource if it is being read or imported, and is empty otherwise.
+	NewResource(typeToken, name string, inputs resource.PropertyMap,
+		provider, id string) (string, resource.PropertyMap, error)
+}
+
+// WithMocks returns a RunOption that runs a program for the given project and stack against the given mocks rather
+// than against a Pulumi engine.
+func WithMocks(project, stack string, mocks MockResourceMonitor) RunOption {
+	return func(info *RunInfo) {
+		info.Project, info.Stack, info.Mocks = project, stack, mocks
+	}
+}
+
+// mockMonitor is an in-process implementation of the resource monitor that defers to a MockResourceMonitor.
+type mockMonitor struct {
+	project string
+	stack   string
+	mocks   MockResourceMonitor
+}
+
+var _ pulumirpc.ResourceMonitorServer = (*mockMonitor)(nil)
+
+func (m *mockMonitor) newURN(parent, typ, name string) string {
+	return string(newURN(m.stack, m.project, URN(parent), typ, name))
+}
+
+func (m *mockMonitor) SupportsFeature(ctx context.Context,
+	req *pulumirpc.SupportsFeatureRequest) (*pulumirpc.SupportsFeatureResponse, error) {
+
+	return &pulumirpc.SupportsFeatureResponse{HasSupport: req.GetId() == "secrets"}, nil
+}
+
+func (m *mockMonitor) Invoke(ctx context.Context, req *pulumirpc.InvokeRequest) (*pulumirpc.InvokeResponse, error) {
+	args, err := plugin.UnmarshalProperties(req.GetArgs(), plugin.MarshalOptions{KeepSecrets: true})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := m.mocks.Call(req.GetTok(), args, req.GetProvider())
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := plugin.MarshalProperties(result, plugin.MarshalOptions{KeepSecrets: true})
+	if err != nil {
+		return nil, err
+	}
+	return &pulumirpc.InvokeResponse{Return: ret}, nil
+}
+
+func (m *mockMonitor) StreamInvoke(req *pulumirpc.InvokeRequest,
+	server pulumirpc.ResourceMonitor_StreamInvokeServer) error {
+
+	return errors.New("StreamInvoke is not supported by mocks")
+}
+
+func (m *mockMonitor) ReadResource(ctx context.Context,
+	req *pulumirpc.ReadResourceRequest) (*pulumirpc.ReadResourceResponse, error) {
+
+	inputs, err := plugin.UnmarshalProperties(req.GetProperties(), plugin.MarshalOptions{KeepSecrets: true})
+	if err != nil {
+		return nil, err
+	}
+
+	_, state, err := m.mocks.NewResource(req.GetType(), req.GetName(), inputs, req.GetProvider(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	properties, err := plugin.MarshalProperties(state, plugin.MarshalOptions{KeepSecrets: true})
+	if err != nil {
+		return nil, err
+	}
+	return &pulumirpc.ReadResourceResponse{
+		Urn:        m.newURN(req.GetParent(), req.GetType(), req.GetName()),
+		Properties: properties,
+	}, nil
+}
+
+func (m *mockMonitor) RegisterResource(ctx context.Context,
+	req *pulumirpc.RegisterResourceRequest) (*pulumirpc.RegisterResourceResponse, error) {
+
+	// The root stack resource is an implementation detail of the program; don't bother the mocks with it.
+	if req.GetType() == string(resource.RootStackType) {
+		return &pulumirpc.RegisterResourceResponse{
+			Urn: m.newURN(req.GetParent(), req.GetType(), req.GetName()),
+		}, nil
+	}
+
+	inputs, err := plugin.UnmarshalProperties(req.GetObject(), plugin.MarshalOptions{KeepSecrets: true})
+	if err != nil {
+		return nil, err
+	}
+
+	id, state, err := m.mocks.NewResource(req.GetType(), req.GetName(), inputs, req.GetProvider(), req.GetImportId())
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := plugin.MarshalProperties(state, plugin.MarshalOptions{KeepSecrets: true})
+	if err != nil {
+		return nil, err
+	}
+	return &pulumirpc.RegisterResourceResponse{
+		Urn:    m.newURN(req.GetParent(), req.GetType(), req.GetName()),
+		Id:     id,
+		Object: object,
+	}, nil
+}
+
+func (m *mockMonitor) RegisterResourceOutputs(ctx context.Context,
+	req *pulumirpc.RegisterResourceOutputsRequest) (*empty.Empty, error) {
+
+	return &empty.Empty{}, nil
+}
+
+// monitorServerClient adapts a resource monitor server so that it may be called directly, in-process, as a client.
+type monitorServerClient struct {
+	server pulumirpc.ResourceMonitorServer
+}
+
+var _ pulumirpc.ResourceMonitorClient = (*monitorServerClient)(nil)
+
+func (c *monitorServerClient) SupportsFeature(ctx context.Context, req *pulumirpc.SupportsFeatureRequest,
+	opts ...grpc.CallOption) (*pulumirpc.SupportsFeatureResponse, error) {
+	return c.server.SupportsFeature(ctx, req)
+}
+
+func (c *monitorServerClient) Invoke(ctx context.Context, req *pulumirpc.InvokeRequest,
+	opts ...grpc.CallOption) (*pulumirpc.InvokeResponse, error) {
+	return c.server.Invoke(ctx, req)
+}
+
+func (c *monitorServerClient) StreamInvoke(ctx context.Context, req *pulumirpc.InvokeRequest,
+	opts ...grpc.CallOption) (pulumirpc.ResourceMonitor_StreamInvokeClient, error) {
+	return nil, errors.New("StreamInvoke is not supported in-process")
+}
+
+func (c *monitorServerClient) ReadResource(ctx context.Context, req *pulumirpc.ReadResourceRequest,
+	opts ...grpc.CallOption) (*pulumirpc.ReadResourceResponse, error) {
+	return c.server.ReadResource(ctx, req)
+}
+
+func (c *monitorServerClient) RegisterResource(ctx context.Context, req *pulumirpc.RegisterResourceRequest,
+	opts ...grpc.CallOption) (*pulumirpc.RegisterResourceResponse, error) {
+	return c.server.RegisterResource(ctx, req)
+}
+
+func (c *monitorServerClient) RegisterResourceOutputs(ctx context.Context,
+	req *pulumirpc.RegisterResourceOutputsRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return c.server.RegisterResourceOutputs(ctx, req)
+}
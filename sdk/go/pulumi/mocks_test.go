@@ -0,0 +1,113 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumi
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+
+	"github.com/pulumi/pulumi/pkg/resource"
+)
+
+type testMonitor struct {
+	m         sync.Mutex
+	resources map[string]resource.PropertyMap
+}
+
+func (t *testMonitor) Call(token string, args resource.PropertyMap, provider string) (resource.PropertyMap, error) {
+	if token != "test:index:getAmi" {
+		return nil, errors.Errorf("unknown function %s", token)
+	}
+	return resource.PropertyMap{
+		"id": resource.NewStringProperty("ami-" + args["region"].StringValue()),
+	}, nil
+}
+
+func (t *testMonitor) NewResource(typeToken, name string, inputs resource.PropertyMap,
+	provider, id string) (string, resource.PropertyMap, error) {
+
+	t.m.Lock()
+	defer t.m.Unlock()
+	t.resources[name] = inputs
+
+	state := inputs.Copy()
+	state["arn"] = resource.NewStringProperty("arn:" + name)
+	if id == "" {
+		id = name + "_id"
+	}
+	return id, state, nil
+}
+
+func TestRunWithMocks(t *testing.T) {
+	mocks := &testMonitor{resources: make(map[string]resource.PropertyMap)}
+
+	var urn URN
+	var id ID
+	var arn, password interface{}
+	var secret bool
+	err := RunErr(func(ctx *Context) error {
+		ami, err := ctx.Invoke("test:index:getAmi", map[string]interface{}{"region": "us-west-2"})
+		if err != nil {
+			return err
+		}
+
+		res := &ResourceState{}
+		err = ctx.RegisterResource("test:index:Instance", "web", true, map[string]interface{}{
+			"ami":      ami["id"],
+			"arn":      nil,
+			"password": ToSecret("hunter2"),
+		}, res)
+		if err != nil {
+			return err
+		}
+
+		if urn, _, err = res.URN().await(context.Background()); err != nil {
+			return err
+		}
+		if id, _, err = res.ID().await(context.Background()); err != nil {
+			return err
+		}
+		if arn, _, _, err = res.State["arn"].s.await(context.Background()); err != nil {
+			return err
+		}
+		password, _, secret, err = res.State["password"].s.await(context.Background())
+		return err
+	}, WithMocks("project", "stack", mocks))
+	assert.NoError(t, err)
+
+	assert.Equal(t, URN("urn:pulumi:stack::project::test:index:Instance::web"), urn)
+	assert.Equal(t, ID("web_id"), id)
+	assert.Equal(t, "arn:web", arn)
+	assert.Equal(t, "hunter2", password)
+	assert.True(t, secret)
+
+	inputs, ok := mocks.resources["web"]
+	if assert.True(t, ok) {
+		assert.Equal(t, "ami-us-west-2", inputs["ami"].StringValue())
+		assert.True(t, inputs["password"].IsSecret())
+	}
+}
+
+func TestRunWithMocksFailure(t *testing.T) {
+	err := RunErr(func(ctx *Context) error {
+		_, err := ctx.Invoke("test:index:unknown", nil)
+		return err
+	}, WithMocks("project", "stack", &testMonitor{resources: make(map[string]resource.PropertyMap)}))
+	assert.Error(t, err)
+}
@@ -23,7 +23,7 @@ import (
 )
 
 func assertApplied(t *testing.T, o Output) {
-	_, known, err := o.s.await(context.Background())
+	_, known, _, err := o.s.await(context.Background())
 	assert.True(t, known)
 	assert.Nil(t, err)
 }
@@ -35,7 +35,7 @@ func TestBasicOutputs(t *testing.T) {
 		go func() {
 			resolve(42)
 		}()
-		v, known, err := out.s.await(context.Background())
+		v, known, _, err := out.s.await(context.Background())
 		assert.Nil(t, err)
 		assert.True(t, known)
 		assert.NotNil(t, v)
@@ -46,7 +46,7 @@ func TestBasicOutputs(t *testing.T) {
 		go func() {
 			reject(errors.New("boom"))
 		}()
-		v, _, err := out.s.await(context.Background())
+		v, _, _, err := out.s.await(context.Background())
 		assert.NotNil(t, err)
 		assert.Nil(t, v)
 	}
@@ -156,7 +156,7 @@ func TestResolveOutputToOutput(t *testing.T) {
 			resolve(other)
 			go func() { rejectOther(errors.New("boom")) }()
 		}()
-		v, _, err := out.s.await(context.Background())
+		v, _, _, err := out.s.await(context.Background())
 		assert.NotNil(t, err)
 		assert.Nil(t, v)
 	}
@@ -173,7 +173,7 @@ func TestOutputApply(t *testing.T) {
 			ranApp = true
 			return v + 1, nil
 		})
-		v, known, err := app.s.await(context.Background())
+		v, known, _, err := app.s.await(context.Background())
 		assert.True(t, ranApp)
 		assert.Nil(t, err)
 		assert.True(t, known)
@@ -182,14 +182,14 @@ func TestOutputApply(t *testing.T) {
 	// Test that resolved, but unknown outputs, skip the running of applies.
 	{
 		out := newOutput()
-		go func() { out.s.fulfill(42, false, nil) }()
+		go func() { out.s.fulfill(42, false, false, nil) }()
 		var ranApp bool
 		b := IntOutput(out)
 		app := b.Apply(func(v int) (interface{}, error) {
 			ranApp = true
 			return v + 1, nil
 		})
-		_, known, err := app.s.await(context.Background())
+		_, known, _, err := app.s.await(context.Background())
 		assert.False(t, ranApp)
 		assert.Nil(t, err)
 		assert.False(t, known)
@@ -204,7 +204,7 @@ func TestOutputApply(t *testing.T) {
 			ranApp = true
 			return v + 1, nil
 		})
-		v, _, err := app.s.await(context.Background())
+		v, _, _, err := app.s.await(context.Background())
 		assert.False(t, ranApp)
 		assert.NotNil(t, err)
 		assert.Nil(t, v)
@@ -221,7 +221,7 @@ func TestOutputApply(t *testing.T) {
 			ranApp = true
 			return other, nil
 		})
-		v, known, err := app.s.await(context.Background())
+		v, known, _, err := app.s.await(context.Background())
 		assert.True(t, ranApp)
 		assert.Nil(t, err)
 		assert.True(t, known)
@@ -233,7 +233,7 @@ func TestOutputApply(t *testing.T) {
 			ranApp = true
 			return IntOutput(other), nil
 		})
-		v, known, err = app.s.await(context.Background())
+		v, known, _, err = app.s.await(context.Background())
 		assert.True(t, ranApp)
 		assert.Nil(t, err)
 		assert.True(t, known)
@@ -251,7 +251,7 @@ func TestOutputApply(t *testing.T) {
 			ranApp = true
 			return other, nil
 		})
-		v, _, err := app.s.await(context.Background())
+		v, _, _, err := app.s.await(context.Background())
 		assert.True(t, ranApp)
 		assert.NotNil(t, err)
 		assert.Nil(t, v)
@@ -262,9 +262,66 @@ func TestOutputApply(t *testing.T) {
 			ranApp = true
 			return IntOutput(other), nil
 		})
-		v, _, err = app.s.await(context.Background())
+		v, _, _, err = app.s.await(context.Background())
 		assert.True(t, ranApp)
 		assert.NotNil(t, err)
 		assert.Nil(t, v)
 	}
 }
+
+func TestSecretOutputs(t *testing.T) {
+	// Test that plain values can be made secret.
+	{
+		out := ToSecret(42)
+		v, known, secret, err := out.s.await(context.Background())
+		assert.Nil(t, err)
+		assert.True(t, known)
+		assert.True(t, secret)
+		assert.Equal(t, 42, v)
+		assert.True(t, out.IsSecret())
+	}
+	// Test that outputs can be made secret, and that ordinary outputs are not.
+	{
+		out, resolve, _ := NewOutput()
+		go func() { resolve("shh") }()
+		secretOut := ToSecret(out)
+		v, known, secret, err := secretOut.s.await(context.Background())
+		assert.Nil(t, err)
+		assert.True(t, known)
+		assert.True(t, secret)
+		assert.Equal(t, "shh", v)
+		assert.False(t, out.IsSecret())
+	}
+	// Test that secretness flows through applies.
+	{
+		app := IntOutput(ToSecret(42)).Apply(func(v int) (interface{}, error) {
+			return v + 1, nil
+		})
+		v, known, secret, err := app.s.await(context.Background())
+		assert.Nil(t, err)
+		assert.True(t, known)
+		assert.True(t, secret)
+		assert.Equal(t, 43, v)
+	}
+	// Test that an apply that returns a secret output is secret.
+	{
+		out, resolve, _ := NewOutput()
+		go func() { resolve(42) }()
+		app := IntOutput(out).Apply(func(v int) (interface{}, error) {
+			return ToSecret(v + 1), nil
+		})
+		assert.True(t, app.IsSecret())
+	}
+	// Test that unknown secret outputs remain secret.
+	{
+		out := newOutput()
+		go func() { out.s.fulfill(nil, false, true, nil) }()
+		app := out.Apply(func(v interface{}) (interface{}, error) {
+			return v, nil
+		})
+		_, known, secret, err := app.s.await(context.Background())
+		assert.Nil(t, err)
+		assert.False(t, known)
+		assert.True(t, secret)
+	}
+}
@@ -73,8 +73,52 @@ type ResourceOpt struct {
 	CustomTimeouts *CustomTimeouts
 	// Ignore changes to any of the specified properties.
 	IgnoreChanges []string
+	// ReplaceOnChanges is an optional list of property paths that, when changed, force the resource to be replaced
+	// rather than updated.
+	ReplaceOnChanges []string
+	// RetainOnDelete, when set to true, causes the resource to be removed from the stack's state rather than deleted
+	// by its provider when it is no longer part of the program.
+	RetainOnDelete bool
+	// AdditionalSecretOutputs is an optional list of output properties to mark as secret, in addition to any the
+	// resource's provider marks as secret.
+	AdditionalSecretOutputs []string
+	// Aliases is an optional list of identifiers used to find and use existing resources. Aliases allow a resource to
+	// be renamed or re-parented without being replaced.
+	Aliases []Alias
+	// ResourceTransformations is an optional list of transformations to apply to this resource and to all of its
+	// children, before any transformations registered by its parents.
+	ResourceTransformations []ResourceTransformation
 }
 
+// ResourceTransformationArgs is the argument bag passed to a resource transformation.
+type ResourceTransformationArgs struct {
+	// Type is the type token of the resource.
+	Type string
+	// Name is the name of the resource.
+	Name string
+	// Props are the original properties passed to the resource's constructor.
+	Props map[string]interface{}
+	// Opts are the original options passed to the resource's constructor.
+	Opts []ResourceOpt
+}
+
+// ResourceTransformationResult is the result that must be returned by a resource transformation callback.  It
+// includes new values to use for the props and opts of the resource in place of the originally provided values.
+type ResourceTransformationResult struct {
+	// Props are the new properties to use in place of the original props.
+	Props map[string]interface{}
+	// Opts are the new resource options to use in place of the original opts.
+	Opts []ResourceOpt
+}
+
+// ResourceTransformation is the callback signature for ResourceOpt.ResourceTransformations and
+// Context.RegisterStackTransformation.  A transformation is passed the same set of inputs provided to the resource
+// constructor, and can optionally return back alternate values for the props and/or opts prior to the resource
+// actually being created.  The effect will be as though those props and opts were passed in place of the original
+// call to the resource constructor.  If the transformation returns nil, this indicates that the resource will not be
+// transformed.
+type ResourceTransformation func(args *ResourceTransformationArgs) *ResourceTransformationResult
+
 // InvokeOpt contains optional settings that control an invoke's behavior.
 type InvokeOpt struct {
 	// Parent is an optional parent resource to use for default provider options for this invoke.
@@ -87,4 +131,5 @@ type CustomTimeouts struct {
 	Create string
 	Update string
 	Delete string
+	Read   string
 }
@@ -0,0 +1,246 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumi
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"github.com/pulumi/pulumi/pkg/resource"
+	pulumirpc "github.com/pulumi/pulumi/sdk/proto/go"
+)
+
+// registrationRecorder records the requests made to register each resource.
+type registrationRecorder struct {
+	pulumirpc.ResourceMonitorClient
+
+	m        sync.Mutex
+	requests map[string]*pulumirpc.RegisterResourceRequest
+}
+
+func (r *registrationRecorder) RegisterResource(ctx context.Context, req *pulumirpc.RegisterResourceRequest,
+	opts ...grpc.CallOption) (*pulumirpc.RegisterResourceResponse, error) {
+
+	r.m.Lock()
+	r.requests[req.GetName()] = req
+	r.m.Unlock()
+	return r.ResourceMonitorClient.RegisterResource(ctx, req, opts...)
+}
+
+func runRecorded(t *testing.T, body RunFunc) map[string]*pulumirpc.RegisterResourceRequest {
+	mocks := &componentMonitor{providers: make(map[string]string)}
+	ctx, err := NewContext(context.Background(), RunInfo{Project: "project", Stack: "stack", Mocks: mocks})
+	assert.NoError(t, err)
+	recorder := &registrationRecorder{
+		ResourceMonitorClient: ctx.monitor,
+		requests:              make(map[string]*pulumirpc.RegisterResourceRequest),
+	}
+	ctx.monitor = recorder
+
+	assert.NoError(t, RunWithContext(ctx, body))
+	return recorder.requests
+}
+
+func TestAliases(t *testing.T) {
+	requests := runRecorded(t, func(ctx *Context) error {
+		comp := &testComponent{}
+		err := ctx.RegisterComponentResource("test:index:Component", "comp-new", comp, ResourceOpt{
+			Aliases: []Alias{{Name: "comp-old"}},
+		})
+		if err != nil {
+			return err
+		}
+
+		return ctx.RegisterResource("test:index:Child", "comp-new-child", true, nil, &ResourceState{}, ResourceOpt{
+			Parent: comp,
+			Aliases: []Alias{
+				{URN: "urn:pulumi:stack::project::test:index:Old::old"},
+				{NoParent: true},
+				{Type: "test:index:OldChild", Project: "other"},
+			},
+		})
+	})
+
+	assert.Equal(t, []string{
+		"urn:pulumi:stack::project::test:index:Component::comp-old",
+	}, requests["comp-new"].GetAliases())
+	assert.Equal(t, []string{
+		"urn:pulumi:stack::project::test:index:Old::old",
+		"urn:pulumi:stack::project::test:index:Child::comp-new-child",
+		"urn:pulumi:stack::other::test:index:Component$test:index:OldChild::comp-new-child",
+		"urn:pulumi:stack::project::test:index:Component$test:index:Child::comp-old-child",
+	}, requests["comp-new-child"].GetAliases())
+}
+
+func TestTransformations(t *testing.T) {
+	var order []string
+	requests := runRecorded(t, func(ctx *Context) error {
+		ctx.RegisterStackTransformation(func(args *ResourceTransformationArgs) *ResourceTransformationResult {
+			order = append(order, "stack:"+args.Name)
+			if args.Type != "test:index:Child" {
+				return nil
+			}
+			props := map[string]interface{}{"stack": true}
+			for k, v := range args.Props {
+				props[k] = v
+			}
+			return &ResourceTransformationResult{Props: props, Opts: args.Opts}
+		})
+
+		comp := &testComponent{}
+		err := ctx.RegisterComponentResource("test:index:Component", "comp", comp, ResourceOpt{
+			ResourceTransformations: []ResourceTransformation{
+				func(args *ResourceTransformationArgs) *ResourceTransformationResult {
+					order = append(order, "comp:"+args.Name)
+					return &ResourceTransformationResult{
+						Props: args.Props,
+						Opts:  append(args.Opts, ResourceOpt{Protect: true}),
+					}
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		child := &ResourceState{}
+		err = ctx.RegisterResource("test:index:Child", "child", true, map[string]interface{}{
+			"name": "child",
+		}, child, ResourceOpt{
+			Parent: comp,
+			ResourceTransformations: []ResourceTransformation{
+				func(args *ResourceTransformationArgs) *ResourceTransformationResult {
+					order = append(order, "child:"+args.Name)
+					return nil
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		// The transformed props determine the resource's outputs.
+		_, ok := child.State["stack"]
+		assert.True(t, ok)
+		return nil
+	})
+
+	assert.Equal(t, []string{"comp:comp", "stack:comp", "child:child", "comp:child", "stack:child"}, order)
+	assert.True(t, requests["comp"].GetProtect())
+	assert.True(t, requests["child"].GetProtect())
+
+	props := requests["child"].GetObject().GetFields()
+	assert.True(t, props["stack"].GetBoolValue())
+	assert.Equal(t, "child", props["name"].GetStringValue())
+}
+
+func TestStackTransformationAfterComponent(t *testing.T) {
+	var transformed []string
+	requests := runRecorded(t, func(ctx *Context) error {
+		comp := &testComponent{}
+		if err := ctx.RegisterComponentResource("test:index:Component", "comp", comp); err != nil {
+			return err
+		}
+		inner := &testComponent{}
+		err := ctx.RegisterComponentResource("test:index:Component", "inner", inner, ResourceOpt{Parent: comp})
+		if err != nil {
+			return err
+		}
+
+		// A stack transformation registered after the components were created still applies to their children.
+		ctx.RegisterStackTransformation(func(args *ResourceTransformationArgs) *ResourceTransformationResult {
+			transformed = append(transformed, args.Name)
+			return &ResourceTransformationResult{Props: args.Props, Opts: append(args.Opts, ResourceOpt{Protect: true})}
+		})
+
+		err = ctx.RegisterResource("test:index:Child", "comp-child", true, nil, &ResourceState{},
+			ResourceOpt{Parent: comp})
+		if err != nil {
+			return err
+		}
+		return ctx.RegisterResource("test:index:Child", "inner-child", true, nil, &ResourceState{},
+			ResourceOpt{Parent: inner})
+	})
+
+	assert.Equal(t, []string{"comp-child", "inner-child"}, transformed)
+	assert.False(t, requests["comp"].GetProtect())
+	assert.False(t, requests["inner"].GetProtect())
+	assert.True(t, requests["comp-child"].GetProtect())
+	assert.True(t, requests["inner-child"].GetProtect())
+}
+
+type testInstance struct {
+	ResourceState
+
+	Ami   StringOutput `pulumi:"ami"`
+	Arn   StringOutput `pulumi:"arn"`
+	Size  IntOutput    `pulumi:"size"`
+	Other Output
+}
+
+func TestRegisterResourceStruct(t *testing.T) {
+	mocks := &testMonitor{resources: make(map[string]resource.PropertyMap)}
+
+	values := make(map[string]interface{})
+	err := RunErr(func(ctx *Context) error {
+		inst := &testInstance{}
+		err := ctx.RegisterResource("test:index:Instance", "web", true, map[string]interface{}{
+			"ami":  "ami-1234",
+			"size": 2,
+		}, inst)
+		if err != nil {
+			return err
+		}
+
+		outputs := map[string]TypedOutput{
+			"ami":  inst.Ami,
+			"arn":  inst.Arn,
+			"size": inst.Size.ApplyT(func(v int) int { return v * 2 }),
+		}
+		for name, out := range outputs {
+			v, _, _, err := out.getState().await(context.Background())
+			if err != nil {
+				return err
+			}
+			values[name] = v
+		}
+
+		// The resource's state includes its tagged outputs as well as its inputs.
+		_, ok := inst.State["arn"]
+		assert.True(t, ok)
+		assert.Nil(t, inst.Other.s)
+		return nil
+	}, WithMocks("project", "stack", mocks))
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{"ami": "ami-1234", "arn": "arn:web", "size": 4}, values)
+}
+
+func TestRegisterResourceBadStruct(t *testing.T) {
+	type badResource struct {
+		ResourceState
+
+		Name string `pulumi:"name"`
+	}
+
+	err := RunErr(func(ctx *Context) error {
+		return ctx.RegisterResource("test:index:Bad", "bad", true, nil, &badResource{})
+	}, WithMocks("project", "stack", &testMonitor{resources: make(map[string]resource.PropertyMap)}))
+	assert.Error(t, err)
+}
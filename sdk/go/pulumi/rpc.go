@@ -15,7 +15,9 @@
 package pulumi
 
 import (
+	"fmt"
 	"reflect"
+	"strings"
 
 	structpb "github.com/golang/protobuf/ptypes/struct"
 	"github.com/pkg/errors"
@@ -194,6 +196,29 @@ func marshalInput(v interface{}) (interface{}, []Resource, error) {
 			return obj, deps, nil
 		case reflect.String:
 			return rv.String(), nil, nil
+		case reflect.Struct:
+			// For structs, marshal each field that has a `pulumi` tag into an object, omitting nil fields.
+			obj := make(map[string]interface{})
+			var deps []Resource
+			typ := rv.Type()
+			for i := 0; i < typ.NumField(); i++ {
+				name := fieldName(typ.Field(i))
+				if name == "" {
+					continue
+				}
+				field := rv.Field(i)
+				if isNilValue(field) {
+					continue
+				}
+				fv, d, err := marshalInput(field.Interface())
+				if err != nil {
+					return nil, nil, errors.Wrapf(err, "marshaling field %s", typ.Field(i).Name)
+				}
+
+				obj[name] = fv
+				deps = append(deps, d...)
+			}
+			return obj, deps, nil
 		default:
 			return nil, nil, errors.Errorf("unrecognized input property type: %v (%T)", v, v)
 		}
@@ -202,19 +227,56 @@ func marshalInput(v interface{}) (interface{}, []Resource, error) {
 
 }
 
+// fieldName returns the property name of the given struct field, as given by its `pulumi` tag. Fields that are not
+// exported or that have no tag have no property name.
+func fieldName(field reflect.StructField) string {
+	name, _ := fieldTag(field)
+	return name
+}
+
+// fieldTag returns the property name of the given struct field and whether or not the property is optional, as given by
+// its `pulumi:"name[,optional]"` tag.
+func fieldTag(field reflect.StructField) (string, bool) {
+	if field.PkgPath != "" {
+		return "", false
+	}
+	tag := field.Tag.Get("pulumi")
+	comma := strings.IndexByte(tag, ',')
+	if comma == -1 {
+		return tag, false
+	}
+	return tag[:comma], strings.Contains(tag[comma:], ",optional")
+}
+
+// isNilValue returns true if the given value is a nil pointer, interface, map, or slice.
+func isNilValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
+
 func marshalInputOutput(out Output) (interface{}, []Resource, error) {
 	// Await the value and return its raw value.
-	ov, known, err := out.s.await(context.TODO())
+	ov, known, secret, err := out.s.await(context.TODO())
 	if err != nil {
 		return nil, nil, err
 	}
 
-	// If the value is known, marshal it.
+	// If the value is known, marshal it, wrapping it in a secret if necessary.
 	if known {
 		e, d, merr := marshalInput(ov)
 		if merr != nil {
 			return nil, nil, merr
 		}
+		if secret {
+			e = map[string]interface{}{
+				rpcTokenSpecialSigKey: rpcTokenSpecialSecretSig,
+				"value":               e,
+			}
+		}
 		return e, append(out.s.dependencies(), d...), nil
 	}
 
@@ -222,29 +284,48 @@ func marshalInputOutput(out Output) (interface{}, []Resource, error) {
 	return rpcTokenUnknownValue, out.s.dependencies(), nil
 }
 
-// unmarshalOutputs unmarshals all the outputs into a simple map.
-func unmarshalOutputs(outs *structpb.Struct) (map[string]interface{}, error) {
-	outprops, err := plugin.UnmarshalProperties(outs, plugin.MarshalOptions{})
+// unmarshalOutputs unmarshals all the outputs into a simple map, along with the set of outputs that contain secrets.
+func unmarshalOutputs(outs *structpb.Struct) (map[string]interface{}, map[string]bool, error) {
+	outprops, err := plugin.UnmarshalProperties(outs, plugin.MarshalOptions{KeepSecrets: true})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	result := make(map[string]interface{})
-	for k, v := range outprops.Mappable() {
-		result[k], err = unmarshalOutput(v)
+	// Secrets are flattened back into their signature-tagged form so that unmarshalOutput can recognize them.
+	mappable := outprops.MapRepl(nil, secretRepl)
+
+	result, secrets := make(map[string]interface{}), make(map[string]bool)
+	for k, v := range mappable {
+		var secret bool
+		result[k], secret, err = unmarshalOutput(v)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+		if secret {
+			secrets[k] = true
 		}
 	}
-	return result, nil
+	return result, secrets, nil
+}
+
+// secretRepl replaces secret property values with their signature-tagged map representation.
+func secretRepl(v resource.PropertyValue) (interface{}, bool) {
+	if !v.IsSecret() {
+		return nil, false
+	}
+	return map[string]interface{}{
+		rpcTokenSpecialSigKey: rpcTokenSpecialSecretSig,
+		"value":               v.SecretValue().Element.MapRepl(nil, secretRepl),
+	}, true
 }
 
 // unmarshalOutput unmarshals a single output variable into its runtime representation.  For the most part, this just
-// returns the raw value.  In a small number of cases, we need to change a type.
-func unmarshalOutput(v interface{}) (interface{}, error) {
+// returns the raw value.  In a small number of cases, we need to change a type.  Secrets are unwrapped; if the value
+// contains any secrets, the second result is true.
+func unmarshalOutput(v interface{}) (interface{}, bool, error) {
 	// Check for nils and unknowns.
 	if v == nil || v == rpcTokenUnknownValue {
-		return nil, nil
+		return nil, false, nil
 	}
 
 	// In the case of assets and archives, turn these into real asset and archive structures.
@@ -253,34 +334,42 @@ func unmarshalOutput(v interface{}) (interface{}, error) {
 			switch sig {
 			case rpcTokenSpecialAssetSig:
 				if path := m["path"]; path != nil {
-					return asset.NewFileAsset(cast.ToString(path)), nil
+					return asset.NewFileAsset(cast.ToString(path)), false, nil
 				} else if text := m["text"]; text != nil {
-					return asset.NewStringAsset(cast.ToString(text)), nil
+					return asset.NewStringAsset(cast.ToString(text)), false, nil
 				} else if uri := m["uri"]; uri != nil {
-					return asset.NewRemoteAsset(cast.ToString(uri)), nil
+					return asset.NewRemoteAsset(cast.ToString(uri)), false, nil
 				}
-				return nil, errors.New("expected asset to be one of File, String, or Remote; got none")
+				return nil, false, errors.New("expected asset to be one of File, String, or Remote; got none")
 			case rpcTokenSpecialArchiveSig:
 				if assets := m["assets"]; assets != nil {
 					as := make(map[string]interface{})
 					for k, v := range assets.(map[string]interface{}) {
-						a, err := unmarshalOutput(v)
+						a, _, err := unmarshalOutput(v)
 						if err != nil {
-							return nil, err
+							return nil, false, err
 						}
 						as[k] = a
 					}
-					return asset.NewAssetArchive(as), nil
+					return asset.NewAssetArchive(as), false, nil
 				} else if path := m["path"]; path != nil {
-					return asset.NewFileArchive(cast.ToString(path)), nil
+					return asset.NewFileArchive(cast.ToString(path)), false, nil
 				} else if uri := m["uri"]; uri != nil {
-					return asset.NewRemoteArchive(cast.ToString(uri)), nil
+					return asset.NewRemoteArchive(cast.ToString(uri)), false, nil
 				}
-				return nil, errors.New("expected asset to be one of File, String, or Remote; got none")
+				return nil, false, errors.New("expected asset to be one of File, String, or Remote; got none")
 			case rpcTokenSpecialSecretSig:
-				return nil, errors.New("this version of the Pulumi SDK does not support first-class secrets")
+				value, ok := m["value"]
+				if !ok {
+					return nil, false, errors.New("malformed secret value: missing value")
+				}
+				element, _, err := unmarshalOutput(value)
+				if err != nil {
+					return nil, false, err
+				}
+				return element, true, nil
 			default:
-				return nil, errors.Errorf("unrecognized signature '%v' in output value", sig)
+				return nil, false, errors.Errorf("unrecognized signature '%v' in output value", sig)
 			}
 		}
 	}
@@ -291,33 +380,228 @@ func unmarshalOutput(v interface{}) (interface{}, error) {
 	case reflect.Array, reflect.Slice:
 		// If an array or a slice, create a new array by recursing into elements.
 		var arr []interface{}
+		secret := false
 		for i := 0; i < rv.Len(); i++ {
 			elem := rv.Index(i)
-			e, err := unmarshalOutput(elem.Interface())
+			e, esecret, err := unmarshalOutput(elem.Interface())
 			if err != nil {
-				return nil, err
+				return nil, false, err
 			}
 			arr = append(arr, e)
+			secret = secret || esecret
 		}
-		return arr, nil
+		return arr, secret, nil
 	case reflect.Map:
 		// For maps, only support string-based keys, and recurse into the values.
 		obj := make(map[string]interface{})
+		secret := false
 		for _, key := range rv.MapKeys() {
 			k, ok := key.Interface().(string)
 			if !ok {
-				return nil, errors.Errorf("expected map keys to be strings; got %v", reflect.TypeOf(key.Interface()))
+				return nil, false,
+					errors.Errorf("expected map keys to be strings; got %v", reflect.TypeOf(key.Interface()))
 			}
 			value := rv.MapIndex(key)
-			mv, err := unmarshalOutput(value)
+			mv, vsecret, err := unmarshalOutput(value.Interface())
 			if err != nil {
-				return nil, err
+				return nil, false, err
 			}
 
 			obj[k] = mv
+			secret = secret || vsecret
 		}
-		return obj, nil
+		return obj, secret, nil
 	}
 
-	return v, nil
+	return v, false, nil
+}
+
+// unmarshalOutputValue decodes the given property into a resolved output of dest's type, which is Output or a typed
+// output. The output is secret if the property contains any secrets, and unknown if it contains any unknowns.
+func unmarshalOutputValue(path string, v resource.PropertyValue, dest reflect.Value) error {
+	elementType := anyType
+	if in, ok := dest.Interface().(Input); ok {
+		elementType = in.ElementType()
+	}
+
+	out, known, secret := newOutput(), !v.ContainsUnknowns(), v.ContainsSecrets()
+	elem := reflect.New(elementType).Elem()
+	if known {
+		if err := unmarshalValue(path, removeSecrets(v), elem); err != nil {
+			return err
+		}
+	}
+	out.s.resolve(elem.Interface(), known, secret)
+	dest.Set(reflect.ValueOf(out).Convert(dest.Type()))
+	return nil
+}
+
+// removeSecrets returns the given property with each secret replaced by its underlying value.
+func removeSecrets(v resource.PropertyValue) resource.PropertyValue {
+	switch {
+	case v.IsSecret():
+		return removeSecrets(v.SecretValue().Element)
+	case v.IsArray():
+		arr := make([]resource.PropertyValue, len(v.ArrayValue()))
+		for i, e := range v.ArrayValue() {
+			arr[i] = removeSecrets(e)
+		}
+		return resource.NewArrayProperty(arr)
+	case v.IsObject():
+		obj := make(resource.PropertyMap, len(v.ObjectValue()))
+		for k, e := range v.ObjectValue() {
+			obj[k] = removeSecrets(e)
+		}
+		return resource.NewObjectProperty(obj)
+	default:
+		return v
+	}
+}
+
+// unmarshalStruct decodes the given properties into the struct pointed to by result. Each of the struct's fields that
+// has a `pulumi:"name"` tag is decoded from the property of that name; it is an error for a field to be missing unless
+// the tag includes the "optional" flag. Secret and unknown properties may only be decoded into outputs, as they can't
+// be represented by plain Go values.
+func unmarshalStruct(props resource.PropertyMap, result interface{}) error {
+	rv := reflect.ValueOf(result)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.Errorf("result must be a non-nil pointer to a struct, not a %T", result)
+	}
+	return unmarshalStructValue("", props, rv.Elem())
+}
+
+func unmarshalStructValue(path string, props resource.PropertyMap, dest reflect.Value) error {
+	typ := dest.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		name, optional := fieldTag(typ.Field(i))
+		if name == "" {
+			continue
+		}
+
+		fieldPath := name
+		if path != "" {
+			fieldPath = path + "." + name
+		}
+
+		v, has := props[resource.PropertyKey(name)]
+		if !has || v.IsNull() {
+			if !optional {
+				return errors.Errorf("%s: missing required property", fieldPath)
+			}
+			continue
+		}
+		if err := unmarshalValue(fieldPath, v, dest.Field(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func unmarshalValue(path string, v resource.PropertyValue, dest reflect.Value) error {
+	if dest.Kind() == reflect.Struct && outputType.ConvertibleTo(dest.Type()) {
+		return unmarshalOutputValue(path, v, dest)
+	}
+
+	switch {
+	case v.IsComputed() || v.IsOutput():
+		return errors.Errorf("%s: unexpected unknown value", path)
+	case v.IsSecret():
+		return errors.Errorf("%s: unexpected secret value", path)
+	case v.IsNull():
+		dest.Set(reflect.Zero(dest.Type()))
+		return nil
+	}
+
+	typeError := func() error {
+		return errors.Errorf("%s: expected a value of type %v, got %s", path, dest.Type(), v.TypeString())
+	}
+
+	switch dest.Kind() {
+	case reflect.Ptr:
+		elem := reflect.New(dest.Type().Elem())
+		if err := unmarshalValue(path, v, elem.Elem()); err != nil {
+			return err
+		}
+		dest.Set(elem)
+	case reflect.Interface:
+		if dest.NumMethod() != 0 {
+			return typeError()
+		}
+		if v.ContainsUnknowns() {
+			return errors.Errorf("%s: unexpected unknown value", path)
+		}
+		if v.ContainsSecrets() {
+			return errors.Errorf("%s: unexpected secret value", path)
+		}
+		if mappable := v.Mappable(); mappable != nil {
+			dest.Set(reflect.ValueOf(mappable))
+		}
+	case reflect.Bool:
+		if !v.IsBool() {
+			return typeError()
+		}
+		dest.SetBool(v.BoolValue())
+	case reflect.String:
+		if !v.IsString() {
+			return typeError()
+		}
+		dest.SetString(v.StringValue())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if !v.IsNumber() {
+			return typeError()
+		}
+		n := v.NumberValue()
+		if n != float64(int64(n)) || dest.OverflowInt(int64(n)) {
+			return errors.Errorf("%s: %v is not representable as a %v", path, n, dest.Type())
+		}
+		dest.SetInt(int64(n))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if !v.IsNumber() {
+			return typeError()
+		}
+		n := v.NumberValue()
+		if n < 0 || n != float64(uint64(n)) || dest.OverflowUint(uint64(n)) {
+			return errors.Errorf("%s: %v is not representable as a %v", path, n, dest.Type())
+		}
+		dest.SetUint(uint64(n))
+	case reflect.Float32, reflect.Float64:
+		if !v.IsNumber() {
+			return typeError()
+		}
+		dest.SetFloat(v.NumberValue())
+	case reflect.Slice:
+		if !v.IsArray() {
+			return typeError()
+		}
+		arr := v.ArrayValue()
+		slice := reflect.MakeSlice(dest.Type(), len(arr), len(arr))
+		for i, e := range arr {
+			if err := unmarshalValue(fmt.Sprintf("%s[%d]", path, i), e, slice.Index(i)); err != nil {
+				return err
+			}
+		}
+		dest.Set(slice)
+	case reflect.Map:
+		if !v.IsObject() || dest.Type().Key().Kind() != reflect.String {
+			return typeError()
+		}
+		obj := v.ObjectValue()
+		m := reflect.MakeMapWithSize(dest.Type(), len(obj))
+		for _, k := range obj.StableKeys() {
+			e := reflect.New(dest.Type().Elem()).Elem()
+			if err := unmarshalValue(fmt.Sprintf("%s[%q]", path, k), obj[k], e); err != nil {
+				return err
+			}
+			m.SetMapIndex(reflect.ValueOf(string(k)).Convert(dest.Type().Key()), e)
+		}
+		dest.Set(m)
+	case reflect.Struct:
+		if !v.IsObject() {
+			return typeError()
+		}
+		return unmarshalStructValue(path, v.ObjectValue(), dest)
+	default:
+		return typeError()
+	}
+	return nil
 }
@@ -19,6 +19,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/pulumi/pulumi/pkg/resource"
 	"github.com/pulumi/pulumi/sdk/go/pulumi/asset"
 )
 
@@ -28,7 +29,7 @@ func TestMarshalRoundtrip(t *testing.T) {
 	out, resolve, _ := NewOutput()
 	resolve("outputty")
 	out2 := newOutput()
-	out2.s.fulfill(nil, false, nil)
+	out2.s.fulfill(nil, false, false, nil)
 	out3 := Output{}
 	input := map[string]interface{}{
 		"s":            "a string",
@@ -62,7 +63,7 @@ func TestMarshalRoundtrip(t *testing.T) {
 		assert.Equal(t, 0, len(deps))
 
 		// Now just unmarshal and ensure the resulting map matches.
-		res, err := unmarshalOutputs(m)
+		res, _, err := unmarshalOutputs(m)
 		if !assert.Nil(t, err) {
 			if !assert.NotNil(t, res) {
 				assert.Equal(t, "a string", res["s"])
@@ -103,7 +104,7 @@ func TestMarshalRoundtrip(t *testing.T) {
 		assert.Equal(t, 0, len(deps))
 
 		// Now just unmarshal and ensure the resulting map matches.
-		res, err := unmarshalOutputs(m)
+		res, _, err := unmarshalOutputs(m)
 		if !assert.Nil(t, err) {
 			if !assert.NotNil(t, res) {
 				assert.Equal(t, "a string", res["s"])
@@ -158,7 +159,7 @@ func TestResourceState(t *testing.T) {
 	}, pdeps)
 	assert.Equal(t, []URN{"foo", "foo", "foo"}, deps)
 
-	res, err := unmarshalOutputs(m)
+	res, _, err := unmarshalOutputs(m)
 	assert.Nil(t, err)
 	assert.Equal(t, map[string]interface{}{
 		"urn": "foo",
@@ -167,12 +168,63 @@ func TestResourceState(t *testing.T) {
 	}, res)
 }
 
-func TestUnmarshalUnsupportedSecret(t *testing.T) {
+// TestMarshalSecretRoundtrip ensures that secrets survive marshaling to and from the on-the-wire gRPC format.
+func TestMarshalSecretRoundtrip(t *testing.T) {
+	out, resolve, _ := NewOutput()
+	resolve("password")
+	input := map[string]interface{}{
+		"plain":  "a string",
+		"secret": ToSecret("shh"),
+		"output": ToSecret(out),
+		"nested": map[string]interface{}{
+			"x": "y",
+			"z": ToSecret(42),
+		},
+	}
+
+	m, _, _, err := marshalInputs(input, true)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	res, secrets, err := unmarshalOutputs(m)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, map[string]interface{}{
+		"plain":  "a string",
+		"secret": "shh",
+		"output": "password",
+		"nested": map[string]interface{}{
+			"x": "y",
+			"z": 42.0,
+		},
+	}, res)
+	assert.Equal(t, map[string]bool{
+		"secret": true,
+		"output": true,
+		"nested": true,
+	}, secrets)
+}
+
+func TestUnmarshalSecret(t *testing.T) {
+	m, _, err := marshalInput(map[string]interface{}{
+		rpcTokenSpecialSigKey: rpcTokenSpecialSecretSig,
+		"value":               "shh",
+	})
+	assert.NoError(t, err)
+	v, secret, err := unmarshalOutput(m)
+	assert.NoError(t, err)
+	assert.True(t, secret)
+	assert.Equal(t, "shh", v)
+}
+
+func TestUnmarshalMalformedSecret(t *testing.T) {
 	m, _, err := marshalInput(map[string]interface{}{
 		rpcTokenSpecialSigKey: rpcTokenSpecialSecretSig,
 	})
 	assert.NoError(t, err)
-	_, err = unmarshalOutput(m)
+	_, _, err = unmarshalOutput(m)
 	assert.Error(t, err)
 }
 
@@ -181,6 +233,153 @@ func TestUnmarshalUnknownSig(t *testing.T) {
 		rpcTokenSpecialSigKey: "foobar",
 	})
 	assert.NoError(t, err)
-	_, err = unmarshalOutput(m)
+	_, _, err = unmarshalOutput(m)
 	assert.Error(t, err)
 }
+
+// TestMarshalStruct ensures that tagged struct fields are marshaled as object properties.
+func TestMarshalStruct(t *testing.T) {
+	type nested struct {
+		Value StringInput `pulumi:"value"`
+	}
+	type args struct {
+		Name     StringInput `pulumi:"name"`
+		Count    IntInput    `pulumi:"count"`
+		Tags     StringMap   `pulumi:"tags"`
+		Nested   *nested     `pulumi:"nested,optional"`
+		Missing  *nested     `pulumi:"missing"`
+		Items    StringArray `pulumi:"items"`
+		Untagged string
+		hidden   string `pulumi:"hidden"`
+	}
+
+	out, resolve, _ := NewOutput()
+	resolve("outputty")
+
+	m, _, err := marshalInput(args{
+		Name:     String("foo"),
+		Count:    IntOutput(ToSecret(42)),
+		Tags:     StringMap{"a": String("b")},
+		Nested:   &nested{Value: StringOutput(out)},
+		Items:    StringArray{String("x")},
+		Untagged: "untagged",
+		hidden:   "hidden",
+	})
+	assert.NoError(t, err)
+
+	res, secret, err := unmarshalOutput(m)
+	assert.NoError(t, err)
+	assert.True(t, secret)
+	assert.Equal(t, map[string]interface{}{
+		"name":   "foo",
+		"count":  42,
+		"tags":   map[string]interface{}{"a": "b"},
+		"nested": map[string]interface{}{"value": "outputty"},
+		"items":  []interface{}{"x"},
+	}, res)
+}
+
+func TestUnmarshalStruct(t *testing.T) {
+	type subnet struct {
+		ID   string  `pulumi:"id"`
+		Cidr *string `pulumi:"cidr,optional"`
+	}
+	type vpc struct {
+		Subnets []subnet `pulumi:"subnets"`
+	}
+	type result struct {
+		Name    string            `pulumi:"name"`
+		Count   int8              `pulumi:"count"`
+		Size    uint              `pulumi:"size"`
+		Ratio   float64           `pulumi:"ratio"`
+		Enabled bool              `pulumi:"enabled"`
+		Tags    map[string]string `pulumi:"tags,optional"`
+		Vpc     *vpc              `pulumi:"vpc"`
+		Extra   interface{}       `pulumi:"extra,optional"`
+	}
+
+	props := func() resource.PropertyMap {
+		return resource.NewPropertyMapFromMap(map[string]interface{}{
+			"name":    "foo",
+			"count":   42,
+			"size":    7,
+			"ratio":   0.5,
+			"enabled": true,
+			"tags":    map[string]interface{}{"a": "b"},
+			"vpc": map[string]interface{}{
+				"subnets": []interface{}{
+					map[string]interface{}{"id": "subnet-1", "cidr": "10.0.0.0/24"},
+					map[string]interface{}{"id": "subnet-2"},
+				},
+			},
+			"extra": []interface{}{"x", 1.0},
+		})
+	}
+
+	var res result
+	assert.NoError(t, unmarshalStruct(props(), &res))
+	cidr := "10.0.0.0/24"
+	assert.Equal(t, result{
+		Name:    "foo",
+		Count:   42,
+		Size:    7,
+		Ratio:   0.5,
+		Enabled: true,
+		Tags:    map[string]string{"a": "b"},
+		Vpc:     &vpc{Subnets: []subnet{{ID: "subnet-1", Cidr: &cidr}, {ID: "subnet-2"}}},
+		Extra:   []interface{}{"x", 1.0},
+	}, res)
+
+	tests := []struct {
+		name   string
+		modify func(resource.PropertyMap)
+		err    string
+	}{
+		{"missing", func(p resource.PropertyMap) {
+			delete(p, "name")
+		}, "name: missing required property"},
+		{"null", func(p resource.PropertyMap) {
+			p["vpc"] = resource.NewNullProperty()
+		}, "vpc: missing required property"},
+		{"nestedMissing", func(p resource.PropertyMap) {
+			p["vpc"].ObjectValue()["subnets"].ArrayValue()[1] = resource.NewObjectProperty(resource.PropertyMap{})
+		}, "vpc.subnets[1].id: missing required property"},
+		{"secret", func(p resource.PropertyMap) {
+			p["tags"].ObjectValue()["a"] = resource.MakeSecret(resource.NewStringProperty("b"))
+		}, `tags["a"]: unexpected secret value`},
+		{"unknown", func(p resource.PropertyMap) {
+			p["name"] = resource.MakeComputed(resource.NewStringProperty(""))
+		}, "name: unexpected unknown value"},
+		{"nestedSecret", func(p resource.PropertyMap) {
+			p["extra"] = resource.NewArrayProperty([]resource.PropertyValue{
+				resource.MakeSecret(resource.NewStringProperty("x")),
+			})
+		}, "extra: unexpected secret value"},
+		{"wrongType", func(p resource.PropertyMap) {
+			p["enabled"] = resource.NewStringProperty("true")
+		}, "enabled: expected a value of type bool, got string"},
+		{"overflow", func(p resource.PropertyMap) {
+			p["count"] = resource.NewNumberProperty(300)
+		}, "count: 300 is not representable as a int8"},
+		{"fractional", func(p resource.PropertyMap) {
+			p["count"] = resource.NewNumberProperty(1.5)
+		}, "count: 1.5 is not representable as a int8"},
+		{"negative", func(p resource.PropertyMap) {
+			p["size"] = resource.NewNumberProperty(-1)
+		}, "size: -1 is not representable as a uint"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := props()
+			tt.modify(p)
+			var res result
+			err := unmarshalStruct(p, &res)
+			if assert.Error(t, err) {
+				assert.Equal(t, tt.err, err.Error())
+			}
+		})
+	}
+
+	assert.Error(t, unmarshalStruct(props(), res))
+	assert.Error(t, unmarshalStruct(props(), (*result)(nil)))
+}
@@ -30,29 +30,32 @@ import (
 // Run executes the body of a Pulumi program, granting it access to a deployment context that it may use
 // to register resources and orchestrate deployment activities.  This connects back to the Pulumi engine using gRPC.
 // If the program fails, the process will be terminated and the function will not return.
-func Run(body RunFunc) {
-	if err := RunErr(body); err != nil {
+func Run(body RunFunc, opts ...RunOption) {
+	if err := RunErr(body, opts...); err != nil {
 		fmt.Fprintf(os.Stderr, "error: program failed: %v\n", err)
 		os.Exit(1)
 	}
 }
 
 // RunErr executes the body of a Pulumi program, granting it access to a deployment context that it may use
-// to register resources and orchestrate deployment activities.  This connects back to the Pulumi engine using gRPC.
-func RunErr(body RunFunc) error {
+// to register resources and orchestrate deployment activities.  This connects back to the Pulumi engine using gRPC,
+// unless the options supply mocks to use in its place.
+func RunErr(body RunFunc, opts ...RunOption) error {
 	// Parse the info out of environment variables.  This is a lame contract with the caller, but helps to keep
 	// boilerplate to a minimum in the average Pulumi Go program.
-	// TODO(joe): this is a fine default, but consider `...RunOpt`s to control how we get the various addresses, etc.
 	info := getEnvInfo()
+	for _, o := range opts {
+		o(&info)
+	}
 
 	// Validate some properties.
 	if info.Project == "" {
 		return errors.Errorf("missing project name")
 	} else if info.Stack == "" {
 		return errors.New("missing stack name")
-	} else if info.MonitorAddr == "" {
+	} else if info.MonitorAddr == "" && info.Mocks == nil {
 		return errors.New("missing resource monitor RPC address")
-	} else if info.EngineAddr == "" {
+	} else if info.EngineAddr == "" && info.Mocks == nil {
 		return errors.New("missing engine RPC address")
 	}
 
@@ -72,8 +75,8 @@ func RunWithContext(ctx *Context, body RunFunc) error {
 	info := ctx.info
 
 	// Create a root stack resource that we'll parent everything to.
-	reg, err := ctx.RegisterResource(
-		"pulumi:pulumi:Stack", fmt.Sprintf("%s-%s", info.Project, info.Stack), false, nil)
+	reg := &ResourceState{}
+	err := ctx.RegisterResource("pulumi:pulumi:Stack", fmt.Sprintf("%s-%s", info.Project, info.Stack), false, nil, reg)
 	if err != nil {
 		return err
 	}
@@ -89,8 +92,13 @@ func RunWithContext(ctx *Context, body RunFunc) error {
 		result = multierror.Append(result, err)
 	}
 
+	// Register the outputs of any components that did not register their own.
+	if err = ctx.registerComponentOutputs(); err != nil {
+		result = multierror.Append(result, err)
+	}
+
 	// Register all the outputs to the stack object.
-	if err = ctx.RegisterResourceOutputs(ctx.stackR, ctx.exports); err != nil {
+	if err = ctx.RegisterResourceOutputs(ctx.stackR, ctx.stackOutputs()); err != nil {
 		result = multierror.Append(result, err)
 	}
 
@@ -105,6 +113,9 @@ func RunWithContext(ctx *Context, body RunFunc) error {
 // supplied as an arguent and any non-nil return value is interpreted as a program error by the Pulumi runtime.
 type RunFunc func(ctx *Context) error
 
+// RunOption is an option that controls how a program is run.
+type RunOption func(*RunInfo)
+
 // RunInfo contains all the metadata about a run request.
 type RunInfo struct {
 	Project     string
@@ -114,6 +125,7 @@ type RunInfo struct {
 	DryRun      bool
 	MonitorAddr string
 	EngineAddr  string
+	Mocks       MockResourceMonitor // if non-nil, resource operations are serviced by these mocks.
 }
 
 // getEnvInfo reads various program information from the process environment.
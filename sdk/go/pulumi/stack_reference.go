@@ -0,0 +1,138 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumi
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// stackReferenceType is the type token of the builtin resource that reads the outputs of another stack.
+const stackReferenceType = "pulumi:pulumi:StackReference"
+
+// StackReference manages a reference to a Pulumi stack. The referenced stack's outputs are available via the
+// Outputs property or the GetOutput method.
+type StackReference struct {
+	state *ResourceState
+
+	// Name is the name of the referenced stack, in the form "[<organization>/][<project>/]<stack>".
+	Name StringOutput
+	// Outputs resolves to the full set of outputs exported by the referenced stack.
+	Outputs MapOutput
+
+	secretOutputNames Output
+}
+
+// StackReferenceArgs are the arguments used to construct a StackReference.
+type StackReferenceArgs struct {
+	// Name is the name of the stack to reference. If empty, the name of the StackReference resource is used.
+	Name string
+}
+
+// NewStackReference creates a stack reference that makes available the outputs of the given stack.
+func NewStackReference(ctx *Context, name string, args *StackReferenceArgs,
+	opts ...ResourceOpt) (*StackReference, error) {
+
+	stackName := name
+	if args != nil && args.Name != "" {
+		stackName = args.Name
+	}
+
+	props := map[string]interface{}{
+		"name":              stackName,
+		"outputs":           nil,
+		"secretOutputNames": nil,
+	}
+	state, err := ctx.ReadResource(stackReferenceType, name, ID(stackName), props, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StackReference{
+		state:             state,
+		Name:              StringOutput(state.State["name"]),
+		Outputs:           MapOutput(state.State["outputs"]),
+		secretOutputNames: state.State["secretOutputNames"],
+	}, nil
+}
+
+// URN is the stack reference's stable logical URN.
+func (s *StackReference) URN() URNOutput {
+	return s.state.URN()
+}
+
+// ID is the stack reference's ID, which is the name of the referenced stack.
+func (s *StackReference) ID() IDOutput {
+	return s.state.ID()
+}
+
+// GetOutput returns an output that resolves to the referenced stack's output with the given name, or nil if the
+// referenced stack has no such output. The result is secret if the referenced output is secret.
+func (s *StackReference) GetOutput(name StringInput) Output {
+	result := newOutput(s)
+	go func() {
+		value, known, secret, err := s.getOutput(context.Background(), name)
+		result.s.fulfill(value, known, secret, err)
+	}()
+	return result
+}
+
+// GetStringOutput is like GetOutput, but returns a StringOutput. The result is rejected if the referenced output is
+// not a string.
+func (s *StackReference) GetStringOutput(name StringInput) StringOutput {
+	return s.GetOutput(name).ApplyT(func(v interface{}) (string, error) {
+		if v == nil {
+			return "", nil
+		}
+		str, ok := v.(string)
+		if !ok {
+			return "", errors.Errorf("stack output is a %T, not a string", v)
+		}
+		return str, nil
+	}).(StringOutput)
+}
+
+// getOutput awaits the name of the requested output and the referenced stack's outputs, then looks up the output.
+// Because the outputs as a whole are secret if any output is secret, secretness is instead determined by whether or
+// not the requested output appears in the stack's secret output names.
+func (s *StackReference) getOutput(ctx context.Context, name StringInput) (interface{}, bool, bool, error) {
+	n, known, secret, err := name.ToStringOutput().getState().await(ctx)
+	if !known || err != nil {
+		return nil, known, secret, err
+	}
+	key := convert(n, stringType).(string)
+
+	outputs, known, _, err := s.Outputs.getState().await(ctx)
+	if !known || err != nil {
+		return nil, known, secret, err
+	}
+	secretNames, known, _, err := s.secretOutputNames.s.await(ctx)
+	if !known || err != nil {
+		return nil, known, secret, err
+	}
+
+	if names, ok := secretNames.([]interface{}); ok {
+		for _, n := range names {
+			if n == key {
+				secret = true
+				break
+			}
+		}
+	}
+
+	m, _ := outputs.(map[string]interface{})
+	return m[key], true, secret, nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/resource"
+)
+
+type stackReferenceMonitor struct{}
+
+func (stackReferenceMonitor) Call(token string, args resource.PropertyMap,
+	provider string) (resource.PropertyMap, error) {
+
+	return nil, errors.Errorf("unknown function %s", token)
+}
+
+func (stackReferenceMonitor) NewResource(typeToken, name string, inputs resource.PropertyMap,
+	provider, id string) (string, resource.PropertyMap, error) {
+
+	if typeToken != stackReferenceType || id != "org/network/prod" {
+		return "", nil, errors.Errorf("unexpected resource %s (%s)", typeToken, id)
+	}
+	return id, resource.PropertyMap{
+		"name": inputs["name"],
+		"outputs": resource.NewObjectProperty(resource.PropertyMap{
+			"vpcId":    resource.NewStringProperty("vpc-1234"),
+			"password": resource.MakeSecret(resource.NewStringProperty("hunter2")),
+			"count":    resource.NewNumberProperty(3),
+		}),
+		"secretOutputNames": resource.NewArrayProperty([]resource.PropertyValue{
+			resource.NewStringProperty("password"),
+		}),
+	}, nil
+}
+
+func TestStackReference(t *testing.T) {
+	type result struct {
+		value  interface{}
+		secret bool
+		err    error
+	}
+	results := make(map[string]result)
+
+	err := RunErr(func(ctx *Context) error {
+		ref, err := NewStackReference(ctx, "network", &StackReferenceArgs{Name: "org/network/prod"})
+		if err != nil {
+			return err
+		}
+
+		outputs := map[string]TypedOutput{
+			"vpcId":     ref.GetStringOutput(String("vpcId")),
+			"password":  ref.GetStringOutput(String("password")),
+			"count":     ref.GetOutput(String("count")),
+			"missing":   ref.GetOutput(String("missing")),
+			"notString": ref.GetStringOutput(String("count")),
+		}
+		for name, out := range outputs {
+			v, _, secret, err := out.getState().await(context.Background())
+			results[name] = result{value: v, secret: secret, err: err}
+		}
+		return nil
+	}, WithMocks("project", "stack", stackReferenceMonitor{}))
+	assert.NoError(t, err)
+
+	assert.Equal(t, result{value: "vpc-1234"}, results["vpcId"])
+	assert.Equal(t, result{value: "hunter2", secret: true}, results["password"])
+	assert.Equal(t, result{value: 3.0}, results["count"])
+	assert.Equal(t, result{}, results["missing"])
+	assert.Error(t, results["notString"].err)
+}
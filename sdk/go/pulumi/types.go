@@ -0,0 +1,387 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate go run ./generate
+
+package pulumi
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+// Input is implemented by every value that may be passed as a typed resource input: the prompt input types (e.g.
+// String), the typed outputs (e.g. StringOutput), and the typed arrays and maps of either.
+type Input interface {
+	// ElementType returns the type of the value that the input resolves to.
+	ElementType() reflect.Type
+}
+
+// TypedOutput is implemented by Output and by each of the typed outputs (e.g. StringOutput). The concrete type of the
+// output returned by ApplyT is determined by the type of the value that its applier returns, so the result can be
+// asserted to the corresponding typed output.
+type TypedOutput interface {
+	Input
+
+	// ApplyT transforms the output's value using the given applier, which must be a function of the form
+	// `func(T) U` or `func(T) (U, error)`, where the output's element type is convertible to T.
+	ApplyT(applier interface{}) TypedOutput
+	// ApplyTWithContext is like ApplyT, but its applier must also accept a leading context.Context parameter.
+	ApplyTWithContext(ctx context.Context, applier interface{}) TypedOutput
+	// IsSecret returns true if the output's value is secret, blocking until the output has been fulfilled.
+	IsSecret() bool
+
+	getState() *outputState
+}
+
+var (
+	anyType     = reflect.TypeOf((*interface{})(nil)).Elem()
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// concreteTypeToOutputType maps each element type to the typed output that resolves to values of that type.
+var concreteTypeToOutputType = make(map[reflect.Type]reflect.Type)
+
+// RegisterOutputType registers a typed output so that ApplyT returns it for appliers that produce values of its
+// element type. The output's type must be defined in terms of Output, i.e. `type FooOutput Output`. This function
+// panics if an output has already been registered for the element type.
+func RegisterOutputType(output TypedOutput) {
+	elementType := output.ElementType()
+	if existing, has := concreteTypeToOutputType[elementType]; has {
+		panic(errors.Errorf("an output type for %v is already registered: %v", elementType, existing))
+	}
+	t := reflect.TypeOf(output)
+	if !t.ConvertibleTo(outputType) {
+		panic(errors.Errorf("output type %v must be defined in terms of Output", t))
+	}
+	concreteTypeToOutputType[elementType] = t
+}
+
+func init() {
+	RegisterOutputType(Output{})
+}
+
+// ElementType returns the element type of this output (interface{}).
+func (Output) ElementType() reflect.Type { return anyType }
+
+func (out Output) getState() *outputState { return out.s }
+
+// ApplyT transforms the output's value using the given applier, which must be a function of the form `func(T) U` or
+// `func(T) (U, error)`. The result is the typed output registered for U, if any, or an Output otherwise. This function
+// panics if the applier does not have one of these forms, or if the output is typed and its element type cannot be
+// converted to T.
+func (out Output) ApplyT(applier interface{}) TypedOutput {
+	return applyT(out, anyType, applier)
+}
+
+// ApplyTWithContext is like ApplyT, but its applier must be a function of the form `func(context.Context, T) U` or
+// `func(context.Context, T) (U, error)`. The provided context can be used to reject the output as canceled.
+func (out Output) ApplyTWithContext(ctx context.Context, applier interface{}) TypedOutput {
+	return applyTWithContext(ctx, out, anyType, applier)
+}
+
+// applyT implements ApplyT for an output whose values are of the given element type.
+func applyT(out Output, elementType reflect.Type, applier interface{}) TypedOutput {
+	return applyTWithContext(context.Background(), out, elementType, makeContextful(applier, elementType))
+}
+
+// applyTWithContext implements ApplyTWithContext for an output whose values are of the given element type.
+func applyTWithContext(ctx context.Context, out Output, elementType reflect.Type, applier interface{}) TypedOutput {
+	fn := checkApplier(applier, true, elementType)
+	elemType := fn.Type().In(1)
+	resultType := fn.Type().Out(0)
+
+	result := out.ApplyWithContext(ctx, func(ctx context.Context, v interface{}) (interface{}, error) {
+		arg, err := convertValue(reflect.ValueOf(v), elemType)
+		if err != nil {
+			return nil, err
+		}
+		results := fn.Call([]reflect.Value{reflect.ValueOf(ctx), arg})
+		if len(results) == 2 && !results[1].IsNil() {
+			return nil, results[1].Interface().(error)
+		}
+		return results[0].Interface(), nil
+	})
+	return toTypedOutput(result, resultType)
+}
+
+// toTypedOutput converts the given output to the typed output registered for the given element type, if any.
+func toTypedOutput(out Output, elementType reflect.Type) TypedOutput {
+	t, has := concreteTypeToOutputType[elementType]
+	if !has {
+		return out
+	}
+	return reflect.ValueOf(out).Convert(t).Interface().(TypedOutput)
+}
+
+// makeContextful wraps an applier of the form `func(T) ...` in one of the form `func(context.Context, T) ...`.
+func makeContextful(applier interface{}, elementType reflect.Type) interface{} {
+	fn := checkApplier(applier, false, elementType)
+
+	ft := fn.Type()
+	in := []reflect.Type{contextType, ft.In(0)}
+	out := make([]reflect.Type, ft.NumOut())
+	for i := range out {
+		out[i] = ft.Out(i)
+	}
+
+	wrapped := reflect.MakeFunc(reflect.FuncOf(in, out, false), func(args []reflect.Value) []reflect.Value {
+		return fn.Call(args[1:])
+	})
+	return wrapped.Interface()
+}
+
+// checkApplier panics if the given applier is not a function of the form `func([context.Context,] T) U` or
+// `func([context.Context,] T) (U, error)`, or if values of the given element type cannot be converted to T.
+func checkApplier(applier interface{}, withContext bool, elementType reflect.Type) reflect.Value {
+	fn := reflect.ValueOf(applier)
+	if fn.Kind() != reflect.Func {
+		panic(errors.Errorf("applier must be a function, not a %v", fn.Type()))
+	}
+
+	ft := fn.Type()
+	switch {
+	case withContext && (ft.NumIn() != 2 || ft.In(0) != contextType):
+		panic(errors.Errorf("applier must accept a context.Context and a single value; got %v", ft))
+	case !withContext && ft.NumIn() != 1:
+		panic(errors.Errorf("applier must accept a single value; got %v", ft))
+	}
+	switch ft.NumOut() {
+	case 1:
+		if ft.Out(0) == errorType {
+			panic(errors.Errorf("applier must return a value; got %v", ft))
+		}
+	case 2:
+		if ft.Out(1) != errorType {
+			panic(errors.Errorf("applier's second result must be an error; got %v", ft))
+		}
+	default:
+		panic(errors.Errorf("applier must return a value and an optional error; got %v", ft))
+	}
+	if in := ft.In(ft.NumIn() - 1); !elementConvertible(elementType, in) {
+		panic(errors.Errorf("applier's parameter type %v is not compatible with the output's element type %v; got %v",
+			in, elementType, ft))
+	}
+	return fn
+}
+
+// elementConvertible returns true if convertValue can convert values of type from to type to. Conversions from
+// interface types can only be checked once the value is known, so they are always allowed.
+func elementConvertible(from, to reflect.Type) bool {
+	switch {
+	case from.Kind() == reflect.Interface || canConvert(from, to):
+		return true
+	case to.Kind() == reflect.Slice && (from.Kind() == reflect.Slice || from.Kind() == reflect.Array):
+		return elementConvertible(from.Elem(), to.Elem())
+	case to.Kind() == reflect.Map && from.Kind() == reflect.Map:
+		return elementConvertible(from.Key(), to.Key()) && elementConvertible(from.Elem(), to.Elem())
+	default:
+		return false
+	}
+}
+
+// canConvert returns true if values of type from can be converted to type to using reflect.Value.Convert. Unlike
+// reflect.Type.ConvertibleTo, this is false for conversions from integers to strings, which yield runes rather than
+// the integers' text.
+func canConvert(from, to reflect.Type) bool {
+	if to.Kind() == reflect.String {
+		switch from.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return false
+		}
+	}
+	return from.ConvertibleTo(to)
+}
+
+// newResolvedOutput returns an output that is resolved to the given known, non-secret value.
+func newResolvedOutput(v interface{}) Output {
+	out := newOutput()
+	out.s.resolve(v, true, false)
+	return out
+}
+
+// Any returns an output that resolves to the given value once every output that it contains, including outputs nested
+// in arrays, slices, and maps, has resolved. The result is unknown if any contained output is unknown and secret if
+// any is secret.
+func Any(v interface{}) Output {
+	if out, ok := isOutput(v); ok {
+		return out
+	}
+
+	result := newOutput(gatherDependencies(v)...)
+	go func() {
+		value, known, secret, err := awaitDeep(context.Background(), v)
+		if !known || err != nil {
+			value = nil
+		}
+		result.s.fulfill(value, known, secret, err)
+	}()
+	return result
+}
+
+// All returns an array output that resolves to the values of the given inputs once all of them have resolved.
+func All(inputs ...interface{}) ArrayOutput {
+	return ArrayOutput(Any(inputs))
+}
+
+// gatherDependencies returns the resources that the outputs contained in the given value depend upon.
+func gatherDependencies(v interface{}) []Resource {
+	if v == nil {
+		return nil
+	}
+	if out, ok := isOutput(v); ok {
+		return out.s.dependencies()
+	}
+
+	var deps []Resource
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Array, reflect.Slice:
+		for i := 0; i < rv.Len(); i++ {
+			deps = append(deps, gatherDependencies(rv.Index(i).Interface())...)
+		}
+	case reflect.Map:
+		for _, key := range rv.MapKeys() {
+			deps = append(deps, gatherDependencies(rv.MapIndex(key).Interface())...)
+		}
+	}
+	return deps
+}
+
+// awaitDeep awaits every output contained in the given value, returning a copy of the value in which each output has
+// been replaced by its resolution. Arrays and slices are returned as []interface{}, and maps with string keys as
+// map[string]interface{}.
+func awaitDeep(ctx context.Context, v interface{}) (interface{}, bool, bool, error) {
+	if v == nil {
+		return nil, true, false, nil
+	}
+	if out, ok := isOutput(v); ok {
+		value, known, secret, err := out.s.await(ctx)
+		if !known || err != nil {
+			return nil, known, secret, err
+		}
+		value, known, esecret, err := awaitDeep(ctx, value)
+		return value, known, secret || esecret, err
+	}
+
+	known, secret := true, false
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Array, reflect.Slice:
+		arr := make([]interface{}, rv.Len())
+		for i := range arr {
+			e, eknown, esecret, err := awaitDeep(ctx, rv.Index(i).Interface())
+			if err != nil {
+				return nil, true, secret || esecret, err
+			}
+			arr[i], known, secret = e, known && eknown, secret || esecret
+		}
+		return arr, known, secret, nil
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return v, true, false, nil
+		}
+		obj := make(map[string]interface{})
+		for _, key := range rv.MapKeys() {
+			e, eknown, esecret, err := awaitDeep(ctx, rv.MapIndex(key).Interface())
+			if err != nil {
+				return nil, true, secret || esecret, err
+			}
+			obj[key.String()], known, secret = e, known && eknown, secret || esecret
+		}
+		return obj, known, secret, nil
+	default:
+		return v, true, false, nil
+	}
+}
+
+func (out IDOutput) await(ctx context.Context) (ID, bool, error) {
+	id, known, _, err := out.s.await(ctx)
+	if !known || err != nil {
+		return "", known, err
+	}
+	return convert(id, idType).(ID), true, nil
+}
+
+func (out URNOutput) await(ctx context.Context) (URN, bool, error) {
+	urn, known, _, err := out.s.await(ctx)
+	if !known || err != nil {
+		return "", known, err
+	}
+	return convert(urn, urnType).(URN), true, nil
+}
+
+// convert converts the given value to the given type, panicking if it cannot be converted.
+func convert(v interface{}, to reflect.Type) interface{} {
+	result, err := tryConvert(v, to)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// tryConvert converts the given value to the given type. Nil values convert to the type's zero value, and arrays,
+// slices, and maps are converted element by element.
+func tryConvert(v interface{}, to reflect.Type) (interface{}, error) {
+	rv, err := convertValue(reflect.ValueOf(v), to)
+	if err != nil {
+		return nil, err
+	}
+	return rv.Interface(), nil
+}
+
+func convertValue(rv reflect.Value, to reflect.Type) (reflect.Value, error) {
+	if !rv.IsValid() {
+		return reflect.Zero(to), nil
+	}
+	if rv.Kind() == reflect.Interface {
+		return convertValue(rv.Elem(), to)
+	}
+	if canConvert(rv.Type(), to) {
+		return rv.Convert(to), nil
+	}
+
+	switch {
+	case to.Kind() == reflect.Slice && (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array):
+		result := reflect.MakeSlice(to, rv.Len(), rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			e, err := convertValue(rv.Index(i), to.Elem())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			result.Index(i).Set(e)
+		}
+		return result, nil
+	case to.Kind() == reflect.Map && rv.Kind() == reflect.Map:
+		result := reflect.MakeMapWithSize(to, rv.Len())
+		for _, key := range rv.MapKeys() {
+			k, err := convertValue(key, to.Key())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			e, err := convertValue(rv.MapIndex(key), to.Elem())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			result.SetMapIndex(k, e)
+		}
+		return result, nil
+	}
+
+	return reflect.Value{}, errors.Errorf("cannot convert output value of type %s to %s", rv.Type(), to)
+}
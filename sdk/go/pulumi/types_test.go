@@ -0,0 +1,220 @@
+// Copyright 2016-2019, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumi
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func awaitTyped(t *testing.T, o TypedOutput) (interface{}, bool, bool, error) {
+	return o.getState().await(context.Background())
+}
+
+func TestPromptInputs(t *testing.T) {
+	v, known, secret, err := awaitTyped(t, String("foo").ToStringOutput())
+	assert.Nil(t, err)
+	assert.True(t, known)
+	assert.False(t, secret)
+	assert.Equal(t, "foo", v)
+
+	v, _, _, err = awaitTyped(t, Int(42).ToIntOutput())
+	assert.Nil(t, err)
+	assert.Equal(t, 42, v)
+
+	v, _, _, err = awaitTyped(t, ID("i-1234").ToIDOutput())
+	assert.Nil(t, err)
+	assert.Equal(t, ID("i-1234"), v)
+
+	var input StringInput = String("bar")
+	assert.Equal(t, stringType, input.ElementType())
+	input = input.ToStringOutput()
+	assert.Equal(t, stringType, input.ElementType())
+}
+
+func TestApplyT(t *testing.T) {
+	// Test that the result's type is determined by the applier's result type.
+	{
+		out := String("42").ToStringOutput().ApplyT(func(s string) (int, error) {
+			return strconv.Atoi(s)
+		})
+		intOut, ok := out.(IntOutput)
+		assert.True(t, ok)
+
+		v, known, _, err := awaitTyped(t, intOut)
+		assert.Nil(t, err)
+		assert.True(t, known)
+		assert.Equal(t, 42, v)
+	}
+	// Test that appliers with contexts and without errors are accepted.
+	{
+		out := Int(41).ToIntOutput().ApplyTWithContext(context.Background(), func(_ context.Context, i int) string {
+			return strconv.Itoa(i + 1)
+		})
+		_, ok := out.(StringOutput)
+		assert.True(t, ok)
+
+		v, _, _, err := awaitTyped(t, out)
+		assert.Nil(t, err)
+		assert.Equal(t, "42", v)
+	}
+	// Test that results of unregistered types yield untyped outputs.
+	{
+		type point struct{ X, Y int }
+		out := Int(1).ToIntOutput().ApplyT(func(i int) point {
+			return point{X: i, Y: i}
+		})
+		_, ok := out.(Output)
+		assert.True(t, ok)
+
+		v, _, _, err := awaitTyped(t, out)
+		assert.Nil(t, err)
+		assert.Equal(t, point{X: 1, Y: 1}, v)
+	}
+	// Test that applier errors reject the result.
+	{
+		out := String("x").ToStringOutput().ApplyT(func(s string) (int, error) {
+			return 0, errors.New("boom")
+		})
+		_, _, _, err := awaitTyped(t, out)
+		assert.EqualError(t, err, "boom")
+	}
+	// Test that secretness flows through typed applies.
+	{
+		out := StringOutput(ToSecret("shh")).ApplyT(func(s string) int {
+			return len(s)
+		})
+		assert.True(t, out.IsSecret())
+	}
+	// Test that collection results yield the corresponding typed outputs.
+	{
+		out := String("a,b").ToStringOutput().ApplyT(func(s string) []string {
+			return strings.Split(s, ",")
+		})
+		arr, ok := out.(StringArrayOutput)
+		assert.True(t, ok)
+
+		v, _, _, err := awaitTyped(t, arr.ApplyT(func(ss []string) map[string]int {
+			return map[string]int{ss[0]: 0, ss[1]: 1}
+		}).(IntMapOutput))
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]int{"a": 0, "b": 1}, v)
+	}
+	// Test that appliers may accept any type that the output's element type can be converted to.
+	{
+		v, _, _, err := awaitTyped(t, ID("i-1234").ToIDOutput().ApplyT(func(s string) string { return s }))
+		assert.Nil(t, err)
+		assert.Equal(t, "i-1234", v)
+
+		v, _, _, err = awaitTyped(t, Int(42).ToIntOutput().ApplyT(func(f float64) float64 { return f / 2 }))
+		assert.Nil(t, err)
+		assert.Equal(t, float64(21), v)
+	}
+	// Test that untyped outputs whose values cannot be converted to the applier's parameter type are rejected.
+	{
+		out := newResolvedOutput(42).ApplyT(func(s string) string { return s })
+		_, _, _, err := awaitTyped(t, out)
+		assert.EqualError(t, err, "cannot convert output value of type int to string")
+	}
+	// Test that appliers of the wrong form are rejected.
+	assert.Panics(t, func() { Output{}.ApplyT(42) })
+	assert.Panics(t, func() { Output{}.ApplyT(func(a, b int) int { return a + b }) })
+	assert.Panics(t, func() { Output{}.ApplyT(func(a int) (int, int) { return a, a }) })
+	// Test that appliers whose parameter type does not match a typed output's element type are rejected.
+	assert.Panics(t, func() { String("x").ToStringOutput().ApplyT(func(i int) int { return i }) })
+	assert.Panics(t, func() { Int(42).ToIntOutput().ApplyT(func(s string) string { return s }) })
+	assert.Panics(t, func() {
+		StringArray{String("x")}.ToStringArrayOutput().ApplyTWithContext(context.Background(),
+			func(_ context.Context, is []int) int { return len(is) })
+	})
+	assert.Panics(t, func() { IntMap{"x": Int(1)}.ToIntMapOutput().ApplyT(func(m map[string]bool) int { return 0 }) })
+}
+
+func TestTypedCollections(t *testing.T) {
+	out, resolve, _ := NewOutput()
+	go func() { resolve("b") }()
+
+	arr := StringArray{String("a"), StringOutput(out)}.ToStringArrayOutput()
+	v, known, _, err := awaitTyped(t, arr.ApplyT(func(ss []string) []string { return ss }))
+	assert.Nil(t, err)
+	assert.True(t, known)
+	assert.Equal(t, []string{"a", "b"}, v)
+
+	v, _, _, err = awaitTyped(t, arr.Index(1))
+	assert.Nil(t, err)
+	assert.Equal(t, "b", v)
+
+	v, _, _, err = awaitTyped(t, arr.Index(2))
+	assert.Nil(t, err)
+	assert.Equal(t, "", v)
+
+	m := IntMap{"x": Int(1), "y": Int(2)}.ToIntMapOutput()
+	v, _, _, err = awaitTyped(t, m.ApplyT(func(m map[string]int) int { return m["x"] + m["y"] }))
+	assert.Nil(t, err)
+	assert.Equal(t, 3, v)
+
+	v, _, _, err = awaitTyped(t, m.MapIndex("y"))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, v)
+}
+
+func TestAllAndAny(t *testing.T) {
+	// Test that All resolves once all of its inputs have resolved.
+	{
+		out, resolve, _ := NewOutput()
+		go func() { resolve(42) }()
+
+		all := All(String("a"), out, ToSecret(true))
+		v, known, secret, err := awaitTyped(t, all)
+		assert.Nil(t, err)
+		assert.True(t, known)
+		assert.True(t, secret)
+		assert.Equal(t, []interface{}{String("a"), 42, true}, v)
+	}
+	// Test that All is unknown if any input is unknown.
+	{
+		out := newOutput()
+		go func() { out.s.fulfill(nil, false, false, nil) }()
+
+		_, known, _, err := awaitTyped(t, All(String("a"), out))
+		assert.Nil(t, err)
+		assert.False(t, known)
+	}
+	// Test that All is rejected if any input is rejected.
+	{
+		out, _, reject := NewOutput()
+		go func() { reject(errors.New("boom")) }()
+
+		_, _, _, err := awaitTyped(t, All(String("a"), out))
+		assert.EqualError(t, err, "boom")
+	}
+	// Test that Any awaits outputs nested in maps and slices.
+	{
+		out, resolve, _ := NewOutput()
+		go func() { resolve("nested") }()
+
+		v, known, _, err := awaitTyped(t, Any(map[string]interface{}{
+			"list": []interface{}{out},
+		}))
+		assert.Nil(t, err)
+		assert.True(t, known)
+		assert.Equal(t, map[string]interface{}{"list": []interface{}{"nested"}}, v)
+	}
+}
@@ -88,6 +88,17 @@ function deserialize_pulumirpc_PluginInfo(buffer_arg) {
   return plugin_pb.PluginInfo.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pulumirpc_RemediateResponse(arg) {
+  if (!(arg instanceof analyzer_pb.RemediateResponse)) {
+    throw new Error('Expected argument of type pulumirpc.RemediateResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_RemediateResponse(buffer_arg) {
+  return analyzer_pb.RemediateResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 
 // Analyzer provides a pluggable interface for checking resource definitions against some number of
 // resource policies. It is intentionally open-ended, allowing for implementations that check
@@ -121,6 +132,20 @@ var AnalyzerService = exports.AnalyzerService = {
     responseSerialize: serialize_pulumirpc_AnalyzeResponse,
     responseDeserialize: deserialize_pulumirpc_AnalyzeResponse,
   },
+  // Remediate is given the opportunity to transform a single resource's inputs before they are
+  // checked by its provider. It returns the remediations that were applied, if any, each of which
+  // carries the full set of transformed properties.
+  remediate: {
+    path: '/pulumirpc.Analyzer/Remediate',
+    requestStream: false,
+    responseStream: false,
+    requestType: analyzer_pb.AnalyzeRequest,
+    responseType: analyzer_pb.RemediateResponse,
+    requestSerialize: serialize_pulumirpc_AnalyzeRequest,
+    requestDeserialize: deserialize_pulumirpc_AnalyzeRequest,
+    responseSerialize: serialize_pulumirpc_RemediateResponse,
+    responseDeserialize: deserialize_pulumirpc_RemediateResponse,
+  },
   // GetAnalyzerInfo returns metadata about the analyzer (e.g., list of policies contained).
   getAnalyzerInfo: {
     path: '/pulumirpc.Analyzer/GetAnalyzerInfo',
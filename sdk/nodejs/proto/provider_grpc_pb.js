@@ -77,6 +77,28 @@ function deserialize_pulumirpc_ConfigureResponse(buffer_arg) {
   return provider_pb.ConfigureResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pulumirpc_ConstructRequest(arg) {
+  if (!(arg instanceof provider_pb.ConstructRequest)) {
+    throw new Error('Expected argument of type pulumirpc.ConstructRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_ConstructRequest(buffer_arg) {
+  return provider_pb.ConstructRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_ConstructResponse(arg) {
+  if (!(arg instanceof provider_pb.ConstructResponse)) {
+    throw new Error('Expected argument of type pulumirpc.ConstructResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_ConstructResponse(buffer_arg) {
+  return provider_pb.ConstructResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_pulumirpc_CreateRequest(arg) {
   if (!(arg instanceof provider_pb.CreateRequest)) {
     throw new Error('Expected argument of type pulumirpc.CreateRequest');
@@ -132,6 +154,28 @@ function deserialize_pulumirpc_DiffResponse(buffer_arg) {
   return provider_pb.DiffResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pulumirpc_GetSchemaRequest(arg) {
+  if (!(arg instanceof provider_pb.GetSchemaRequest)) {
+    throw new Error('Expected argument of type pulumirpc.GetSchemaRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_GetSchemaRequest(buffer_arg) {
+  return provider_pb.GetSchemaRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_GetSchemaResponse(arg) {
+  if (!(arg instanceof provider_pb.GetSchemaResponse)) {
+    throw new Error('Expected argument of type pulumirpc.GetSchemaResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_GetSchemaResponse(buffer_arg) {
+  return provider_pb.GetSchemaResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_pulumirpc_InvokeRequest(arg) {
   if (!(arg instanceof provider_pb.InvokeRequest)) {
     throw new Error('Expected argument of type pulumirpc.InvokeRequest');
@@ -213,6 +257,18 @@ function deserialize_pulumirpc_UpdateResponse(buffer_arg) {
 // ResourceProvider is a service that understands how to create, read, update, or delete resources for types defined
 // within a single package.  It is driven by the overall planning engine in response to resource diffs.
 var ResourceProviderService = exports.ResourceProviderService = {
+  // GetSchema fetches the schema for this resource provider.
+  getSchema: {
+    path: '/pulumirpc.ResourceProvider/GetSchema',
+    requestStream: false,
+    responseStream: false,
+    requestType: provider_pb.GetSchemaRequest,
+    responseType: provider_pb.GetSchemaResponse,
+    requestSerialize: serialize_pulumirpc_GetSchemaRequest,
+    requestDeserialize: deserialize_pulumirpc_GetSchemaRequest,
+    responseSerialize: serialize_pulumirpc_GetSchemaResponse,
+    responseDeserialize: deserialize_pulumirpc_GetSchemaResponse,
+  },
   // CheckConfig validates the configuration for this resource provider.
   checkConfig: {
     path: '/pulumirpc.ResourceProvider/CheckConfig',
@@ -352,6 +408,18 @@ var ResourceProviderService = exports.ResourceProviderService = {
     responseSerialize: serialize_google_protobuf_Empty,
     responseDeserialize: deserialize_google_protobuf_Empty,
   },
+  // Construct creates a new instance of the provided component resource and returns its state.
+  construct: {
+    path: '/pulumirpc.ResourceProvider/Construct',
+    requestStream: false,
+    responseStream: false,
+    requestType: provider_pb.ConstructRequest,
+    responseType: provider_pb.ConstructResponse,
+    requestSerialize: serialize_pulumirpc_ConstructRequest,
+    requestDeserialize: deserialize_pulumirpc_ConstructRequest,
+    responseSerialize: serialize_pulumirpc_ConstructResponse,
+    responseDeserialize: deserialize_pulumirpc_ConstructResponse,
+  },
   // Cancel signals the provider to abort all outstanding resource operations.
   cancel: {
     path: '/pulumirpc.ResourceProvider/Cancel',
@@ -21,6 +21,7 @@ goog.exportSymbol('proto.pulumirpc.RegisterResourceRequest', null, global);
 goog.exportSymbol('proto.pulumirpc.RegisterResourceRequest.CustomTimeouts', null, global);
 goog.exportSymbol('proto.pulumirpc.RegisterResourceRequest.PropertyDependencies', null, global);
 goog.exportSymbol('proto.pulumirpc.RegisterResourceResponse', null, global);
+goog.exportSymbol('proto.pulumirpc.RegisterResourceResponse.PropertyDependencies', null, global);
 goog.exportSymbol('proto.pulumirpc.SupportsFeatureRequest', null, global);
 goog.exportSymbol('proto.pulumirpc.SupportsFeatureResponse', null, global);
 
@@ -998,7 +999,7 @@ if (goog.DEBUG && !COMPILED) {
  * @private {!Array<number>}
  * @const
  */
-proto.pulumirpc.RegisterResourceRequest.repeatedFields_ = [7,12,14,15];
+proto.pulumirpc.RegisterResourceRequest.repeatedFields_ = [7,12,14,15,21];
 
 
 
@@ -1047,7 +1048,10 @@ proto.pulumirpc.RegisterResourceRequest.toObject = function(includeInstance, msg
     importid: jspb.Message.getFieldWithDefault(msg, 16, ""),
     customtimeouts: (f = msg.getCustomtimeouts()) && proto.pulumirpc.RegisterResourceRequest.CustomTimeouts.toObject(includeInstance, f),
     deletebeforereplacedefined: jspb.Message.getFieldWithDefault(msg, 18, false),
-    supportspartialvalues: jspb.Message.getFieldWithDefault(msg, 19, false)
+    supportspartialvalues: jspb.Message.getFieldWithDefault(msg, 19, false),
+    remote: jspb.Message.getFieldWithDefault(msg, 20, false),
+    replaceonchangesList: jspb.Message.getRepeatedField(msg, 21),
+    retainondelete: jspb.Message.getFieldWithDefault(msg, 22, false)
   };
 
   if (includeInstance) {
@@ -1164,6 +1168,18 @@ proto.pulumirpc.RegisterResourceRequest.deserializeBinaryFromReader = function(m
       var value = /** @type {boolean} */ (reader.readBool());
       msg.setSupportspartialvalues(value);
       break;
+    case 20:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRemote(value);
+      break;
+    case 21:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addReplaceonchanges(value);
+      break;
+    case 22:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRetainondelete(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1325,6 +1341,27 @@ proto.pulumirpc.RegisterResourceRequest.serializeBinaryToWriter = function(messa
       f
     );
   }
+  f = message.getRemote();
+  if (f) {
+    writer.writeBool(
+      20,
+      f
+    );
+  }
+  f = message.getReplaceonchangesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      21,
+      f
+    );
+  }
+  f = message.getRetainondelete();
+  if (f) {
+    writer.writeBool(
+      22,
+      f
+    );
+  }
 };
 
 
@@ -1540,7 +1577,8 @@ proto.pulumirpc.RegisterResourceRequest.CustomTimeouts.toObject = function(inclu
   var f, obj = {
     create: jspb.Message.getFieldWithDefault(msg, 1, ""),
     update: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    pb_delete: jspb.Message.getFieldWithDefault(msg, 3, "")
+    pb_delete: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    read: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
 
   if (includeInstance) {
@@ -1589,6 +1627,10 @@ proto.pulumirpc.RegisterResourceRequest.CustomTimeouts.deserializeBinaryFromRead
       var value = /** @type {string} */ (reader.readString());
       msg.setDelete(value);
       break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRead(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1639,6 +1681,13 @@ proto.pulumirpc.RegisterResourceRequest.CustomTimeouts.serializeBinaryToWriter =
       f
     );
   }
+  f = message.getRead();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
 };
 
 
@@ -1687,6 +1736,21 @@ proto.pulumirpc.RegisterResourceRequest.CustomTimeouts.prototype.setDelete = fun
 };
 
 
+/**
+ * optional string read = 4;
+ * @return {string}
+ */
+proto.pulumirpc.RegisterResourceRequest.CustomTimeouts.prototype.getRead = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/** @param {string} value */
+proto.pulumirpc.RegisterResourceRequest.CustomTimeouts.prototype.setRead = function(value) {
+  jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
 /**
  * optional string type = 1;
  * @return {string}
@@ -2073,6 +2137,69 @@ proto.pulumirpc.RegisterResourceRequest.prototype.setSupportspartialvalues = fun
 };
 
 
+/**
+ * optional bool remote = 20;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.pulumirpc.RegisterResourceRequest.prototype.getRemote = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 20, false));
+};
+
+
+/** @param {boolean} value */
+proto.pulumirpc.RegisterResourceRequest.prototype.setRemote = function(value) {
+  jspb.Message.setProto3BooleanField(this, 20, value);
+};
+
+
+/**
+ * repeated string replaceOnChanges = 21;
+ * @return {!Array.<string>}
+ */
+proto.pulumirpc.RegisterResourceRequest.prototype.getReplaceonchangesList = function() {
+  return /** @type {!Array.<string>} */ (jspb.Message.getRepeatedField(this, 21));
+};
+
+
+/** @param {!Array.<string>} value */
+proto.pulumirpc.RegisterResourceRequest.prototype.setReplaceonchangesList = function(value) {
+  jspb.Message.setField(this, 21, value || []);
+};
+
+
+/**
+ * @param {!string} value
+ * @param {number=} opt_index
+ */
+proto.pulumirpc.RegisterResourceRequest.prototype.addReplaceonchanges = function(value, opt_index) {
+  jspb.Message.addToRepeatedField(this, 21, value, opt_index);
+};
+
+
+proto.pulumirpc.RegisterResourceRequest.prototype.clearReplaceonchangesList = function() {
+  this.setReplaceonchangesList([]);
+};
+
+
+/**
+ * optional bool retainOnDelete = 22;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.pulumirpc.RegisterResourceRequest.prototype.getRetainondelete = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 22, false));
+};
+
+
+/** @param {boolean} value */
+proto.pulumirpc.RegisterResourceRequest.prototype.setRetainondelete = function(value) {
+  jspb.Message.setProto3BooleanField(this, 22, value);
+};
+
+
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -2131,7 +2258,8 @@ proto.pulumirpc.RegisterResourceResponse.toObject = function(includeInstance, ms
     id: jspb.Message.getFieldWithDefault(msg, 2, ""),
     object: (f = msg.getObject()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
     stable: jspb.Message.getFieldWithDefault(msg, 4, false),
-    stablesList: jspb.Message.getRepeatedField(msg, 5)
+    stablesList: jspb.Message.getRepeatedField(msg, 5),
+    propertydependenciesMap: (f = msg.getPropertydependenciesMap()) ? f.toObject(includeInstance, proto.pulumirpc.RegisterResourceResponse.PropertyDependencies.toObject) : []
   };
 
   if (includeInstance) {
@@ -2189,6 +2317,12 @@ proto.pulumirpc.RegisterResourceResponse.deserializeBinaryFromReader = function(
       var value = /** @type {string} */ (reader.readString());
       msg.addStables(value);
       break;
+    case 6:
+      var value = msg.getPropertydependenciesMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.pulumirpc.RegisterResourceResponse.PropertyDependencies.deserializeBinaryFromReader);
+         });
+      break;
     default:
       reader.skipField();
       break;
@@ -2254,6 +2388,173 @@ proto.pulumirpc.RegisterResourceResponse.serializeBinaryToWriter = function(mess
       f
     );
   }
+  f = message.getPropertydependenciesMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(6, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.pulumirpc.RegisterResourceResponse.PropertyDependencies.serializeBinaryToWriter);
+  }
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pulumirpc.RegisterResourceResponse.PropertyDependencies = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pulumirpc.RegisterResourceResponse.PropertyDependencies.repeatedFields_, null);
+};
+goog.inherits(proto.pulumirpc.RegisterResourceResponse.PropertyDependencies, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.pulumirpc.RegisterResourceResponse.PropertyDependencies.displayName = 'proto.pulumirpc.RegisterResourceResponse.PropertyDependencies';
+}
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pulumirpc.RegisterResourceResponse.PropertyDependencies.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.RegisterResourceResponse.PropertyDependencies.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.RegisterResourceResponse.PropertyDependencies.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.RegisterResourceResponse.PropertyDependencies} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.RegisterResourceResponse.PropertyDependencies.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    urnsList: jspb.Message.getRepeatedField(msg, 1)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.RegisterResourceResponse.PropertyDependencies}
+ */
+proto.pulumirpc.RegisterResourceResponse.PropertyDependencies.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.RegisterResourceResponse.PropertyDependencies;
+  return proto.pulumirpc.RegisterResourceResponse.PropertyDependencies.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.RegisterResourceResponse.PropertyDependencies} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.RegisterResourceResponse.PropertyDependencies}
+ */
+proto.pulumirpc.RegisterResourceResponse.PropertyDependencies.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addUrns(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.RegisterResourceResponse.PropertyDependencies.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.RegisterResourceResponse.PropertyDependencies.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.RegisterResourceResponse.PropertyDependencies} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.RegisterResourceResponse.PropertyDependencies.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUrnsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated string urns = 1;
+ * @return {!Array.<string>}
+ */
+proto.pulumirpc.RegisterResourceResponse.PropertyDependencies.prototype.getUrnsList = function() {
+  return /** @type {!Array.<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/** @param {!Array.<string>} value */
+proto.pulumirpc.RegisterResourceResponse.PropertyDependencies.prototype.setUrnsList = function(value) {
+  jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {!string} value
+ * @param {number=} opt_index
+ */
+proto.pulumirpc.RegisterResourceResponse.PropertyDependencies.prototype.addUrns = function(value, opt_index) {
+  jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+proto.pulumirpc.RegisterResourceResponse.PropertyDependencies.prototype.clearUrnsList = function() {
+  this.setUrnsList([]);
 };
 
 
@@ -2363,6 +2664,24 @@ proto.pulumirpc.RegisterResourceResponse.prototype.clearStablesList = function()
 };
 
 
+/**
+ * map<string, PropertyDependencies> propertyDependencies = 6;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.pulumirpc.RegisterResourceResponse.PropertyDependencies>}
+ */
+proto.pulumirpc.RegisterResourceResponse.prototype.getPropertydependenciesMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.pulumirpc.RegisterResourceResponse.PropertyDependencies>} */ (
+      jspb.Message.getMapField(this, 6, opt_noLazyCreate,
+      proto.pulumirpc.RegisterResourceResponse.PropertyDependencies));
+};
+
+
+proto.pulumirpc.RegisterResourceResponse.prototype.clearPropertydependenciesMap = function() {
+  this.getPropertydependenciesMap().clear();
+};
+
+
 
 /**
  * Generated by JsPbCodeGenerator.
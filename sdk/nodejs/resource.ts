@@ -523,6 +523,11 @@ export interface CustomTimeouts {
      * The optional delete timeout represented as a string e.g. 5m, 40s, 1d.
      */
     delete?: string;
+    /**
+     * The optional read timeout represented as a string e.g. 5m, 40s, 1d. Reads that do not complete in time
+     * during a refresh are cancelled.
+     */
+    read?: string;
 }
 
 /**
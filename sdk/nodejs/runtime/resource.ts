@@ -200,6 +200,7 @@ export function registerResource(res: Resource, t: string, name: string, custom:
             customTimeouts.setCreate(opts.customTimeouts.create);
             customTimeouts.setUpdate(opts.customTimeouts.update);
             customTimeouts.setDelete(opts.customTimeouts.delete);
+            customTimeouts.setRead(opts.customTimeouts.read);
         }
         req.setCustomtimeouts(customTimeouts);
 
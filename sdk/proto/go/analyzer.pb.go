@@ -346,6 +346,116 @@ func (m *AnalyzeDiagnostic) GetUrn() string {
 	return ""
 }
 
+// Remediation is a single resource remediation result.
+type Remediation struct {
+	PolicyName           string          `protobuf:"bytes,1,opt,name=policyName" json:"policyName,omitempty"`
+	PolicyPackName       string          `protobuf:"bytes,2,opt,name=policyPackName" json:"policyPackName,omitempty"`
+	PolicyPackVersion    string          `protobuf:"bytes,3,opt,name=policyPackVersion" json:"policyPackVersion,omitempty"`
+	Description          string          `protobuf:"bytes,4,opt,name=description" json:"description,omitempty"`
+	Properties           *_struct.Struct `protobuf:"bytes,5,opt,name=properties" json:"properties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Remediation) Reset()         { *m = Remediation{} }
+func (m *Remediation) String() string { return proto.CompactTextString(m) }
+func (*Remediation) ProtoMessage()    {}
+func (*Remediation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_analyzer_a9c30ddfcaef9aa8, []int{5}
+}
+func (m *Remediation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Remediation.Unmarshal(m, b)
+}
+func (m *Remediation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Remediation.Marshal(b, m, deterministic)
+}
+func (dst *Remediation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Remediation.Merge(dst, src)
+}
+func (m *Remediation) XXX_Size() int {
+	return xxx_messageInfo_Remediation.Size(m)
+}
+func (m *Remediation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Remediation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Remediation proto.InternalMessageInfo
+
+func (m *Remediation) GetPolicyName() string {
+	if m != nil {
+		return m.PolicyName
+	}
+	return ""
+}
+
+func (m *Remediation) GetPolicyPackName() string {
+	if m != nil {
+		return m.PolicyPackName
+	}
+	return ""
+}
+
+func (m *Remediation) GetPolicyPackVersion() string {
+	if m != nil {
+		return m.PolicyPackVersion
+	}
+	return ""
+}
+
+func (m *Remediation) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Remediation) GetProperties() *_struct.Struct {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+// RemediateResponse contains a sequence of remediations applied, in order.
+type RemediateResponse struct {
+	Remediations         []*Remediation `protobuf:"bytes,1,rep,name=remediations" json:"remediations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RemediateResponse) Reset()         { *m = RemediateResponse{} }
+func (m *RemediateResponse) String() string { return proto.CompactTextString(m) }
+func (*RemediateResponse) ProtoMessage()    {}
+func (*RemediateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_analyzer_a9c30ddfcaef9aa8, []int{6}
+}
+func (m *RemediateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemediateResponse.Unmarshal(m, b)
+}
+func (m *RemediateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemediateResponse.Marshal(b, m, deterministic)
+}
+func (dst *RemediateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemediateResponse.Merge(dst, src)
+}
+func (m *RemediateResponse) XXX_Size() int {
+	return xxx_messageInfo_RemediateResponse.Size(m)
+}
+func (m *RemediateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemediateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemediateResponse proto.InternalMessageInfo
+
+func (m *RemediateResponse) GetRemediations() []*Remediation {
+	if m != nil {
+		return m.Remediations
+	}
+	return nil
+}
+
 // AnalyzerInfo provides metadata about a PolicyPack inside an analyzer.
 type AnalyzerInfo struct {
 	Name                 string        `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
@@ -360,7 +470,7 @@ func (m *AnalyzerInfo) Reset()         { *m = AnalyzerInfo{} }
 func (m *AnalyzerInfo) String() string { return proto.CompactTextString(m) }
 func (*AnalyzerInfo) ProtoMessage()    {}
 func (*AnalyzerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_analyzer_a9c30ddfcaef9aa8, []int{5}
+	return fileDescriptor_analyzer_a9c30ddfcaef9aa8, []int{7}
 }
 func (m *AnalyzerInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AnalyzerInfo.Unmarshal(m, b)
@@ -417,7 +527,7 @@ func (m *PolicyInfo) Reset()         { *m = PolicyInfo{} }
 func (m *PolicyInfo) String() string { return proto.CompactTextString(m) }
 func (*PolicyInfo) ProtoMessage()    {}
 func (*PolicyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_analyzer_a9c30ddfcaef9aa8, []int{6}
+	return fileDescriptor_analyzer_a9c30ddfcaef9aa8, []int{8}
 }
 func (m *PolicyInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PolicyInfo.Unmarshal(m, b)
@@ -478,6 +588,8 @@ func init() {
 	proto.RegisterType((*AnalyzeStackRequest)(nil), "pulumirpc.AnalyzeStackRequest")
 	proto.RegisterType((*AnalyzeResponse)(nil), "pulumirpc.AnalyzeResponse")
 	proto.RegisterType((*AnalyzeDiagnostic)(nil), "pulumirpc.AnalyzeDiagnostic")
+	proto.RegisterType((*Remediation)(nil), "pulumirpc.Remediation")
+	proto.RegisterType((*RemediateResponse)(nil), "pulumirpc.RemediateResponse")
 	proto.RegisterType((*AnalyzerInfo)(nil), "pulumirpc.AnalyzerInfo")
 	proto.RegisterType((*PolicyInfo)(nil), "pulumirpc.PolicyInfo")
 	proto.RegisterEnum("pulumirpc.EnforcementLevel", EnforcementLevel_name, EnforcementLevel_value)
@@ -501,6 +613,10 @@ type AnalyzerClient interface {
 	// preview or update. The provided resources are the "outputs", after any mutations
 	// have taken place.
 	AnalyzeStack(ctx context.Context, in *AnalyzeStackRequest, opts ...grpc.CallOption) (*AnalyzeResponse, error)
+	// Remediate is given the opportunity to transform a single resource's inputs before they are
+	// checked by its provider. It returns the remediations that were applied, if any, each of which
+	// carries the full set of transformed properties.
+	Remediate(ctx context.Context, in *AnalyzeRequest, opts ...grpc.CallOption) (*RemediateResponse, error)
 	// GetAnalyzerInfo returns metadata about the analyzer (e.g., list of policies contained).
 	GetAnalyzerInfo(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*AnalyzerInfo, error)
 	// GetPluginInfo returns generic information about this plugin, like its version.
@@ -533,6 +649,15 @@ func (c *analyzerClient) AnalyzeStack(ctx context.Context, in *AnalyzeStackReque
 	return out, nil
 }
 
+func (c *analyzerClient) Remediate(ctx context.Context, in *AnalyzeRequest, opts ...grpc.CallOption) (*RemediateResponse, error) {
+	out := new(RemediateResponse)
+	err := grpc.Invoke(ctx, "/pulumirpc.Analyzer/Remediate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *analyzerClient) GetAnalyzerInfo(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*AnalyzerInfo, error) {
 	out := new(AnalyzerInfo)
 	err := grpc.Invoke(ctx, "/pulumirpc.Analyzer/GetAnalyzerInfo", in, out, c.cc, opts...)
@@ -561,6 +686,10 @@ type AnalyzerServer interface {
 	// preview or update. The provided resources are the "outputs", after any mutations
 	// have taken place.
 	AnalyzeStack(context.Context, *AnalyzeStackRequest) (*AnalyzeResponse, error)
+	// Remediate is given the opportunity to transform a single resource's inputs before they are
+	// checked by its provider. It returns the remediations that were applied, if any, each of which
+	// carries the full set of transformed properties.
+	Remediate(context.Context, *AnalyzeRequest) (*RemediateResponse, error)
 	// GetAnalyzerInfo returns metadata about the analyzer (e.g., list of policies contained).
 	GetAnalyzerInfo(context.Context, *empty.Empty) (*AnalyzerInfo, error)
 	// GetPluginInfo returns generic information about this plugin, like its version.
@@ -607,6 +736,24 @@ func _Analyzer_AnalyzeStack_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Analyzer_Remediate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServer).Remediate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pulumirpc.Analyzer/Remediate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServer).Remediate(ctx, req.(*AnalyzeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Analyzer_GetAnalyzerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
@@ -655,6 +802,10 @@ var _Analyzer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AnalyzeStack",
 			Handler:    _Analyzer_AnalyzeStack_Handler,
 		},
+		{
+			MethodName: "Remediate",
+			Handler:    _Analyzer_Remediate_Handler,
+		},
 		{
 			MethodName: "GetAnalyzerInfo",
 			Handler:    _Analyzer_GetAnalyzerInfo_Handler,
@@ -671,43 +822,46 @@ var _Analyzer_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("analyzer.proto", fileDescriptor_analyzer_a9c30ddfcaef9aa8) }
 
 var fileDescriptor_analyzer_a9c30ddfcaef9aa8 = []byte{
-	// 593 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xc4, 0x54, 0xdf, 0x6e, 0xd3, 0x3e,
-	0x14, 0x6e, 0x9a, 0x6e, 0x6d, 0x4f, 0xbb, 0xae, 0xf3, 0x4f, 0x3f, 0x16, 0xba, 0x69, 0x8a, 0x72,
-	0x81, 0x2a, 0x84, 0x52, 0x51, 0x2e, 0x10, 0x37, 0x88, 0xa2, 0x4e, 0xd5, 0xa4, 0x31, 0x4a, 0x8a,
-	0x26, 0x71, 0x99, 0x65, 0x6e, 0x14, 0x2d, 0x8d, 0x8d, 0xed, 0x80, 0xca, 0x2d, 0xe2, 0x4d, 0x78,
-	0x04, 0xde, 0x81, 0xd7, 0x42, 0x76, 0xfe, 0x34, 0x4d, 0xaa, 0x21, 0xed, 0x86, 0x3b, 0xfb, 0x9c,
-	0xcf, 0x9f, 0x8f, 0xbf, 0xef, 0x1c, 0x43, 0xcf, 0x8d, 0xdc, 0x70, 0xfd, 0x0d, 0x33, 0x9b, 0x32,
-	0x22, 0x08, 0x6a, 0xd3, 0x38, 0x8c, 0x57, 0x01, 0xa3, 0xde, 0xa0, 0x4b, 0xc3, 0xd8, 0x0f, 0xa2,
-	0x24, 0x31, 0x38, 0xf1, 0x09, 0xf1, 0x43, 0x3c, 0x52, 0xbb, 0x9b, 0x78, 0x39, 0xc2, 0x2b, 0x2a,
-	0xd6, 0x69, 0xf2, 0xb4, 0x9c, 0xe4, 0x82, 0xc5, 0x9e, 0x48, 0xb2, 0xd6, 0x77, 0x0d, 0x7a, 0x93,
-	0xe4, 0x1a, 0x07, 0x7f, 0x8e, 0x31, 0x17, 0x08, 0x41, 0x43, 0xac, 0x29, 0x36, 0x34, 0x53, 0x1b,
-	0xb6, 0x1d, 0xb5, 0x46, 0x2f, 0x01, 0x28, 0x23, 0x14, 0x33, 0x11, 0x60, 0x6e, 0xd4, 0x4d, 0x6d,
-	0xd8, 0x19, 0x1f, 0xdb, 0x09, 0xb3, 0x9d, 0x31, 0xdb, 0x0b, 0xc5, 0xec, 0x14, 0xa0, 0xa8, 0x0f,
-	0x7a, 0xcc, 0x22, 0x43, 0x57, 0x5c, 0x72, 0x29, 0xe9, 0x23, 0x77, 0x85, 0x8d, 0x46, 0x42, 0x2f,
-	0xd7, 0xd6, 0x0f, 0x0d, 0xfa, 0x69, 0x15, 0xcc, 0xc1, 0x9c, 0xc4, 0xcc, 0xc3, 0xff, 0xa2, 0x8e,
-	0x39, 0xfc, 0x97, 0x96, 0xb1, 0x10, 0xae, 0x77, 0x97, 0x29, 0xf2, 0x0a, 0xda, 0x2c, 0xad, 0x8a,
-	0x1b, 0x9a, 0xa9, 0x0f, 0x3b, 0xe3, 0x13, 0x3b, 0x37, 0xc3, 0x2e, 0x57, 0xee, 0x6c, 0xd0, 0xd6,
-	0x07, 0x38, 0xcc, 0xe5, 0xe5, 0x94, 0x44, 0x1c, 0xa3, 0xd7, 0xd0, 0xb9, 0x0d, 0x5c, 0x3f, 0x22,
-	0x5c, 0x04, 0x9e, 0x7c, 0x84, 0xe4, 0x3b, 0xad, 0xf2, 0x4d, 0x73, 0x90, 0x53, 0x3c, 0x60, 0xfd,
-	0xaa, 0xc3, 0x51, 0x05, 0x82, 0xce, 0x00, 0x28, 0x09, 0x03, 0x6f, 0x7d, 0x25, 0x1f, 0x95, 0x68,
-	0x56, 0x88, 0xa0, 0x27, 0xd0, 0x4b, 0x76, 0x73, 0xd7, 0xbb, 0x53, 0x98, 0xba, 0xc2, 0x94, 0xa2,
-	0xe8, 0x19, 0x1c, 0x6d, 0x22, 0xd7, 0x98, 0xf1, 0x80, 0x64, 0xb2, 0x55, 0x13, 0xc8, 0x84, 0xce,
-	0x2d, 0xe6, 0x1e, 0x0b, 0xa8, 0x90, 0xb8, 0x44, 0xcb, 0x62, 0x08, 0x19, 0xd0, 0x5c, 0x61, 0xce,
-	0x5d, 0x1f, 0x1b, 0x7b, 0x2a, 0x9b, 0x6d, 0x95, 0xbf, 0xae, 0xcf, 0x8d, 0x7d, 0x53, 0x57, 0xfe,
-	0xba, 0x3e, 0x47, 0x33, 0xe8, 0xe3, 0x68, 0x49, 0x98, 0x87, 0x57, 0x38, 0x12, 0x97, 0xf8, 0x0b,
-	0x0e, 0x8d, 0xa6, 0xa9, 0x0d, 0x7b, 0x5b, 0x82, 0x9f, 0x97, 0x20, 0x4e, 0xe5, 0x50, 0xe6, 0x77,
-	0x2b, 0xf7, 0xdb, 0xfa, 0x0a, 0xdd, 0xcc, 0xa8, 0x8b, 0x68, 0x49, 0x72, 0xff, 0xb5, 0x8d, 0xff,
-	0xea, 0x39, 0x01, 0xa7, 0xa1, 0xbb, 0x2e, 0x28, 0x54, 0x0c, 0xa1, 0xe7, 0xd0, 0x52, 0x2a, 0xc8,
-	0xf6, 0xd3, 0x95, 0x73, 0xff, 0x17, 0x0a, 0x9b, 0x2b, 0x81, 0x24, 0xbd, 0x93, 0xc3, 0xac, 0xdf,
-	0x1a, 0xc0, 0x26, 0xf1, 0xc0, 0x7b, 0x4b, 0x42, 0xeb, 0xf7, 0x0a, 0xdd, 0xd8, 0x16, 0x7a, 0x97,
-	0xa8, 0x7b, 0x0f, 0x10, 0xf5, 0xe9, 0x08, 0xfa, 0x65, 0x14, 0xea, 0x42, 0x6b, 0x32, 0xbd, 0xbe,
-	0x58, 0xbc, 0x77, 0x3e, 0xf5, 0x6b, 0xe8, 0x00, 0xda, 0xef, 0x26, 0x57, 0xd3, 0xc9, 0x47, 0xb9,
-	0xd5, 0xc6, 0x3f, 0xeb, 0xd0, 0xca, 0x44, 0x47, 0x6f, 0xa1, 0x99, 0xae, 0xd1, 0xe3, 0x6a, 0xb7,
-	0xa7, 0xb3, 0x36, 0x18, 0xec, 0x4a, 0x25, 0x93, 0x63, 0xd5, 0xd0, 0x65, 0x6e, 0xa2, 0x1a, 0x50,
-	0x74, 0x56, 0x45, 0x17, 0x27, 0xf7, 0x2f, 0x6c, 0x53, 0x38, 0x9c, 0x61, 0xb1, 0xd5, 0x15, 0x8f,
-	0x2a, 0x9f, 0xc9, 0xb9, 0xfc, 0x4b, 0x07, 0xc7, 0x3b, 0xe6, 0x5d, 0x1e, 0xb0, 0x6a, 0xe8, 0x0d,
-	0x1c, 0xcc, 0xb0, 0x98, 0xab, 0x0f, 0xf9, 0x5e, 0x8e, 0xad, 0x4e, 0xc9, 0xe1, 0x56, 0xed, 0x66,
-	0x5f, 0x01, 0x5f, 0xfc, 0x09, 0x00, 0x00, 0xff, 0xff, 0x63, 0x35, 0xde, 0x7f, 0xf1, 0x05, 0x00,
-	0x00,
+	// 649 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xcc, 0x55, 0xdd, 0x6e, 0xd3, 0x30,
+	0x14, 0x6e, 0xda, 0xfd, 0xb4, 0xa7, 0x5d, 0xd7, 0x19, 0xb1, 0x85, 0x6e, 0x9a, 0xaa, 0x5c, 0xa0,
+	0x0a, 0xa1, 0x4e, 0x8c, 0x0b, 0x04, 0x17, 0x88, 0xa2, 0x8e, 0x69, 0xd2, 0xd8, 0x4a, 0x86, 0x26,
+	0x71, 0xe9, 0x65, 0x67, 0x51, 0xb4, 0x24, 0x36, 0xb6, 0x03, 0x2a, 0xb7, 0x88, 0x37, 0xe2, 0x1d,
+	0x78, 0x0b, 0x9e, 0x80, 0x87, 0x40, 0x71, 0x9a, 0xd4, 0x6d, 0xa6, 0x81, 0x76, 0x03, 0x77, 0xb6,
+	0xcf, 0xe7, 0xef, 0x9c, 0xf3, 0x9d, 0x2f, 0x0e, 0xb4, 0x69, 0x4c, 0xc3, 0xc9, 0x17, 0x14, 0x03,
+	0x2e, 0x98, 0x62, 0xa4, 0xc1, 0x93, 0x30, 0x89, 0x02, 0xc1, 0xbd, 0x6e, 0x8b, 0x87, 0x89, 0x1f,
+	0xc4, 0x59, 0xa0, 0xbb, 0xed, 0x33, 0xe6, 0x87, 0xb8, 0xa7, 0x77, 0x17, 0xc9, 0xd5, 0x1e, 0x46,
+	0x5c, 0x4d, 0xa6, 0xc1, 0x9d, 0xc5, 0xa0, 0x54, 0x22, 0xf1, 0x54, 0x16, 0x75, 0xbe, 0x5a, 0xd0,
+	0x1e, 0x66, 0x69, 0x5c, 0xfc, 0x98, 0xa0, 0x54, 0x84, 0xc0, 0x92, 0x9a, 0x70, 0xb4, 0xad, 0x9e,
+	0xd5, 0x6f, 0xb8, 0x7a, 0x4d, 0x9e, 0x01, 0x70, 0xc1, 0x38, 0x0a, 0x15, 0xa0, 0xb4, 0xab, 0x3d,
+	0xab, 0xdf, 0xdc, 0xdf, 0x1a, 0x64, 0xcc, 0x83, 0x9c, 0x79, 0x70, 0xa6, 0x99, 0x5d, 0x03, 0x4a,
+	0x3a, 0x50, 0x4b, 0x44, 0x6c, 0xd7, 0x34, 0x57, 0xba, 0x4c, 0xe9, 0x63, 0x1a, 0xa1, 0xbd, 0x94,
+	0xd1, 0xa7, 0x6b, 0xe7, 0x9b, 0x05, 0x9d, 0x69, 0x15, 0xc2, 0x45, 0xc9, 0x12, 0xe1, 0xe1, 0xbf,
+	0xa8, 0x63, 0x0c, 0xf7, 0xa6, 0x65, 0x9c, 0x29, 0xea, 0x5d, 0xe7, 0x8a, 0x3c, 0x87, 0x86, 0x98,
+	0x56, 0x25, 0x6d, 0xab, 0x57, 0xeb, 0x37, 0xf7, 0xb7, 0x07, 0xc5, 0x30, 0x06, 0x8b, 0x95, 0xbb,
+	0x33, 0xb4, 0xf3, 0x0e, 0xd6, 0x0b, 0x79, 0x25, 0x67, 0xb1, 0x44, 0xf2, 0x12, 0x9a, 0x97, 0x01,
+	0xf5, 0x63, 0x26, 0x55, 0xe0, 0xa5, 0x4d, 0xa4, 0x7c, 0x3b, 0x65, 0xbe, 0x51, 0x01, 0x72, 0xcd,
+	0x0b, 0xce, 0xf7, 0x2a, 0x6c, 0x94, 0x20, 0x64, 0x17, 0x80, 0xb3, 0x30, 0xf0, 0x26, 0x27, 0x34,
+	0xca, 0x35, 0x33, 0x4e, 0xc8, 0x43, 0x68, 0x67, 0xbb, 0x31, 0xf5, 0xae, 0x35, 0xa6, 0xaa, 0x31,
+	0x0b, 0xa7, 0xe4, 0x31, 0x6c, 0xcc, 0x4e, 0xce, 0x51, 0xc8, 0x80, 0xe5, 0xb2, 0x95, 0x03, 0xa4,
+	0x07, 0xcd, 0x4b, 0x94, 0x9e, 0x08, 0xb8, 0x4a, 0x71, 0x99, 0x96, 0xe6, 0x11, 0xb1, 0x61, 0x35,
+	0x42, 0x29, 0xa9, 0x8f, 0xf6, 0xb2, 0x8e, 0xe6, 0x5b, 0x3d, 0x5f, 0xea, 0x4b, 0x7b, 0xa5, 0x57,
+	0xd3, 0xf3, 0xa5, 0xbe, 0x24, 0x87, 0xd0, 0xc1, 0xf8, 0x8a, 0x09, 0x0f, 0x23, 0x8c, 0xd5, 0x31,
+	0x7e, 0xc2, 0xd0, 0x5e, 0xed, 0x59, 0xfd, 0xf6, 0x9c, 0xe0, 0x07, 0x0b, 0x10, 0xb7, 0x74, 0x29,
+	0x9f, 0x77, 0xbd, 0x98, 0xb7, 0xf3, 0xd3, 0x82, 0xa6, 0x8b, 0x11, 0x5e, 0x06, 0x54, 0x17, 0xf6,
+	0xbf, 0x0a, 0x36, 0x6f, 0xf1, 0xe5, 0xbf, 0xb6, 0xb8, 0x73, 0x0a, 0x1b, 0x79, 0x7f, 0x33, 0xb3,
+	0xbd, 0x80, 0x96, 0x98, 0x35, 0x9d, 0xbb, 0x77, 0xd3, 0x10, 0xd3, 0xd0, 0xc4, 0x9d, 0xc3, 0x3a,
+	0x9f, 0xa1, 0x95, 0x5b, 0xfb, 0x28, 0xbe, 0x62, 0xc5, 0x17, 0x63, 0xcd, 0xbe, 0x18, 0xdd, 0x4f,
+	0x20, 0x79, 0x48, 0x27, 0x86, 0x44, 0xe6, 0x11, 0x79, 0x02, 0x75, 0x2d, 0x43, 0xda, 0x4d, 0x4d,
+	0x67, 0xbf, 0x6f, 0x64, 0x1f, 0x6b, 0x85, 0x52, 0x7a, 0xb7, 0x80, 0x39, 0x3f, 0x2c, 0x80, 0x59,
+	0xe0, 0x8e, 0x79, 0x17, 0x94, 0xae, 0xdd, 0x6a, 0xcd, 0xa5, 0x79, 0x6b, 0xde, 0x64, 0xc3, 0xe5,
+	0x3b, 0xd8, 0xf0, 0xd1, 0x1e, 0x74, 0x16, 0x51, 0xa4, 0x05, 0xf5, 0xe1, 0xe8, 0xfc, 0xe8, 0xec,
+	0xd4, 0xfd, 0xd0, 0xa9, 0x90, 0x35, 0x68, 0xbc, 0x1d, 0x9e, 0x8c, 0x86, 0xef, 0xd3, 0xad, 0xb5,
+	0xff, 0xab, 0x0a, 0xf5, 0x5c, 0x74, 0xf2, 0x1a, 0x56, 0xa7, 0x6b, 0xf2, 0xa0, 0xfc, 0x3e, 0x4c,
+	0x5f, 0xa7, 0x6e, 0xf7, 0xa6, 0x50, 0x36, 0x7e, 0xa7, 0x42, 0x8e, 0xa1, 0x65, 0x3e, 0x69, 0x64,
+	0xb7, 0x8c, 0x36, 0xdf, 0xba, 0x3f, 0xb0, 0xbd, 0x81, 0x46, 0xe1, 0xb1, 0xdb, 0x6a, 0xda, 0xb9,
+	0xc1, 0x60, 0x26, 0xcf, 0x08, 0xd6, 0x0f, 0x51, 0xcd, 0xb9, 0x6b, 0xb3, 0xe4, 0xf1, 0x83, 0xf4,
+	0x2f, 0xd6, 0xdd, 0x2a, 0x67, 0xd1, 0x17, 0x9c, 0x0a, 0x79, 0x05, 0x6b, 0x87, 0xa8, 0xc6, 0xfa,
+	0x57, 0x78, 0x2b, 0xc7, 0x9c, 0xe3, 0x0a, 0xb8, 0x53, 0xb9, 0x58, 0xd1, 0xc0, 0xa7, 0xbf, 0x07,
+	0x00, 0x93, 0x17, 0x49, 0x0b, 0x6b, 0x07, 0x00, 0x00,
 }
@@ -57,7 +57,7 @@ func (x PropertyDiff_Kind) String() string {
 	return proto.EnumName(PropertyDiff_Kind_name, int32(x))
 }
 func (PropertyDiff_Kind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_provider_766486f54fa2871e, []int{9, 0}
+	return fileDescriptor_provider_766486f54fa2871e, []int{11, 0}
 }
 
 type DiffResponse_DiffChanges int32
@@ -83,7 +83,83 @@ func (x DiffResponse_DiffChanges) String() string {
 	return proto.EnumName(DiffResponse_DiffChanges_name, int32(x))
 }
 func (DiffResponse_DiffChanges) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_provider_766486f54fa2871e, []int{10, 0}
+	return fileDescriptor_provider_766486f54fa2871e, []int{12, 0}
+}
+
+type GetSchemaRequest struct {
+	Version              int32    `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSchemaRequest) Reset()         { *m = GetSchemaRequest{} }
+func (m *GetSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSchemaRequest) ProtoMessage()    {}
+func (*GetSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_766486f54fa2871e, []int{0}
+}
+func (m *GetSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSchemaRequest.Unmarshal(m, b)
+}
+func (m *GetSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSchemaRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSchemaRequest.Merge(dst, src)
+}
+func (m *GetSchemaRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSchemaRequest.Size(m)
+}
+func (m *GetSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSchemaRequest proto.InternalMessageInfo
+
+func (m *GetSchemaRequest) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type GetSchemaResponse struct {
+	Schema               string   `protobuf:"bytes,1,opt,name=schema" json:"schema,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSchemaResponse) Reset()         { *m = GetSchemaResponse{} }
+func (m *GetSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSchemaResponse) ProtoMessage()    {}
+func (*GetSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_766486f54fa2871e, []int{1}
+}
+func (m *GetSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSchemaResponse.Unmarshal(m, b)
+}
+func (m *GetSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSchemaResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSchemaResponse.Merge(dst, src)
+}
+func (m *GetSchemaResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSchemaResponse.Size(m)
+}
+func (m *GetSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSchemaResponse proto.InternalMessageInfo
+
+func (m *GetSchemaResponse) GetSchema() string {
+	if m != nil {
+		return m.Schema
+	}
+	return ""
 }
 
 type ConfigureRequest struct {
@@ -99,7 +175,7 @@ func (m *ConfigureRequest) Reset()         { *m = ConfigureRequest{} }
 func (m *ConfigureRequest) String() string { return proto.CompactTextString(m) }
 func (*ConfigureRequest) ProtoMessage()    {}
 func (*ConfigureRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_766486f54fa2871e, []int{0}
+	return fileDescriptor_provider_766486f54fa2871e, []int{2}
 }
 func (m *ConfigureRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigureRequest.Unmarshal(m, b)
@@ -151,7 +227,7 @@ func (m *ConfigureResponse) Reset()         { *m = ConfigureResponse{} }
 func (m *ConfigureResponse) String() string { return proto.CompactTextString(m) }
 func (*ConfigureResponse) ProtoMessage()    {}
 func (*ConfigureResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_766486f54fa2871e, []int{1}
+	return fileDescriptor_provider_766486f54fa2871e, []int{3}
 }
 func (m *ConfigureResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigureResponse.Unmarshal(m, b)
@@ -190,7 +266,7 @@ func (m *ConfigureErrorMissingKeys) Reset()         { *m = ConfigureErrorMissing
 func (m *ConfigureErrorMissingKeys) String() string { return proto.CompactTextString(m) }
 func (*ConfigureErrorMissingKeys) ProtoMessage()    {}
 func (*ConfigureErrorMissingKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_766486f54fa2871e, []int{2}
+	return fileDescriptor_provider_766486f54fa2871e, []int{4}
 }
 func (m *ConfigureErrorMissingKeys) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigureErrorMissingKeys.Unmarshal(m, b)
@@ -229,7 +305,7 @@ func (m *ConfigureErrorMissingKeys_MissingKey) Reset()         { *m = ConfigureE
 func (m *ConfigureErrorMissingKeys_MissingKey) String() string { return proto.CompactTextString(m) }
 func (*ConfigureErrorMissingKeys_MissingKey) ProtoMessage()    {}
 func (*ConfigureErrorMissingKeys_MissingKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_766486f54fa2871e, []int{2, 0}
+	return fileDescriptor_provider_766486f54fa2871e, []int{4, 0}
 }
 func (m *ConfigureErrorMissingKeys_MissingKey) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigureErrorMissingKeys_MissingKey.Unmarshal(m, b)
@@ -277,7 +353,7 @@ func (m *InvokeRequest) Reset()         { *m = InvokeRequest{} }
 func (m *InvokeRequest) String() string { return proto.CompactTextString(m) }
 func (*InvokeRequest) ProtoMessage()    {}
 func (*InvokeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_766486f54fa2871e, []int{3}
+	return fileDescriptor_provider_766486f54fa2871e, []int{5}
 }
 func (m *InvokeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InvokeRequest.Unmarshal(m, b)
@@ -337,7 +413,7 @@ func (m *InvokeResponse) Reset()         { *m = InvokeResponse{} }
 func (m *InvokeResponse) String() string { return proto.CompactTextString(m) }
 func (*InvokeResponse) ProtoMessage()    {}
 func (*InvokeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_766486f54fa2871e, []int{4}
+	return fileDescriptor_provider_766486f54fa2871e, []int{6}
 }
 func (m *InvokeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InvokeResponse.Unmarshal(m, b)
@@ -384,7 +460,7 @@ func (m *CheckRequest) Reset()         { *m = CheckRequest{} }
 func (m *CheckRequest) String() string { return proto.CompactTextString(m) }
 func (*CheckRequest) ProtoMessage()    {}
 func (*CheckRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_766486f54fa2871e, []int{5}
+	return fileDescriptor_provider_766486f54fa2871e, []int{7}
 }
 func (m *CheckRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CheckRequest.Unmarshal(m, b)
@@ -437,7 +513,7 @@ func (m *CheckResponse) Reset()         { *m = CheckResponse{} }
 func (m *CheckResponse) String() string { return proto.CompactTextString(m) }
 func (*CheckResponse) ProtoMessage()    {}
 func (*CheckResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_766486f54fa2871e, []int{6}
+	return fileDescriptor_provider_766486f54fa2871e, []int{8}
 }
 func (m *CheckResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CheckResponse.Unmarshal(m, b)
@@ -483,7 +559,7 @@ func (m *CheckFailure) Reset()         { *m = CheckFailure{} }
 func (m *CheckFailure) String() string { return proto.CompactTextString(m) }
 func (*CheckFailure) ProtoMessage()    {}
 func (*CheckFailure) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_766486f54fa2871e, []int{7}
+	return fileDescriptor_provider_766486f54fa2871e, []int{9}
 }
 func (m *CheckFailure) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CheckFailure.Unmarshal(m, b)
@@ -532,7 +608,7 @@ func (m *DiffRequest) Reset()         { *m = DiffRequest{} }
 func (m *DiffRequest) String() string { return proto.CompactTextString(m) }
 func (*DiffRequest) ProtoMessage()    {}
 func (*DiffRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_766486f54fa2871e, []int{8}
+	return fileDescriptor_provider_766486f54fa2871e, []int{10}
 }
 func (m *DiffRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DiffRequest.Unmarshal(m, b)
@@ -599,7 +675,7 @@ func (m *PropertyDiff) Reset()         { *m = PropertyDiff{} }
 func (m *PropertyDiff) String() string { return proto.CompactTextString(m) }
 func (*PropertyDiff) ProtoMessage()    {}
 func (*PropertyDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_766486f54fa2871e, []int{9}
+	return fileDescriptor_provider_766486f54fa2871e, []int{11}
 }
 func (m *PropertyDiff) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PropertyDiff.Unmarshal(m, b)
@@ -680,7 +756,7 @@ func (m *DiffResponse) Reset()         { *m = DiffResponse{} }
 func (m *DiffResponse) String() string { return proto.CompactTextString(m) }
 func (*DiffResponse) ProtoMessage()    {}
 func (*DiffResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_766486f54fa2871e, []int{10}
+	return fileDescriptor_provider_766486f54fa2871e, []int{12}
 }
 func (m *DiffResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DiffResponse.Unmarshal(m, b)
@@ -762,7 +838,7 @@ func (m *CreateRequest) Reset()         { *m = CreateRequest{} }
 func (m *CreateRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRequest) ProtoMessage()    {}
 func (*CreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_766486f54fa2871e, []int{11}
+	return fileDescriptor_provider_766486f54fa2871e, []int{13}
 }
 func (m *CreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateRequest.Unmarshal(m, b)
@@ -815,7 +891,7 @@ func (m *CreateResponse) Reset()         { *m = CreateResponse{} }
 func (m *CreateResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateResponse) ProtoMessage()    {}
 func (*CreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_766486f54fa2871e, []int{12}
+	return fileDescriptor_provider_766486f54fa2871e, []int{14}
 }
 func (m *CreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateResponse.Unmarshal(m, b)
@@ -863,7 +939,7 @@ func (m *ReadRequest) Reset()         { *m = ReadRequest{} }
 func (m *ReadRequest) String() string { return proto.CompactTextString(m) }
 func (*ReadRequest) ProtoMessage()    {}
 func (*ReadRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_766486f54fa2871e, []int{13}
+	return fileDescriptor_provider_766486f54fa2871e, []int{15}
 }
 func (m *ReadRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReadRequest.Unmarshal(m, b)
@@ -924,7 +1000,7 @@ func (m *ReadResponse) Reset()         { *m = ReadResponse{} }
 func (m *ReadResponse) String() string { return proto.CompactTextString(m) }
 func (*ReadResponse) ProtoMessage()    {}
 func (*ReadResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_766486f54fa2871e, []int{14}
+	return fileDescriptor_provider_766486f54fa2871e, []int{16}
 }
 func (m *ReadResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReadResponse.Unmarshal(m, b)
@@ -981,7 +1057,7 @@ func (m *UpdateRequest) Reset()         { *m = UpdateRequest{} }
 func (m *UpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateRequest) ProtoMessage()    {}
 func (*UpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_766486f54fa2871e, []int{15}
+	return fileDescriptor_provider_766486f54fa2871e, []int{17}
 }
 func (m *UpdateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateRequest.Unmarshal(m, b)
@@ -1054,7 +1130,7 @@ func (m *UpdateResponse) Reset()         { *m = UpdateResponse{} }
 func (m *UpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateResponse) ProtoMessage()    {}
 func (*UpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_766486f54fa2871e, []int{16}
+	return fileDescriptor_provider_766486f54fa2871e, []int{18}
 }
 func (m *UpdateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateResponse.Unmarshal(m, b)
@@ -1095,7 +1171,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_766486f54fa2871e, []int{17}
+	return fileDescriptor_provider_766486f54fa2871e, []int{19}
 }
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
@@ -1143,6 +1219,292 @@ func (m *DeleteRequest) GetTimeout() float64 {
 	return 0
 }
 
+// ConstructRequest is sent to a provider in order to construct a component resource on behalf of a program.
+type ConstructRequest struct {
+	Project              string                                            `protobuf:"bytes,1,opt,name=project" json:"project,omitempty"`
+	Stack                string                                            `protobuf:"bytes,2,opt,name=stack" json:"stack,omitempty"`
+	Config               map[string]string                                 `protobuf:"bytes,3,rep,name=config" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DryRun               bool                                              `protobuf:"varint,4,opt,name=dryRun" json:"dryRun,omitempty"`
+	Parallel             int32                                             `protobuf:"varint,5,opt,name=parallel" json:"parallel,omitempty"`
+	MonitorEndpoint      string                                            `protobuf:"bytes,6,opt,name=monitorEndpoint" json:"monitorEndpoint,omitempty"`
+	Type                 string                                            `protobuf:"bytes,7,opt,name=type" json:"type,omitempty"`
+	Name                 string                                            `protobuf:"bytes,8,opt,name=name" json:"name,omitempty"`
+	Parent               string                                            `protobuf:"bytes,9,opt,name=parent" json:"parent,omitempty"`
+	Inputs               *_struct.Struct                                   `protobuf:"bytes,10,opt,name=inputs" json:"inputs,omitempty"`
+	InputDependencies    map[string]*ConstructRequest_PropertyDependencies `protobuf:"bytes,11,rep,name=inputDependencies" json:"inputDependencies,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Protect              bool                                              `protobuf:"varint,12,opt,name=protect" json:"protect,omitempty"`
+	Providers            map[string]string                                 `protobuf:"bytes,13,rep,name=providers" json:"providers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Aliases              []string                                          `protobuf:"bytes,14,rep,name=aliases" json:"aliases,omitempty"`
+	Dependencies         []string                                          `protobuf:"bytes,15,rep,name=dependencies" json:"dependencies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                          `json:"-"`
+	XXX_unrecognized     []byte                                            `json:"-"`
+	XXX_sizecache        int32                                             `json:"-"`
+}
+
+func (m *ConstructRequest) Reset()         { *m = ConstructRequest{} }
+func (m *ConstructRequest) String() string { return proto.CompactTextString(m) }
+func (*ConstructRequest) ProtoMessage()    {}
+func (*ConstructRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_766486f54fa2871e, []int{20}
+}
+func (m *ConstructRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConstructRequest.Unmarshal(m, b)
+}
+func (m *ConstructRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConstructRequest.Marshal(b, m, deterministic)
+}
+func (dst *ConstructRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConstructRequest.Merge(dst, src)
+}
+func (m *ConstructRequest) XXX_Size() int {
+	return xxx_messageInfo_ConstructRequest.Size(m)
+}
+func (m *ConstructRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConstructRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConstructRequest proto.InternalMessageInfo
+
+func (m *ConstructRequest) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *ConstructRequest) GetStack() string {
+	if m != nil {
+		return m.Stack
+	}
+	return ""
+}
+
+func (m *ConstructRequest) GetConfig() map[string]string {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *ConstructRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *ConstructRequest) GetParallel() int32 {
+	if m != nil {
+		return m.Parallel
+	}
+	return 0
+}
+
+func (m *ConstructRequest) GetMonitorEndpoint() string {
+	if m != nil {
+		return m.MonitorEndpoint
+	}
+	return ""
+}
+
+func (m *ConstructRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ConstructRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ConstructRequest) GetParent() string {
+	if m != nil {
+		return m.Parent
+	}
+	return ""
+}
+
+func (m *ConstructRequest) GetInputs() *_struct.Struct {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+func (m *ConstructRequest) GetInputDependencies() map[string]*ConstructRequest_PropertyDependencies {
+	if m != nil {
+		return m.InputDependencies
+	}
+	return nil
+}
+
+func (m *ConstructRequest) GetProtect() bool {
+	if m != nil {
+		return m.Protect
+	}
+	return false
+}
+
+func (m *ConstructRequest) GetProviders() map[string]string {
+	if m != nil {
+		return m.Providers
+	}
+	return nil
+}
+
+func (m *ConstructRequest) GetAliases() []string {
+	if m != nil {
+		return m.Aliases
+	}
+	return nil
+}
+
+func (m *ConstructRequest) GetDependencies() []string {
+	if m != nil {
+		return m.Dependencies
+	}
+	return nil
+}
+
+// PropertyDependencies describes the resources that a particular property depends on.
+type ConstructRequest_PropertyDependencies struct {
+	Urns                 []string `protobuf:"bytes,1,rep,name=urns" json:"urns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConstructRequest_PropertyDependencies) Reset()         { *m = ConstructRequest_PropertyDependencies{} }
+func (m *ConstructRequest_PropertyDependencies) String() string { return proto.CompactTextString(m) }
+func (*ConstructRequest_PropertyDependencies) ProtoMessage()    {}
+func (*ConstructRequest_PropertyDependencies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_766486f54fa2871e, []int{20, 0}
+}
+func (m *ConstructRequest_PropertyDependencies) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConstructRequest_PropertyDependencies.Unmarshal(m, b)
+}
+func (m *ConstructRequest_PropertyDependencies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConstructRequest_PropertyDependencies.Marshal(b, m, deterministic)
+}
+func (dst *ConstructRequest_PropertyDependencies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConstructRequest_PropertyDependencies.Merge(dst, src)
+}
+func (m *ConstructRequest_PropertyDependencies) XXX_Size() int {
+	return xxx_messageInfo_ConstructRequest_PropertyDependencies.Size(m)
+}
+func (m *ConstructRequest_PropertyDependencies) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConstructRequest_PropertyDependencies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConstructRequest_PropertyDependencies proto.InternalMessageInfo
+
+func (m *ConstructRequest_PropertyDependencies) GetUrns() []string {
+	if m != nil {
+		return m.Urns
+	}
+	return nil
+}
+
+// ConstructResponse is returned by a provider after it has constructed a component resource.
+type ConstructResponse struct {
+	Urn                  string                                             `protobuf:"bytes,1,opt,name=urn" json:"urn,omitempty"`
+	State                *_struct.Struct                                    `protobuf:"bytes,2,opt,name=state" json:"state,omitempty"`
+	StateDependencies    map[string]*ConstructResponse_PropertyDependencies `protobuf:"bytes,3,rep,name=stateDependencies" json:"stateDependencies,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *ConstructResponse) Reset()         { *m = ConstructResponse{} }
+func (m *ConstructResponse) String() string { return proto.CompactTextString(m) }
+func (*ConstructResponse) ProtoMessage()    {}
+func (*ConstructResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_766486f54fa2871e, []int{21}
+}
+func (m *ConstructResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConstructResponse.Unmarshal(m, b)
+}
+func (m *ConstructResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConstructResponse.Marshal(b, m, deterministic)
+}
+func (dst *ConstructResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConstructResponse.Merge(dst, src)
+}
+func (m *ConstructResponse) XXX_Size() int {
+	return xxx_messageInfo_ConstructResponse.Size(m)
+}
+func (m *ConstructResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConstructResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConstructResponse proto.InternalMessageInfo
+
+func (m *ConstructResponse) GetUrn() string {
+	if m != nil {
+		return m.Urn
+	}
+	return ""
+}
+
+func (m *ConstructResponse) GetState() *_struct.Struct {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (m *ConstructResponse) GetStateDependencies() map[string]*ConstructResponse_PropertyDependencies {
+	if m != nil {
+		return m.StateDependencies
+	}
+	return nil
+}
+
+// PropertyDependencies describes the resources that a particular property depends on.
+type ConstructResponse_PropertyDependencies struct {
+	Urns                 []string `protobuf:"bytes,1,rep,name=urns" json:"urns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConstructResponse_PropertyDependencies) Reset() {
+	*m = ConstructResponse_PropertyDependencies{}
+}
+func (m *ConstructResponse_PropertyDependencies) String() string { return proto.CompactTextString(m) }
+func (*ConstructResponse_PropertyDependencies) ProtoMessage()    {}
+func (*ConstructResponse_PropertyDependencies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_766486f54fa2871e, []int{21, 0}
+}
+func (m *ConstructResponse_PropertyDependencies) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConstructResponse_PropertyDependencies.Unmarshal(m, b)
+}
+func (m *ConstructResponse_PropertyDependencies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConstructResponse_PropertyDependencies.Marshal(b, m, deterministic)
+}
+func (dst *ConstructResponse_PropertyDependencies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConstructResponse_PropertyDependencies.Merge(dst, src)
+}
+func (m *ConstructResponse_PropertyDependencies) XXX_Size() int {
+	return xxx_messageInfo_ConstructResponse_PropertyDependencies.Size(m)
+}
+func (m *ConstructResponse_PropertyDependencies) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConstructResponse_PropertyDependencies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConstructResponse_PropertyDependencies proto.InternalMessageInfo
+
+func (m *ConstructResponse_PropertyDependencies) GetUrns() []string {
+	if m != nil {
+		return m.Urns
+	}
+	return nil
+}
+
 // ErrorResourceInitFailed is sent as a Detail `ResourceProvider.{Create, Update}` fail because a
 // resource was created successfully, but failed to initialize.
 type ErrorResourceInitFailed struct {
@@ -1159,7 +1521,7 @@ func (m *ErrorResourceInitFailed) Reset()         { *m = ErrorResourceInitFailed
 func (m *ErrorResourceInitFailed) String() string { return proto.CompactTextString(m) }
 func (*ErrorResourceInitFailed) ProtoMessage()    {}
 func (*ErrorResourceInitFailed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_provider_766486f54fa2871e, []int{18}
+	return fileDescriptor_provider_766486f54fa2871e, []int{22}
 }
 func (m *ErrorResourceInitFailed) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ErrorResourceInitFailed.Unmarshal(m, b)
@@ -1208,6 +1570,8 @@ func (m *ErrorResourceInitFailed) GetInputs() *_struct.Struct {
 }
 
 func init() {
+	proto.RegisterType((*GetSchemaRequest)(nil), "pulumirpc.GetSchemaRequest")
+	proto.RegisterType((*GetSchemaResponse)(nil), "pulumirpc.GetSchemaResponse")
 	proto.RegisterType((*ConfigureRequest)(nil), "pulumirpc.ConfigureRequest")
 	proto.RegisterMapType((map[string]string)(nil), "pulumirpc.ConfigureRequest.VariablesEntry")
 	proto.RegisterType((*ConfigureResponse)(nil), "pulumirpc.ConfigureResponse")
@@ -1229,6 +1593,14 @@ func init() {
 	proto.RegisterType((*UpdateRequest)(nil), "pulumirpc.UpdateRequest")
 	proto.RegisterType((*UpdateResponse)(nil), "pulumirpc.UpdateResponse")
 	proto.RegisterType((*DeleteRequest)(nil), "pulumirpc.DeleteRequest")
+	proto.RegisterType((*ConstructRequest)(nil), "pulumirpc.ConstructRequest")
+	proto.RegisterMapType((map[string]string)(nil), "pulumirpc.ConstructRequest.ConfigEntry")
+	proto.RegisterMapType((map[string]*ConstructRequest_PropertyDependencies)(nil), "pulumirpc.ConstructRequest.InputDependenciesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "pulumirpc.ConstructRequest.ProvidersEntry")
+	proto.RegisterType((*ConstructRequest_PropertyDependencies)(nil), "pulumirpc.ConstructRequest.PropertyDependencies")
+	proto.RegisterType((*ConstructResponse)(nil), "pulumirpc.ConstructResponse")
+	proto.RegisterMapType((map[string]*ConstructResponse_PropertyDependencies)(nil), "pulumirpc.ConstructResponse.StateDependenciesEntry")
+	proto.RegisterType((*ConstructResponse_PropertyDependencies)(nil), "pulumirpc.ConstructResponse.PropertyDependencies")
 	proto.RegisterType((*ErrorResourceInitFailed)(nil), "pulumirpc.ErrorResourceInitFailed")
 	proto.RegisterEnum("pulumirpc.PropertyDiff_Kind", PropertyDiff_Kind_name, PropertyDiff_Kind_value)
 	proto.RegisterEnum("pulumirpc.DiffResponse_DiffChanges", DiffResponse_DiffChanges_name, DiffResponse_DiffChanges_value)
@@ -1245,6 +1617,8 @@ const _ = grpc.SupportPackageIsVersion4
 // Client API for ResourceProvider service
 
 type ResourceProviderClient interface {
+	// GetSchema fetches the schema for this resource provider.
+	GetSchema(ctx context.Context, in *GetSchemaRequest, opts ...grpc.CallOption) (*GetSchemaResponse, error)
 	// CheckConfig validates the configuration for this resource provider.
 	CheckConfig(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error)
 	// DiffConfig checks the impact a hypothetical change to this provider's configuration will have on the provider.
@@ -1274,6 +1648,8 @@ type ResourceProviderClient interface {
 	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
 	// Delete tears down an existing resource with the given ID.  If it fails, the resource is assumed to still exist.
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Construct creates a new instance of the provided component resource and returns its state.
+	Construct(ctx context.Context, in *ConstructRequest, opts ...grpc.CallOption) (*ConstructResponse, error)
 	// Cancel signals the provider to abort all outstanding resource operations.
 	Cancel(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
 	// GetPluginInfo returns generic information about this plugin, like its version.
@@ -1288,6 +1664,15 @@ func NewResourceProviderClient(cc *grpc.ClientConn) ResourceProviderClient {
 	return &resourceProviderClient{cc}
 }
 
+func (c *resourceProviderClient) GetSchema(ctx context.Context, in *GetSchemaRequest, opts ...grpc.CallOption) (*GetSchemaResponse, error) {
+	out := new(GetSchemaResponse)
+	err := grpc.Invoke(ctx, "/pulumirpc.ResourceProvider/GetSchema", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *resourceProviderClient) CheckConfig(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error) {
 	out := new(CheckResponse)
 	err := grpc.Invoke(ctx, "/pulumirpc.ResourceProvider/CheckConfig", in, out, c.cc, opts...)
@@ -1410,6 +1795,15 @@ func (c *resourceProviderClient) Delete(ctx context.Context, in *DeleteRequest,
 	return out, nil
 }
 
+func (c *resourceProviderClient) Construct(ctx context.Context, in *ConstructRequest, opts ...grpc.CallOption) (*ConstructResponse, error) {
+	out := new(ConstructResponse)
+	err := grpc.Invoke(ctx, "/pulumirpc.ResourceProvider/Construct", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *resourceProviderClient) Cancel(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := grpc.Invoke(ctx, "/pulumirpc.ResourceProvider/Cancel", in, out, c.cc, opts...)
@@ -1431,6 +1825,8 @@ func (c *resourceProviderClient) GetPluginInfo(ctx context.Context, in *empty.Em
 // Server API for ResourceProvider service
 
 type ResourceProviderServer interface {
+	// GetSchema fetches the schema for this resource provider.
+	GetSchema(context.Context, *GetSchemaRequest) (*GetSchemaResponse, error)
 	// CheckConfig validates the configuration for this resource provider.
 	CheckConfig(context.Context, *CheckRequest) (*CheckResponse, error)
 	// DiffConfig checks the impact a hypothetical change to this provider's configuration will have on the provider.
@@ -1460,6 +1856,8 @@ type ResourceProviderServer interface {
 	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
 	// Delete tears down an existing resource with the given ID.  If it fails, the resource is assumed to still exist.
 	Delete(context.Context, *DeleteRequest) (*empty.Empty, error)
+	// Construct creates a new instance of the provided component resource and returns its state.
+	Construct(context.Context, *ConstructRequest) (*ConstructResponse, error)
 	// Cancel signals the provider to abort all outstanding resource operations.
 	Cancel(context.Context, *empty.Empty) (*empty.Empty, error)
 	// GetPluginInfo returns generic information about this plugin, like its version.
@@ -1470,6 +1868,24 @@ func RegisterResourceProviderServer(s *grpc.Server, srv ResourceProviderServer)
 	s.RegisterService(&_ResourceProvider_serviceDesc, srv)
 }
 
+func _ResourceProvider_GetSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceProviderServer).GetSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pulumirpc.ResourceProvider/GetSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceProviderServer).GetSchema(ctx, req.(*GetSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ResourceProvider_CheckConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CheckRequest)
 	if err := dec(in); err != nil {
@@ -1671,6 +2087,24 @@ func _ResourceProvider_Delete_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ResourceProvider_Construct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConstructRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceProviderServer).Construct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pulumirpc.ResourceProvider/Construct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceProviderServer).Construct(ctx, req.(*ConstructRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ResourceProvider_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
@@ -1711,6 +2145,10 @@ var _ResourceProvider_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pulumirpc.ResourceProvider",
 	HandlerType: (*ResourceProviderServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSchema",
+			Handler:    _ResourceProvider_GetSchema_Handler,
+		},
 		{
 			MethodName: "CheckConfig",
 			Handler:    _ResourceProvider_CheckConfig_Handler,
@@ -1751,6 +2189,10 @@ var _ResourceProvider_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ResourceProvider_Delete_Handler,
 		},
+		{
+			MethodName: "Construct",
+			Handler:    _ResourceProvider_Construct_Handler,
+		},
 		{
 			MethodName: "Cancel",
 			Handler:    _ResourceProvider_Cancel_Handler,
@@ -1773,82 +2215,106 @@ var _ResourceProvider_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("provider.proto", fileDescriptor_provider_766486f54fa2871e) }
 
 var fileDescriptor_provider_766486f54fa2871e = []byte{
-	// 1221 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x72, 0x1b, 0xc5,
-	0x13, 0xd7, 0x6a, 0x65, 0xd9, 0x6a, 0x7d, 0x44, 0x99, 0xff, 0x9f, 0x58, 0x51, 0x7c, 0x50, 0x2d,
-	0x1c, 0x04, 0x14, 0xb2, 0xcb, 0x39, 0x40, 0x52, 0x4e, 0x05, 0xdb, 0x92, 0x83, 0xcb, 0xf1, 0x07,
-	0xeb, 0x98, 0x8f, 0x53, 0x58, 0x6b, 0x47, 0xf2, 0x96, 0x56, 0xbb, 0xcb, 0xec, 0xac, 0x28, 0x73,
-	0xe6, 0xc0, 0x2b, 0xf0, 0x10, 0x14, 0x55, 0x3c, 0x01, 0x77, 0x9e, 0x21, 0x8f, 0xc0, 0x3b, 0x50,
-	0xf3, 0xb1, 0xab, 0x19, 0x4b, 0x76, 0x64, 0x93, 0x82, 0xdb, 0xf4, 0x74, 0xcf, 0x74, 0xf7, 0x6f,
-	0xba, 0x7f, 0x33, 0x03, 0xb5, 0x88, 0x84, 0x13, 0xcf, 0xc5, 0xa4, 0x13, 0x91, 0x90, 0x86, 0xa8,
-	0x14, 0x25, 0x7e, 0x32, 0xf6, 0x48, 0xd4, 0x6f, 0x56, 0x22, 0x3f, 0x19, 0x7a, 0x81, 0x50, 0x34,
-	0x1f, 0x0d, 0xc3, 0x70, 0xe8, 0xe3, 0x75, 0x2e, 0x9d, 0x27, 0x83, 0x75, 0x3c, 0x8e, 0xe8, 0xa5,
-	0x54, 0xae, 0x5d, 0x55, 0xc6, 0x94, 0x24, 0x7d, 0x2a, 0xb4, 0xd6, 0x5f, 0x06, 0xd4, 0x77, 0xc3,
-	0x60, 0xe0, 0x0d, 0x13, 0x82, 0x6d, 0xfc, 0x7d, 0x82, 0x63, 0x8a, 0xbe, 0x80, 0xd2, 0xc4, 0x21,
-	0x9e, 0x73, 0xee, 0xe3, 0xb8, 0x61, 0xb4, 0xcc, 0x76, 0x79, 0xf3, 0xa3, 0x4e, 0xe6, 0xbc, 0x73,
-	0xd5, 0xbe, 0xf3, 0x55, 0x6a, 0xdc, 0x0b, 0x28, 0xb9, 0xb4, 0xa7, 0x8b, 0xd1, 0xc7, 0x50, 0x70,
-	0xc8, 0x30, 0x6e, 0xe4, 0x5b, 0x46, 0xbb, 0xbc, 0xb9, 0xda, 0x11, 0xb1, 0x74, 0xd2, 0x58, 0x3a,
-	0xa7, 0x3c, 0x16, 0x9b, 0x1b, 0xa1, 0x0f, 0xa0, 0xea, 0xf4, 0xfb, 0x38, 0xa2, 0xa7, 0xb8, 0x4f,
-	0x30, 0x8d, 0x1b, 0x66, 0xcb, 0x68, 0xaf, 0xd8, 0xfa, 0x64, 0x73, 0x0b, 0x6a, 0xba, 0x3f, 0x54,
-	0x07, 0x73, 0x84, 0x2f, 0x1b, 0x46, 0xcb, 0x68, 0x97, 0x6c, 0x36, 0x44, 0xff, 0x87, 0xa5, 0x89,
-	0xe3, 0x27, 0x98, 0xfb, 0x2d, 0xd9, 0x42, 0x78, 0x9a, 0xff, 0xcc, 0xb0, 0x9e, 0xc0, 0x7d, 0x25,
-	0xfc, 0x38, 0x0a, 0x83, 0x18, 0xcf, 0x3a, 0x36, 0xe6, 0x38, 0xb6, 0x7e, 0x37, 0xe0, 0x61, 0xb6,
-	0xb6, 0x47, 0x48, 0x48, 0x0e, 0xbd, 0x38, 0xf6, 0x82, 0xe1, 0x01, 0xbe, 0x8c, 0xd1, 0x97, 0x50,
-	0x1e, 0x4f, 0x45, 0x89, 0xda, 0xfa, 0x3c, 0xd4, 0xae, 0x2e, 0xed, 0x4c, 0xc7, 0xb6, 0xba, 0x47,
-	0x73, 0x07, 0x60, 0xaa, 0x42, 0x08, 0x0a, 0x81, 0x33, 0xc6, 0x32, 0x4d, 0x3e, 0x46, 0x2d, 0x28,
-	0xbb, 0x38, 0xee, 0x13, 0x2f, 0xa2, 0x5e, 0x18, 0xc8, 0x6c, 0xd5, 0x29, 0xeb, 0x27, 0x03, 0xaa,
-	0xfb, 0xc1, 0x24, 0x1c, 0x65, 0x87, 0x5b, 0x07, 0x93, 0x86, 0xa3, 0x14, 0x2d, 0x1a, 0x8e, 0x6e,
-	0x77, 0x48, 0x4d, 0x58, 0x49, 0xcb, 0x92, 0x9f, 0x4f, 0xc9, 0xce, 0x64, 0xd4, 0x80, 0xe5, 0x09,
-	0x26, 0x31, 0x0b, 0xa5, 0xc0, 0x55, 0xa9, 0x68, 0x4d, 0xa0, 0x96, 0x46, 0x21, 0x31, 0x5f, 0x87,
-	0x22, 0xc1, 0x34, 0x21, 0x01, 0x8f, 0xe4, 0x06, 0xb7, 0xd2, 0x0c, 0x3d, 0x86, 0x95, 0x81, 0xe3,
-	0xf9, 0x09, 0xc1, 0x2c, 0x52, 0x93, 0x2f, 0x51, 0xd0, 0xbd, 0xc0, 0xfd, 0xd1, 0x9e, 0xd0, 0xdb,
-	0x99, 0xa1, 0xf5, 0x23, 0x54, 0xb8, 0x46, 0x49, 0x3e, 0x75, 0x59, 0xb2, 0xd9, 0x90, 0x25, 0x1f,
-	0xfa, 0xee, 0xdb, 0x93, 0x67, 0x46, 0xcc, 0x38, 0xc0, 0x3f, 0x88, 0xc2, 0xbc, 0xc9, 0x98, 0x19,
-	0x59, 0x09, 0x54, 0xa5, 0xef, 0x69, 0xca, 0x5e, 0x10, 0x25, 0xb2, 0xbe, 0x6e, 0x4a, 0x59, 0x98,
-	0xdd, 0x2d, 0xe5, 0x1d, 0x99, 0xb2, 0xd4, 0xc8, 0x03, 0x8b, 0x30, 0xa1, 0x69, 0x8b, 0x64, 0x32,
-	0x7a, 0xc0, 0x0e, 0xc1, 0x89, 0xb3, 0xd2, 0x91, 0x92, 0xf5, 0x9b, 0x01, 0xe5, 0xae, 0x37, 0x18,
-	0xa4, 0xb0, 0xd5, 0x20, 0xef, 0xb9, 0x72, 0x75, 0xde, 0x73, 0x53, 0x18, 0xf3, 0xb3, 0x30, 0x9a,
-	0xb7, 0x81, 0xb1, 0xb0, 0x00, 0x8c, 0xac, 0x39, 0xbd, 0x61, 0x10, 0x12, 0xbc, 0x7b, 0xe1, 0x04,
-	0x43, 0x1c, 0x37, 0x96, 0x5a, 0x66, 0xbb, 0x64, 0xeb, 0x93, 0xd6, 0x1f, 0x06, 0x54, 0x4e, 0x64,
-	0x5a, 0x2c, 0x72, 0xb4, 0x01, 0x85, 0x91, 0x17, 0x88, 0xa0, 0x6b, 0x9b, 0x6b, 0x0a, 0x6e, 0xaa,
-	0x59, 0xe7, 0xc0, 0x0b, 0x5c, 0x9b, 0x5b, 0xa2, 0x35, 0x28, 0x71, 0xdc, 0xd9, 0x3c, 0x4f, 0x6d,
-	0xc5, 0x9e, 0x4e, 0x58, 0xdf, 0x41, 0x81, 0xd9, 0xa2, 0x65, 0x30, 0xb7, 0xbb, 0xdd, 0x7a, 0x0e,
-	0xdd, 0x83, 0xf2, 0x76, 0xb7, 0xfb, 0xda, 0xee, 0x9d, 0xbc, 0xdc, 0xde, 0xed, 0xd5, 0x0d, 0x04,
-	0x50, 0xec, 0xf6, 0x5e, 0xf6, 0x5e, 0xf5, 0xea, 0x79, 0x84, 0xa0, 0x26, 0xc6, 0x99, 0xde, 0x64,
-	0xfa, 0xb3, 0x93, 0xee, 0xf6, 0xab, 0x5e, 0xbd, 0xc0, 0xf4, 0x62, 0x9c, 0xe9, 0x97, 0xac, 0x37,
-	0x26, 0x54, 0x04, 0xe8, 0xb2, 0x5e, 0x9a, 0xb0, 0x42, 0x70, 0xe4, 0x3b, 0x7d, 0xc9, 0xc2, 0x25,
-	0x3b, 0x93, 0x59, 0xab, 0xc5, 0x54, 0x10, 0x74, 0x9e, 0xab, 0x52, 0x11, 0x6d, 0xc0, 0xff, 0x5c,
-	0xec, 0x63, 0x8a, 0x77, 0xf0, 0x20, 0x64, 0x24, 0xc7, 0x57, 0x48, 0x2e, 0x9d, 0xa7, 0x42, 0xcf,
-	0x60, 0xb9, 0x2f, 0xb1, 0x2d, 0x70, 0xb4, 0xde, 0x57, 0xd0, 0x52, 0x23, 0xe2, 0x82, 0x44, 0xdc,
-	0x4e, 0xd7, 0x30, 0xb2, 0x75, 0xbd, 0xc1, 0x20, 0x3d, 0x18, 0x21, 0xa0, 0x43, 0xa8, 0xb8, 0x98,
-	0x3a, 0x9e, 0x8f, 0x5d, 0x0e, 0x68, 0x91, 0xd7, 0xef, 0x87, 0xd7, 0xee, 0xac, 0xd8, 0x8a, 0x5b,
-	0x44, 0x5b, 0x8e, 0xda, 0x70, 0xef, 0xc2, 0x89, 0x55, 0xab, 0xc6, 0x32, 0xcf, 0xe8, 0xea, 0x74,
-	0xf3, 0x1b, 0xb8, 0x3f, 0xb3, 0xd9, 0x9c, 0x2b, 0xe2, 0x13, 0xf5, 0x8a, 0xd0, 0x1b, 0x4b, 0x2d,
-	0x10, 0xf5, 0xee, 0x78, 0x26, 0x9a, 0x42, 0x02, 0x80, 0xea, 0x50, 0xe9, 0xee, 0xef, 0xed, 0xbd,
-	0x3e, 0x3b, 0x3a, 0x38, 0x3a, 0xfe, 0xfa, 0xa8, 0x9e, 0x43, 0x55, 0x28, 0xf1, 0x99, 0xa3, 0xe3,
-	0x23, 0x56, 0x10, 0xa9, 0x78, 0x7a, 0x7c, 0xd8, 0xab, 0xe7, 0x2d, 0x0a, 0xd5, 0x5d, 0x82, 0x1d,
-	0x8a, 0xaf, 0x27, 0xa3, 0x4f, 0x01, 0x64, 0x6f, 0x7a, 0xf8, 0xad, 0x94, 0xa4, 0x98, 0xb2, 0x72,
-	0xa0, 0xde, 0x18, 0x87, 0x09, 0xe5, 0x07, 0x6d, 0xd8, 0xa9, 0x68, 0x7d, 0x0b, 0xb5, 0xd4, 0xab,
-	0x2c, 0xab, 0xab, 0xcd, 0x7c, 0x57, 0xa7, 0xd6, 0x2f, 0x06, 0x94, 0x6d, 0xec, 0xb8, 0x8b, 0xb3,
-	0x84, 0xee, 0xca, 0x5c, 0x3c, 0xbf, 0x29, 0x75, 0x16, 0x16, 0xa2, 0x4e, 0xeb, 0x67, 0x03, 0x2a,
-	0x22, 0xb6, 0x77, 0x9c, 0xb5, 0x12, 0x8a, 0xb9, 0x58, 0x28, 0x7f, 0x1a, 0x50, 0x3d, 0x8b, 0x5c,
-	0xe5, 0xe0, 0xff, 0x4b, 0x3a, 0x55, 0x2a, 0x65, 0x49, 0xab, 0x94, 0x59, 0xa2, 0x2d, 0xce, 0x23,
-	0xda, 0x7d, 0xa8, 0xa5, 0xc9, 0x48, 0x64, 0x75, 0x24, 0x8d, 0xc5, 0xeb, 0x87, 0xbd, 0x4d, 0xba,
-	0x9c, 0x8f, 0xfe, 0x85, 0x0a, 0x52, 0xf2, 0x2e, 0xe8, 0x1d, 0xf2, 0xab, 0x01, 0xab, 0xfc, 0x4d,
-	0x66, 0xe3, 0x38, 0x4c, 0x48, 0x1f, 0xef, 0x07, 0x1e, 0xdd, 0xe3, 0x04, 0xf2, 0xee, 0xaa, 0xa6,
-	0x01, 0xcb, 0xe2, 0x6e, 0x65, 0x41, 0x73, 0xbe, 0x96, 0xe2, 0xad, 0x4b, 0x7b, 0xf3, 0x4d, 0x11,
-	0xea, 0x69, 0xa8, 0x27, 0xe9, 0xd3, 0x6b, 0x07, 0xca, 0xfc, 0xd6, 0x17, 0xaf, 0x4c, 0x34, 0xf3,
-	0x4e, 0x90, 0x08, 0x37, 0x1b, 0xb3, 0x0a, 0x71, 0x8c, 0x56, 0x0e, 0x3d, 0x07, 0xe0, 0xfc, 0x26,
-	0xb6, 0x78, 0x30, 0x43, 0xd5, 0x62, 0x87, 0xd5, 0x6b, 0x28, 0xdc, 0xca, 0xb1, 0x7f, 0x43, 0xf6,
-	0xca, 0x45, 0x8f, 0x6e, 0xf8, 0x31, 0x34, 0xd7, 0xe6, 0x2b, 0x95, 0x50, 0x8a, 0xe2, 0xbd, 0x88,
-	0xd4, 0x80, 0xb5, 0x87, 0x6c, 0xf3, 0xe1, 0x1c, 0x4d, 0xb6, 0xc1, 0x0b, 0xa8, 0x9c, 0x52, 0x82,
-	0x9d, 0xf1, 0x3f, 0xda, 0x66, 0xc3, 0x40, 0x5b, 0xb0, 0xc4, 0x71, 0xba, 0x1b, 0xa4, 0x4f, 0xa0,
-	0xc0, 0xaf, 0xaf, 0x3b, 0x80, 0xf9, 0x1c, 0x8a, 0x82, 0xb8, 0xb5, 0xd8, 0xb5, 0x1b, 0x44, 0x8b,
-	0x5d, 0x67, 0x79, 0xe1, 0x9b, 0x31, 0xa0, 0xe6, 0x5b, 0xa1, 0x6b, 0xcd, 0xb7, 0x4a, 0x95, 0xc2,
-	0xb7, 0x68, 0x72, 0xcd, 0xb7, 0x46, 0x62, 0x9a, 0x6f, 0x9d, 0x11, 0xac, 0x1c, 0xda, 0x82, 0xa2,
-	0xe8, 0x6c, 0x6d, 0x03, 0xad, 0xd9, 0x9b, 0x0f, 0x66, 0x0a, 0xbd, 0xc7, 0xbe, 0xad, 0x56, 0x0e,
-	0x3d, 0x85, 0xe2, 0xae, 0x13, 0xf4, 0xb1, 0x8f, 0xae, 0xb1, 0xb9, 0x61, 0xed, 0xe7, 0x50, 0x7d,
-	0x81, 0xe9, 0x09, 0xff, 0x1e, 0xef, 0x07, 0x83, 0xf0, 0xda, 0x2d, 0xde, 0x53, 0x6f, 0xfc, 0xcc,
-	0xdc, 0xca, 0x9d, 0x17, 0xb9, 0xe1, 0xe3, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x7b, 0xd1, 0xe0,
-	0x18, 0x7f, 0x0f, 0x00, 0x00,
+	// 1605 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xc4, 0x58, 0xdd, 0x52, 0xdb, 0xc6,
+	0x17, 0x47, 0xb6, 0x31, 0xf8, 0xf8, 0x23, 0x66, 0xff, 0xf9, 0x83, 0x50, 0xb8, 0x60, 0xd4, 0xce,
+	0x94, 0x26, 0x8d, 0xa1, 0xe4, 0xa2, 0x4d, 0x86, 0x4c, 0x0a, 0xd8, 0x50, 0x26, 0x09, 0xa1, 0x22,
+	0xe9, 0xc7, 0x55, 0xa2, 0x48, 0x6b, 0xa3, 0x22, 0x4b, 0xea, 0x6a, 0x45, 0x86, 0x5e, 0xf7, 0xa2,
+	0xaf, 0xd0, 0x87, 0xe8, 0x74, 0xa6, 0x4f, 0xd0, 0xfb, 0xbe, 0x42, 0xfb, 0x08, 0xbd, 0xe8, 0xf4,
+	0x05, 0x3a, 0xfb, 0x21, 0xb1, 0xb2, 0x05, 0x18, 0x9a, 0x69, 0xef, 0xf6, 0xec, 0x39, 0x7b, 0x3e,
+	0x7e, 0x7b, 0xf6, 0xec, 0xd9, 0x85, 0x56, 0x44, 0xc2, 0x13, 0xcf, 0xc5, 0xa4, 0x13, 0x91, 0x90,
+	0x86, 0xa8, 0x16, 0x25, 0x7e, 0x32, 0xf4, 0x48, 0xe4, 0x18, 0x8d, 0xc8, 0x4f, 0x06, 0x5e, 0x20,
+	0x18, 0xc6, 0xad, 0x41, 0x18, 0x0e, 0x7c, 0xbc, 0xca, 0xa9, 0xd7, 0x49, 0x7f, 0x15, 0x0f, 0x23,
+	0x7a, 0x2a, 0x99, 0x4b, 0xa3, 0xcc, 0x98, 0x92, 0xc4, 0xa1, 0x82, 0x6b, 0x7e, 0x00, 0xed, 0x5d,
+	0x4c, 0x0f, 0x9d, 0x23, 0x3c, 0xb4, 0x2d, 0xfc, 0x4d, 0x82, 0x63, 0x8a, 0x74, 0x98, 0x39, 0xc1,
+	0x24, 0xf6, 0xc2, 0x40, 0xd7, 0x96, 0xb5, 0x95, 0x69, 0x2b, 0x25, 0xcd, 0x3b, 0x30, 0xa7, 0x48,
+	0xc7, 0x51, 0x18, 0xc4, 0x18, 0xcd, 0x43, 0x35, 0xe6, 0x33, 0x5c, 0xba, 0x66, 0x49, 0xca, 0xfc,
+	0x43, 0x83, 0xf6, 0x76, 0x18, 0xf4, 0xbd, 0x41, 0x42, 0x70, 0xaa, 0xfb, 0x53, 0xa8, 0x9d, 0xd8,
+	0xc4, 0xb3, 0x5f, 0xfb, 0x38, 0xd6, 0xb5, 0xe5, 0xf2, 0x4a, 0x7d, 0xfd, 0x76, 0x27, 0x8b, 0xab,
+	0x33, 0x2a, 0xdf, 0xf9, 0x3c, 0x15, 0xee, 0x05, 0x94, 0x9c, 0x5a, 0x67, 0x8b, 0xd1, 0x1d, 0xa8,
+	0xd8, 0x64, 0x10, 0xeb, 0xa5, 0x65, 0x6d, 0xa5, 0xbe, 0xbe, 0xd0, 0x11, 0x61, 0x76, 0xd2, 0x30,
+	0x3b, 0x87, 0x3c, 0x4c, 0x8b, 0x0b, 0xa1, 0x77, 0xa1, 0x69, 0x3b, 0x0e, 0x8e, 0xe8, 0x21, 0x76,
+	0x08, 0xa6, 0xb1, 0x5e, 0x5e, 0xd6, 0x56, 0x66, 0xad, 0xfc, 0xa4, 0xb1, 0x01, 0xad, 0xbc, 0x3d,
+	0xd4, 0x86, 0xf2, 0x31, 0x3e, 0x95, 0x81, 0xb1, 0x21, 0xba, 0x09, 0xd3, 0x27, 0xb6, 0x9f, 0x60,
+	0x6e, 0xb7, 0x66, 0x09, 0xe2, 0x41, 0xe9, 0x63, 0xcd, 0xbc, 0x0f, 0x73, 0x8a, 0xfb, 0x12, 0x9c,
+	0x31, 0xc3, 0x5a, 0x81, 0x61, 0xf3, 0x67, 0x0d, 0x16, 0xb3, 0xb5, 0x3d, 0x42, 0x42, 0xf2, 0xd4,
+	0x8b, 0x63, 0x2f, 0x18, 0x3c, 0xc6, 0xa7, 0x31, 0xfa, 0x0c, 0xea, 0xc3, 0x33, 0x52, 0xa2, 0xb6,
+	0x5a, 0x84, 0xda, 0xe8, 0xd2, 0xce, 0xd9, 0xd8, 0x52, 0x75, 0x18, 0x5b, 0x00, 0x67, 0x2c, 0x84,
+	0xa0, 0x12, 0xd8, 0x43, 0x2c, 0xc3, 0xe4, 0x63, 0xb4, 0x0c, 0x75, 0x17, 0xc7, 0x0e, 0xf1, 0x22,
+	0xca, 0x12, 0x41, 0x44, 0xab, 0x4e, 0x99, 0xdf, 0x69, 0xd0, 0xdc, 0x0b, 0x4e, 0xc2, 0xe3, 0x6c,
+	0x73, 0xdb, 0x50, 0xa6, 0xe1, 0x71, 0x8a, 0x16, 0x0d, 0x8f, 0xaf, 0xb6, 0x49, 0x06, 0xcc, 0xa6,
+	0x19, 0xcf, 0xf7, 0xa7, 0x66, 0x65, 0xb4, 0x9a, 0x93, 0x15, 0xce, 0xca, 0x72, 0xf2, 0x04, 0x5a,
+	0xa9, 0x17, 0x12, 0xf3, 0x55, 0xa8, 0x12, 0x4c, 0x13, 0x22, 0xd2, 0xf7, 0x02, 0xb3, 0x52, 0x0c,
+	0xdd, 0x83, 0xd9, 0xbe, 0xed, 0xf9, 0x09, 0xc1, 0xcc, 0xd3, 0x32, 0x5f, 0xa2, 0xa0, 0x7b, 0x84,
+	0x9d, 0xe3, 0x1d, 0xc1, 0xb7, 0x32, 0x41, 0xf3, 0x5b, 0x68, 0x70, 0x8e, 0x12, 0x7c, 0x6a, 0xb2,
+	0x66, 0xb1, 0x21, 0x0b, 0x3e, 0xf4, 0xdd, 0xcb, 0x83, 0x67, 0x42, 0x4c, 0x38, 0xc0, 0x6f, 0x44,
+	0x62, 0x5e, 0x24, 0xcc, 0x84, 0xcc, 0x04, 0x9a, 0xd2, 0xf6, 0x59, 0xc8, 0x5e, 0x10, 0x25, 0x32,
+	0xbf, 0x2e, 0x0a, 0x59, 0x88, 0x5d, 0x2f, 0xe4, 0x2d, 0x68, 0xa8, 0x1c, 0xb9, 0x61, 0x11, 0x26,
+	0x34, 0x3d, 0x22, 0x19, 0xcd, 0xaa, 0x02, 0xc1, 0x76, 0x9c, 0xa5, 0x8e, 0xa4, 0xcc, 0x9f, 0x34,
+	0xa8, 0x77, 0xbd, 0x7e, 0x3f, 0x85, 0xad, 0x05, 0x25, 0xcf, 0x95, 0xab, 0x4b, 0x9e, 0x9b, 0xc2,
+	0x58, 0x1a, 0x87, 0xb1, 0x7c, 0x15, 0x18, 0x2b, 0x13, 0xc0, 0xc8, 0x0e, 0xa7, 0x37, 0x08, 0x42,
+	0x82, 0xb7, 0x8f, 0xec, 0x60, 0x80, 0x63, 0x7d, 0x7a, 0xb9, 0xbc, 0x52, 0xb3, 0xf2, 0x93, 0xe6,
+	0x2f, 0x1a, 0x34, 0x0e, 0x64, 0x58, 0xcc, 0x73, 0xb4, 0x06, 0x95, 0x63, 0x2f, 0x10, 0x4e, 0xb7,
+	0xd6, 0x97, 0x14, 0xdc, 0x54, 0xb1, 0xce, 0x63, 0x2f, 0x70, 0x2d, 0x2e, 0x89, 0x96, 0xa0, 0xc6,
+	0x71, 0x67, 0xf3, 0x3c, 0xb4, 0x59, 0xeb, 0x6c, 0xc2, 0x7c, 0x05, 0x15, 0x26, 0x8b, 0x66, 0xa0,
+	0xbc, 0xd9, 0xed, 0xb6, 0xa7, 0xd0, 0x0d, 0xa8, 0x6f, 0x76, 0xbb, 0x2f, 0xad, 0xde, 0xc1, 0x93,
+	0xcd, 0xed, 0x5e, 0x5b, 0x43, 0x00, 0xd5, 0x6e, 0xef, 0x49, 0xef, 0x79, 0xaf, 0x5d, 0x42, 0x08,
+	0x5a, 0x62, 0x9c, 0xf1, 0xcb, 0x8c, 0xff, 0xe2, 0xa0, 0xbb, 0xf9, 0xbc, 0xd7, 0xae, 0x30, 0xbe,
+	0x18, 0x67, 0xfc, 0x69, 0xf3, 0xf7, 0x32, 0x34, 0x04, 0xe8, 0x32, 0x5f, 0x0c, 0x98, 0x25, 0x38,
+	0xf2, 0x6d, 0x47, 0x56, 0xe1, 0x9a, 0x95, 0xd1, 0xec, 0xa8, 0xc5, 0x54, 0x14, 0xe8, 0x12, 0x67,
+	0xa5, 0x24, 0x5a, 0x83, 0xff, 0xb9, 0xd8, 0xc7, 0x14, 0x6f, 0xe1, 0x7e, 0xc8, 0x8a, 0x1c, 0x5f,
+	0x21, 0x6b, 0x69, 0x11, 0x0b, 0x3d, 0x84, 0x19, 0x47, 0x62, 0x5b, 0xe1, 0x68, 0xbd, 0xa3, 0xa0,
+	0xa5, 0x7a, 0xc4, 0x09, 0x89, 0xb8, 0x95, 0xae, 0x61, 0xc5, 0xd6, 0xf5, 0xfa, 0xfd, 0x74, 0x63,
+	0x04, 0x81, 0x9e, 0x42, 0xc3, 0xc5, 0xd4, 0xf6, 0x7c, 0xec, 0x72, 0x40, 0xab, 0x3c, 0x7f, 0xdf,
+	0x3f, 0x57, 0xb3, 0x22, 0x2b, 0x6e, 0x91, 0xdc, 0x72, 0xb4, 0x02, 0x37, 0x8e, 0xec, 0x58, 0x95,
+	0xd2, 0x67, 0x78, 0x44, 0xa3, 0xd3, 0xc6, 0x97, 0x30, 0x37, 0xa6, 0xac, 0xe0, 0x8a, 0xb8, 0xab,
+	0x5e, 0x11, 0xf9, 0x83, 0xa5, 0x26, 0x88, 0x7a, 0x77, 0x3c, 0x84, 0xba, 0x02, 0x00, 0x6a, 0x43,
+	0xa3, 0xbb, 0xb7, 0xb3, 0xf3, 0xf2, 0xc5, 0xfe, 0xe3, 0xfd, 0x67, 0x5f, 0xec, 0xb7, 0xa7, 0x50,
+	0x13, 0x6a, 0x7c, 0x66, 0xff, 0xd9, 0x3e, 0x4b, 0x88, 0x94, 0x3c, 0x7c, 0xf6, 0xb4, 0xd7, 0x2e,
+	0x99, 0x14, 0x9a, 0xdb, 0x04, 0xdb, 0x14, 0x9f, 0x5f, 0x8c, 0x3e, 0x02, 0x90, 0x67, 0xd3, 0xc3,
+	0x97, 0x96, 0x24, 0x45, 0x94, 0xa5, 0x03, 0xf5, 0x86, 0x38, 0x4c, 0x28, 0xdf, 0x68, 0xcd, 0x4a,
+	0x49, 0xf3, 0x2b, 0x68, 0xa5, 0x56, 0x65, 0x5a, 0x8d, 0x1e, 0xe6, 0xeb, 0x1a, 0x35, 0x7f, 0xd0,
+	0xa0, 0x6e, 0x61, 0xdb, 0x9d, 0xbc, 0x4a, 0xe4, 0x4d, 0x95, 0x27, 0x8f, 0xef, 0xac, 0x74, 0x56,
+	0x26, 0x2a, 0x9d, 0xe6, 0xf7, 0x1a, 0x34, 0x84, 0x6f, 0x6f, 0x39, 0x6a, 0xc5, 0x95, 0xf2, 0x64,
+	0xae, 0xfc, 0xaa, 0x41, 0xf3, 0x45, 0xe4, 0x2a, 0x1b, 0xff, 0x5f, 0x96, 0x53, 0x25, 0x53, 0xa6,
+	0x73, 0x99, 0x32, 0x5e, 0x68, 0xab, 0x45, 0x85, 0x76, 0x0f, 0x5a, 0x69, 0x30, 0x12, 0xd9, 0x3c,
+	0x92, 0xda, 0xe4, 0xf9, 0xc3, 0x7a, 0x93, 0x2e, 0xaf, 0x47, 0xff, 0x42, 0x06, 0x29, 0x71, 0x57,
+	0xf2, 0x27, 0xe4, 0xaf, 0x2a, 0x6f, 0x81, 0x45, 0xc7, 0xad, 0xb4, 0xd7, 0x11, 0x09, 0xbf, 0xc6,
+	0x0e, 0x95, 0xee, 0xa4, 0x24, 0x2b, 0x77, 0x31, 0xb5, 0x9d, 0xe3, 0xb4, 0xb7, 0xe4, 0x04, 0x7a,
+	0x04, 0x55, 0x87, 0x37, 0x78, 0x7a, 0x99, 0x17, 0xba, 0xf7, 0xf2, 0x9d, 0x5f, 0x4e, 0xb9, 0x6c,
+	0x05, 0x45, 0x99, 0x93, 0xcb, 0xd8, 0x55, 0xec, 0x92, 0x53, 0x2b, 0x11, 0xad, 0xd3, 0xac, 0x25,
+	0x29, 0x7e, 0x7d, 0xdb, 0xc4, 0xf6, 0x7d, 0xec, 0xf3, 0x0d, 0x9b, 0xb6, 0x32, 0x9a, 0x15, 0xc5,
+	0x61, 0x18, 0x78, 0x34, 0x24, 0xbd, 0xc0, 0x8d, 0x42, 0x2f, 0xa0, 0x7a, 0x95, 0x3b, 0x35, 0x3a,
+	0xcd, 0x9a, 0x47, 0x7a, 0x1a, 0x61, 0x5e, 0x33, 0x6b, 0x16, 0x1f, 0x67, 0x0d, 0xe5, 0xac, 0xd2,
+	0x50, 0xce, 0x43, 0x35, 0xb2, 0x09, 0x0e, 0xa8, 0x5e, 0xe3, 0xb3, 0x92, 0x52, 0x92, 0x1e, 0x26,
+	0x6b, 0x5d, 0x5e, 0xc1, 0x1c, 0x1f, 0x75, 0x71, 0x84, 0x03, 0x17, 0x07, 0x0e, 0xdb, 0xae, 0x3a,
+	0x87, 0x66, 0xfd, 0x22, 0x68, 0xf6, 0x46, 0x17, 0x09, 0x94, 0xc6, 0x95, 0xc9, 0x1d, 0xa2, 0x6c,
+	0x87, 0x1a, 0x1c, 0xb1, 0x94, 0x64, 0xcf, 0x97, 0xb4, 0x25, 0x8d, 0xf5, 0x66, 0xd1, 0xf3, 0x25,
+	0x6f, 0xf3, 0x20, 0x15, 0x96, 0xcf, 0x97, 0x6c, 0x31, 0xb3, 0x61, 0xfb, 0x9e, 0x1d, 0xe3, 0x58,
+	0x6f, 0x89, 0x5b, 0x56, 0x92, 0xc8, 0x64, 0xd7, 0x9b, 0x12, 0xda, 0x0d, 0xce, 0xce, 0xcd, 0x19,
+	0xb7, 0xe1, 0x66, 0x76, 0x95, 0xa8, 0x9e, 0x23, 0xa8, 0x24, 0x24, 0x48, 0xef, 0x74, 0x3e, 0x36,
+	0xee, 0x43, 0x5d, 0xc9, 0x8a, 0xab, 0x3c, 0x69, 0x8c, 0x13, 0x98, 0x2f, 0x46, 0xad, 0x40, 0xcb,
+	0x4e, 0xfe, 0xd6, 0x5b, 0xbb, 0x04, 0x96, 0x31, 0xdf, 0x55, 0xbb, 0x1b, 0xd0, 0xca, 0x23, 0x77,
+	0xa5, 0x87, 0xd8, 0x6f, 0x25, 0x98, 0x53, 0x4c, 0xca, 0x5a, 0x32, 0x7e, 0x25, 0xde, 0xe5, 0xc7,
+	0x8d, 0xe2, 0xcb, 0x4a, 0xb4, 0x90, 0x42, 0x36, 0xcc, 0xf1, 0x41, 0x2e, 0xef, 0xc4, 0x91, 0xbc,
+	0x57, 0x1c, 0xac, 0xb0, 0xdc, 0x39, 0x1c, 0x5d, 0x25, 0x13, 0x6f, 0x4c, 0xdb, 0x95, 0xb6, 0xf5,
+	0x0d, 0xcc, 0x17, 0x2b, 0x2e, 0xc0, 0x6a, 0x37, 0xbf, 0x37, 0x1f, 0x5e, 0xe8, 0xee, 0x25, 0x9b,
+	0x63, 0xfe, 0xa8, 0xc1, 0x02, 0x7f, 0x68, 0x5a, 0x38, 0x0e, 0x13, 0xe2, 0xe0, 0xbd, 0xc0, 0xa3,
+	0x3b, 0xbc, 0x2b, 0x7a, 0x7b, 0x57, 0xa1, 0x0e, 0x33, 0xe2, 0xc1, 0x20, 0x20, 0xae, 0x59, 0x29,
+	0x79, 0xe5, 0xfb, 0x7a, 0xfd, 0xcf, 0x19, 0x68, 0xa7, 0xae, 0xa6, 0x59, 0xc5, 0x0e, 0x72, 0xf6,
+	0x93, 0x81, 0x6e, 0x29, 0x78, 0x8c, 0xfe, 0x86, 0x18, 0x4b, 0xc5, 0x4c, 0x01, 0x96, 0x39, 0x85,
+	0xb6, 0xa0, 0xce, 0x1f, 0x45, 0xe2, 0x8c, 0xa1, 0xb1, 0x67, 0x54, 0xaa, 0x47, 0x1f, 0x67, 0x64,
+	0x3a, 0x1e, 0x01, 0xf0, 0xf6, 0x4f, 0xd6, 0xeb, 0xb1, 0x4e, 0x56, 0x68, 0x58, 0x38, 0xa7, 0xc3,
+	0x35, 0xa7, 0x58, 0x38, 0xd9, 0x27, 0x40, 0x2e, 0x9c, 0xd1, 0x0f, 0x15, 0x63, 0xa9, 0x98, 0xa9,
+	0xb8, 0x52, 0x15, 0xcf, 0x69, 0xa4, 0x3a, 0x9c, 0x7b, 0xe7, 0x1b, 0x8b, 0x05, 0x9c, 0x4c, 0xc1,
+	0x2e, 0x34, 0x0e, 0x29, 0xc1, 0xf6, 0xf0, 0x1f, 0xa9, 0x59, 0xd3, 0xd0, 0x06, 0x4c, 0x73, 0x9c,
+	0xae, 0x07, 0xe9, 0x7d, 0xa8, 0xf0, 0xee, 0xfe, 0x1a, 0x60, 0x3e, 0x82, 0xaa, 0xe8, 0x6b, 0x73,
+	0xbe, 0xe7, 0x1a, 0x6c, 0x63, 0xb1, 0x80, 0xa3, 0xda, 0x66, 0x0d, 0x62, 0xce, 0xb6, 0xd2, 0xcd,
+	0x1a, 0x0b, 0x63, 0xf3, 0xaa, 0x6d, 0xd1, 0x03, 0xe5, 0x6c, 0xe7, 0x7a, 0x3c, 0x63, 0xb1, 0x80,
+	0x93, 0x29, 0xd8, 0x80, 0xaa, 0x68, 0x7c, 0x72, 0x0a, 0x72, 0xbd, 0x90, 0x31, 0x3f, 0x76, 0x64,
+	0x7a, 0xec, 0xc3, 0x30, 0xcb, 0x23, 0x51, 0x10, 0x46, 0xf3, 0x28, 0x57, 0xc2, 0x8d, 0xa5, 0x62,
+	0x66, 0xe6, 0xc7, 0x03, 0xa8, 0x6e, 0xdb, 0x81, 0x83, 0x7d, 0x74, 0x8e, 0xb5, 0x0b, 0xbc, 0xf8,
+	0x04, 0x9a, 0xbb, 0x98, 0x1e, 0xf0, 0x2f, 0xce, 0xbd, 0xa0, 0x1f, 0x9e, 0xab, 0xe2, 0xff, 0xea,
+	0xd3, 0x2a, 0x13, 0x37, 0xa7, 0x5e, 0x57, 0xb9, 0xe0, 0xbd, 0xbf, 0x07, 0x00, 0x02, 0x02, 0x0b,
+	0x29, 0x43, 0x15, 0x00, 0x00,
 }
@@ -290,6 +290,9 @@ type RegisterResourceRequest struct {
 	CustomTimeouts             *RegisterResourceRequest_CustomTimeouts                  `protobuf:"bytes,17,opt,name=customTimeouts" json:"customTimeouts,omitempty"`
 	DeleteBeforeReplaceDefined bool                                                     `protobuf:"varint,18,opt,name=deleteBeforeReplaceDefined" json:"deleteBeforeReplaceDefined,omitempty"`
 	SupportsPartialValues      bool                                                     `protobuf:"varint,19,opt,name=supportsPartialValues" json:"supportsPartialValues,omitempty"`
+	Remote                     bool                                                     `protobuf:"varint,20,opt,name=remote" json:"remote,omitempty"`
+	ReplaceOnChanges           []string                                                 `protobuf:"bytes,21,rep,name=replaceOnChanges" json:"replaceOnChanges,omitempty"`
+	RetainOnDelete             bool                                                     `protobuf:"varint,22,opt,name=retainOnDelete" json:"retainOnDelete,omitempty"`
 	XXX_NoUnkeyedLiteral       struct{}                                                 `json:"-"`
 	XXX_unrecognized           []byte                                                   `json:"-"`
 	XXX_sizecache              int32                                                    `json:"-"`
@@ -452,6 +455,27 @@ func (m *RegisterResourceRequest) GetSupportsPartialValues() bool {
 	return false
 }
 
+func (m *RegisterResourceRequest) GetRemote() bool {
+	if m != nil {
+		return m.Remote
+	}
+	return false
+}
+
+func (m *RegisterResourceRequest) GetReplaceOnChanges() []string {
+	if m != nil {
+		return m.ReplaceOnChanges
+	}
+	return nil
+}
+
+func (m *RegisterResourceRequest) GetRetainOnDelete() bool {
+	if m != nil {
+		return m.RetainOnDelete
+	}
+	return false
+}
+
 // PropertyDependencies describes the resources that a particular property depends on.
 type RegisterResourceRequest_PropertyDependencies struct {
 	Urns                 []string `protobuf:"bytes,1,rep,name=urns" json:"urns,omitempty"`
@@ -500,6 +524,7 @@ type RegisterResourceRequest_CustomTimeouts struct {
 	Create               string   `protobuf:"bytes,1,opt,name=create" json:"create,omitempty"`
 	Update               string   `protobuf:"bytes,2,opt,name=update" json:"update,omitempty"`
 	Delete               string   `protobuf:"bytes,3,opt,name=delete" json:"delete,omitempty"`
+	Read                 string   `protobuf:"bytes,4,opt,name=read" json:"read,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -552,17 +577,25 @@ func (m *RegisterResourceRequest_CustomTimeouts) GetDelete() string {
 	return ""
 }
 
+func (m *RegisterResourceRequest_CustomTimeouts) GetRead() string {
+	if m != nil {
+		return m.Read
+	}
+	return ""
+}
+
 // RegisterResourceResponse is returned by the engine after a resource has finished being initialized.  It includes the
 // auto-assigned URN, the provider-assigned ID, and any other properties initialized by the engine.
 type RegisterResourceResponse struct {
-	Urn                  string          `protobuf:"bytes,1,opt,name=urn" json:"urn,omitempty"`
-	Id                   string          `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
-	Object               *_struct.Struct `protobuf:"bytes,3,opt,name=object" json:"object,omitempty"`
-	Stable               bool            `protobuf:"varint,4,opt,name=stable" json:"stable,omitempty"`
-	Stables              []string        `protobuf:"bytes,5,rep,name=stables" json:"stables,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Urn                  string                                                    `protobuf:"bytes,1,opt,name=urn" json:"urn,omitempty"`
+	Id                   string                                                    `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+	Object               *_struct.Struct                                           `protobuf:"bytes,3,opt,name=object" json:"object,omitempty"`
+	Stable               bool                                                      `protobuf:"varint,4,opt,name=stable" json:"stable,omitempty"`
+	Stables              []string                                                  `protobuf:"bytes,5,rep,name=stables" json:"stables,omitempty"`
+	PropertyDependencies map[string]*RegisterResourceResponse_PropertyDependencies `protobuf:"bytes,6,rep,name=propertyDependencies" json:"propertyDependencies,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	XXX_NoUnkeyedLiteral struct{}                                                  `json:"-"`
+	XXX_unrecognized     []byte                                                    `json:"-"`
+	XXX_sizecache        int32                                                     `json:"-"`
 }
 
 func (m *RegisterResourceResponse) Reset()         { *m = RegisterResourceResponse{} }
@@ -624,6 +657,56 @@ func (m *RegisterResourceResponse) GetStables() []string {
 	return nil
 }
 
+func (m *RegisterResourceResponse) GetPropertyDependencies() map[string]*RegisterResourceResponse_PropertyDependencies {
+	if m != nil {
+		return m.PropertyDependencies
+	}
+	return nil
+}
+
+// PropertyDependencies describes the resources that a particular property depends on.
+type RegisterResourceResponse_PropertyDependencies struct {
+	Urns                 []string `protobuf:"bytes,1,rep,name=urns" json:"urns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterResourceResponse_PropertyDependencies) Reset() {
+	*m = RegisterResourceResponse_PropertyDependencies{}
+}
+func (m *RegisterResourceResponse_PropertyDependencies) String() string {
+	return proto.CompactTextString(m)
+}
+func (*RegisterResourceResponse_PropertyDependencies) ProtoMessage() {}
+func (*RegisterResourceResponse_PropertyDependencies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_resource_beee7c3faa8096b0, []int{5, 0}
+}
+func (m *RegisterResourceResponse_PropertyDependencies) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterResourceResponse_PropertyDependencies.Unmarshal(m, b)
+}
+func (m *RegisterResourceResponse_PropertyDependencies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterResourceResponse_PropertyDependencies.Marshal(b, m, deterministic)
+}
+func (dst *RegisterResourceResponse_PropertyDependencies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterResourceResponse_PropertyDependencies.Merge(dst, src)
+}
+func (m *RegisterResourceResponse_PropertyDependencies) XXX_Size() int {
+	return xxx_messageInfo_RegisterResourceResponse_PropertyDependencies.Size(m)
+}
+func (m *RegisterResourceResponse_PropertyDependencies) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterResourceResponse_PropertyDependencies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterResourceResponse_PropertyDependencies proto.InternalMessageInfo
+
+func (m *RegisterResourceResponse_PropertyDependencies) GetUrns() []string {
+	if m != nil {
+		return m.Urns
+	}
+	return nil
+}
+
 // RegisterResourceOutputsRequest adds extra resource outputs created by the program after registration has occurred.
 type RegisterResourceOutputsRequest struct {
 	Urn                  string          `protobuf:"bytes,1,opt,name=urn" json:"urn,omitempty"`
@@ -681,6 +764,8 @@ func init() {
 	proto.RegisterType((*RegisterResourceRequest_PropertyDependencies)(nil), "pulumirpc.RegisterResourceRequest.PropertyDependencies")
 	proto.RegisterType((*RegisterResourceRequest_CustomTimeouts)(nil), "pulumirpc.RegisterResourceRequest.CustomTimeouts")
 	proto.RegisterType((*RegisterResourceResponse)(nil), "pulumirpc.RegisterResourceResponse")
+	proto.RegisterMapType((map[string]*RegisterResourceResponse_PropertyDependencies)(nil), "pulumirpc.RegisterResourceResponse.PropertyDependenciesEntry")
+	proto.RegisterType((*RegisterResourceResponse_PropertyDependencies)(nil), "pulumirpc.RegisterResourceResponse.PropertyDependencies")
 	proto.RegisterType((*RegisterResourceOutputsRequest)(nil), "pulumirpc.RegisterResourceOutputsRequest")
 }
 
@@ -952,61 +1037,66 @@ var _ResourceMonitor_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("resource.proto", fileDescriptor_resource_beee7c3faa8096b0) }
 
 var fileDescriptor_resource_beee7c3faa8096b0 = []byte{
-	// 882 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xa4, 0x56, 0x51, 0x6f, 0x23, 0x35,
-	0x10, 0xbe, 0x4d, 0x7a, 0x69, 0x32, 0xed, 0xa5, 0xc5, 0x2d, 0xa9, 0x6f, 0x41, 0xa5, 0x2c, 0x3c,
-	0x04, 0x1e, 0xd2, 0xbb, 0x82, 0x74, 0x07, 0x42, 0x20, 0x71, 0x77, 0xa0, 0x7b, 0x38, 0x71, 0x6c,
-	0x11, 0x02, 0x24, 0x90, 0xdc, 0xdd, 0x69, 0x6e, 0xe9, 0x66, 0x6d, 0x6c, 0x6f, 0xa5, 0xbc, 0xf1,
-	0xca, 0xaf, 0xe0, 0x2f, 0xf1, 0x5b, 0xf8, 0x05, 0xc8, 0x5e, 0x3b, 0x64, 0xb3, 0x9b, 0x36, 0x70,
-	0x6f, 0x9e, 0x6f, 0xc6, 0xb3, 0xf6, 0x37, 0xdf, 0x8c, 0x17, 0x86, 0x12, 0x15, 0x2f, 0x65, 0x82,
-	0x13, 0x21, 0xb9, 0xe6, 0x64, 0x20, 0xca, 0xbc, 0x9c, 0x65, 0x52, 0x24, 0xe1, 0x5b, 0x53, 0xce,
-	0xa7, 0x39, 0x9e, 0x5a, 0xc7, 0x45, 0x79, 0x79, 0x8a, 0x33, 0xa1, 0xe7, 0x55, 0x5c, 0xf8, 0xf6,
-	0xaa, 0x53, 0x69, 0x59, 0x26, 0xda, 0x79, 0x87, 0x42, 0xf2, 0xeb, 0x2c, 0x45, 0x59, 0xd9, 0xd1,
-	0x18, 0x46, 0xe7, 0xa5, 0x10, 0x5c, 0x6a, 0xf5, 0x15, 0x32, 0x5d, 0x4a, 0x8c, 0xf1, 0xb7, 0x12,
-	0x95, 0x26, 0x43, 0xe8, 0x64, 0x29, 0x0d, 0x4e, 0x82, 0xf1, 0x20, 0xee, 0x64, 0x69, 0xf4, 0x09,
-	0x1c, 0x35, 0x22, 0x95, 0xe0, 0x85, 0x42, 0x72, 0x0c, 0xf0, 0x8a, 0x29, 0xe7, 0xb5, 0x5b, 0xfa,
-	0xf1, 0x12, 0x12, 0xfd, 0xdd, 0x81, 0x83, 0x18, 0x59, 0x1a, 0xbb, 0x1b, 0xad, 0xf9, 0x04, 0x21,
-	0xb0, 0xa5, 0xe7, 0x02, 0x69, 0xc7, 0x22, 0x76, 0x6d, 0xb0, 0x82, 0xcd, 0x90, 0x76, 0x2b, 0xcc,
-	0xac, 0xc9, 0x08, 0x7a, 0x82, 0x49, 0x2c, 0x34, 0xdd, 0xb2, 0xa8, 0xb3, 0xc8, 0x23, 0x00, 0x21,
-	0xb9, 0x40, 0xa9, 0x33, 0x54, 0xf4, 0xee, 0x49, 0x30, 0xde, 0x39, 0x3b, 0x9a, 0x54, 0x7c, 0x4c,
-	0x3c, 0x1f, 0x93, 0x73, 0xcb, 0x47, 0xbc, 0x14, 0x4a, 0x22, 0xd8, 0x4d, 0x51, 0x60, 0x91, 0x62,
-	0x91, 0x98, 0xad, 0xbd, 0x93, 0xee, 0x78, 0x10, 0xd7, 0x30, 0x12, 0x42, 0xdf, 0x73, 0x47, 0xb7,
-	0xed, 0x67, 0x17, 0x36, 0xa1, 0xb0, 0x7d, 0x8d, 0x52, 0x65, 0xbc, 0xa0, 0x7d, 0xeb, 0xf2, 0x26,
-	0x79, 0x1f, 0xee, 0xb1, 0x24, 0x41, 0xa1, 0xcf, 0x31, 0x91, 0xa8, 0x15, 0x1d, 0x58, 0x76, 0xea,
-	0x20, 0x79, 0x0c, 0x47, 0x2c, 0x4d, 0x33, 0x9d, 0xf1, 0x82, 0xe5, 0x15, 0xf8, 0x4d, 0xa9, 0x45,
-	0xa9, 0x15, 0x05, 0x7b, 0x94, 0x75, 0x6e, 0xf3, 0x65, 0x96, 0x67, 0x4c, 0xa1, 0xa2, 0x3b, 0x36,
-	0xd2, 0x9b, 0x11, 0x83, 0xc3, 0x3a, 0xe7, 0xae, 0x58, 0xfb, 0xd0, 0x2d, 0x65, 0xe1, 0x58, 0x37,
-	0xcb, 0x15, 0xda, 0x3a, 0x1b, 0xd3, 0x16, 0xfd, 0xd5, 0x87, 0xa3, 0x18, 0xa7, 0x99, 0xd2, 0x28,
-	0x57, 0x6b, 0xeb, 0x6b, 0x19, 0xb4, 0xd4, 0xb2, 0xd3, 0x5a, 0xcb, 0x6e, 0xad, 0x96, 0x23, 0xe8,
-	0x25, 0xa5, 0xd2, 0x7c, 0x66, 0x6b, 0xdc, 0x8f, 0x9d, 0x45, 0x4e, 0xa1, 0xc7, 0x2f, 0x7e, 0xc5,
-	0x44, 0xdf, 0x56, 0x5f, 0x17, 0x66, 0x18, 0x32, 0x2e, 0xb3, 0xa3, 0x67, 0x33, 0x79, 0xb3, 0x51,
-	0xf5, 0xed, 0x5b, 0xaa, 0xde, 0x5f, 0xa9, 0xba, 0x80, 0x43, 0x47, 0xc6, 0xfc, 0xe9, 0x72, 0x9e,
-	0xc1, 0x49, 0x77, 0xbc, 0x73, 0xf6, 0xd9, 0x64, 0xd1, 0xb0, 0x93, 0x35, 0x24, 0x4d, 0x5e, 0xb6,
-	0x6c, 0x7f, 0x56, 0x68, 0x39, 0x8f, 0x5b, 0x33, 0x93, 0x07, 0x70, 0x90, 0x62, 0x8e, 0x1a, 0xbf,
-	0xc4, 0x4b, 0x6e, 0x1a, 0x50, 0xe4, 0x2c, 0x41, 0x0a, 0xf6, 0x5e, 0x6d, 0xae, 0x65, 0x65, 0xee,
-	0x34, 0x94, 0x99, 0x4d, 0x0b, 0x2e, 0xf1, 0xc9, 0x2b, 0x56, 0x4c, 0x51, 0xd1, 0x5d, 0x7b, 0xfd,
-	0x3a, 0xd8, 0xd4, 0xef, 0xbd, 0xff, 0xa8, 0xdf, 0xe1, 0xc6, 0xfa, 0xdd, 0xab, 0xe9, 0xd7, 0x30,
-	0x9f, 0xcd, 0xcc, 0xf8, 0x78, 0x9e, 0xd2, 0xfd, 0x8a, 0x79, 0x6f, 0x93, 0x1f, 0x61, 0x58, 0xc9,
-	0xe1, 0xbb, 0x6c, 0x86, 0xdc, 0x7c, 0xe6, 0x0d, 0x2b, 0x86, 0x87, 0x1b, 0x70, 0xfe, 0xa4, 0xb6,
-	0x31, 0x5e, 0x49, 0x44, 0x3e, 0x87, 0xb0, 0x85, 0xc7, 0xa7, 0x78, 0x99, 0x15, 0x98, 0x52, 0x62,
-	0x6f, 0x7f, 0x43, 0x04, 0xf9, 0x18, 0xde, 0x54, 0x6e, 0x4c, 0xbe, 0x64, 0x52, 0x67, 0x2c, 0xff,
-	0x9e, 0xe5, 0x25, 0x2a, 0x7a, 0x60, 0xb7, 0xb6, 0x3b, 0xc3, 0x0f, 0xe1, 0xb0, 0x4d, 0x0b, 0xa6,
-	0x63, 0x4a, 0x59, 0x28, 0x1a, 0x58, 0x6e, 0xec, 0x3a, 0xfc, 0x01, 0x86, 0xf5, 0x3b, 0xd8, 0x5e,
-	0x91, 0xc8, 0xb4, 0xef, 0x36, 0x67, 0x19, 0xbc, 0x14, 0xa9, 0xc1, 0xab, 0x8e, 0x73, 0x96, 0xc1,
-	0xab, 0x1b, 0xf8, 0x9e, 0xab, 0xac, 0xf0, 0xf7, 0x00, 0xee, 0xaf, 0x95, 0xa4, 0x19, 0x1c, 0x57,
-	0x38, 0xf7, 0x83, 0xe3, 0x0a, 0xe7, 0xe4, 0x05, 0xdc, 0xbd, 0x36, 0xe7, 0x77, 0x33, 0xe3, 0xd1,
-	0xff, 0x54, 0x7c, 0x5c, 0x65, 0xf9, 0xb4, 0xf3, 0x38, 0x88, 0xfe, 0x0c, 0x80, 0x36, 0xf7, 0xae,
-	0x1d, 0x5d, 0xd5, 0x0b, 0xd2, 0x59, 0xbc, 0x20, 0xff, 0x4e, 0x87, 0xee, 0x66, 0xd3, 0x61, 0x04,
-	0x3d, 0xa5, 0xd9, 0x45, 0x8e, 0x7e, 0xcc, 0x54, 0x96, 0xd1, 0x65, 0xb5, 0x32, 0xef, 0x88, 0xd5,
-	0xa5, 0x33, 0x23, 0x84, 0xe3, 0xd5, 0x03, 0x3a, 0x31, 0xfb, 0xd1, 0xd7, 0x3c, 0xe6, 0x43, 0xd8,
-	0xe6, 0xae, 0x1f, 0x6e, 0x19, 0xaf, 0x3e, 0xee, 0xec, 0x8f, 0x2d, 0xd8, 0xf3, 0xf9, 0x5f, 0xf0,
-	0x22, 0xd3, 0x5c, 0x92, 0x9f, 0x60, 0x6f, 0xe5, 0x09, 0x26, 0xef, 0x2e, 0x71, 0xde, 0xfe, 0x90,
-	0x87, 0xd1, 0x4d, 0x21, 0x15, 0xb3, 0xd1, 0x1d, 0xf2, 0x05, 0xf4, 0x9e, 0x17, 0xd7, 0xfc, 0x0a,
-	0x09, 0x5d, 0x8a, 0xaf, 0x20, 0x9f, 0xe9, 0x7e, 0x8b, 0x67, 0x91, 0xe0, 0x6b, 0xd8, 0x3d, 0xd7,
-	0x12, 0xd9, 0xec, 0xb5, 0xd2, 0x3c, 0x08, 0xc8, 0xb7, 0xb0, 0xbb, 0xfc, 0x70, 0x91, 0xe3, 0x9a,
-	0xac, 0x1a, 0x7f, 0x11, 0xe1, 0x3b, 0x6b, 0xfd, 0x8b, 0xb3, 0xfd, 0x0c, 0xfb, 0xab, 0x35, 0x23,
-	0xd1, 0xed, 0x6a, 0x0d, 0xdf, 0xbb, 0x31, 0x66, 0x91, 0xfe, 0x97, 0xe6, 0x33, 0xe8, 0xe7, 0xdb,
-	0x07, 0x37, 0x64, 0xa8, 0xcb, 0x26, 0x1c, 0x35, 0x34, 0xf1, 0xcc, 0xfc, 0xd6, 0x45, 0x77, 0x2e,
-	0x7a, 0x16, 0xf9, 0xe8, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x00, 0xa9, 0x5c, 0x60, 0x13, 0x0a,
+	// 962 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xa4, 0x56, 0xdd, 0x6e, 0x23, 0x35,
+	0x14, 0x6e, 0x92, 0x6e, 0x9a, 0x9c, 0x76, 0xd3, 0xe2, 0x76, 0x13, 0xef, 0x80, 0x4a, 0x19, 0x10,
+	0x0a, 0x7b, 0x91, 0xee, 0x16, 0xa4, 0x2d, 0x88, 0x1f, 0x89, 0xed, 0x82, 0xf6, 0x62, 0xe9, 0x32,
+	0x45, 0x48, 0x20, 0x81, 0xe4, 0xce, 0x9c, 0x66, 0x87, 0x4e, 0xc6, 0x5e, 0xdb, 0x53, 0x29, 0x77,
+	0x70, 0xc9, 0x6b, 0xf1, 0x04, 0x3c, 0x0b, 0x4f, 0x80, 0x6c, 0xcf, 0x84, 0x4c, 0x66, 0xd2, 0xa6,
+	0xe5, 0xce, 0xe7, 0xd7, 0xf6, 0xf7, 0x9d, 0x73, 0x6c, 0xe8, 0x49, 0x54, 0x3c, 0x93, 0x21, 0x8e,
+	0x84, 0xe4, 0x9a, 0x93, 0xae, 0xc8, 0x92, 0x6c, 0x12, 0x4b, 0x11, 0x7a, 0x6f, 0x8f, 0x39, 0x1f,
+	0x27, 0x78, 0x68, 0x0d, 0xe7, 0xd9, 0xc5, 0x21, 0x4e, 0x84, 0x9e, 0x3a, 0x3f, 0xef, 0x9d, 0x45,
+	0xa3, 0xd2, 0x32, 0x0b, 0x75, 0x6e, 0xed, 0x09, 0xc9, 0xaf, 0xe2, 0x08, 0xa5, 0x93, 0xfd, 0x21,
+	0xf4, 0xcf, 0x32, 0x21, 0xb8, 0xd4, 0xea, 0x1b, 0x64, 0x3a, 0x93, 0x18, 0xe0, 0x9b, 0x0c, 0x95,
+	0x26, 0x3d, 0x68, 0xc6, 0x11, 0x6d, 0x1c, 0x34, 0x86, 0xdd, 0xa0, 0x19, 0x47, 0xfe, 0xa7, 0x30,
+	0xa8, 0x78, 0x2a, 0xc1, 0x53, 0x85, 0x64, 0x1f, 0xe0, 0x35, 0x53, 0xb9, 0xd5, 0x86, 0x74, 0x82,
+	0x39, 0x8d, 0xff, 0x4f, 0x13, 0x76, 0x03, 0x64, 0x51, 0x90, 0xdf, 0x68, 0xc9, 0x16, 0x84, 0xc0,
+	0xba, 0x9e, 0x0a, 0xa4, 0x4d, 0xab, 0xb1, 0x6b, 0xa3, 0x4b, 0xd9, 0x04, 0x69, 0xcb, 0xe9, 0xcc,
+	0x9a, 0xf4, 0xa1, 0x2d, 0x98, 0xc4, 0x54, 0xd3, 0x75, 0xab, 0xcd, 0x25, 0xf2, 0x14, 0x40, 0x48,
+	0x2e, 0x50, 0xea, 0x18, 0x15, 0xbd, 0x77, 0xd0, 0x18, 0x6e, 0x1e, 0x0d, 0x46, 0x0e, 0x8f, 0x51,
+	0x81, 0xc7, 0xe8, 0xcc, 0xe2, 0x11, 0xcc, 0xb9, 0x12, 0x1f, 0xb6, 0x22, 0x14, 0x98, 0x46, 0x98,
+	0x86, 0x26, 0xb4, 0x7d, 0xd0, 0x1a, 0x76, 0x83, 0x92, 0x8e, 0x78, 0xd0, 0x29, 0xb0, 0xa3, 0x1b,
+	0x76, 0xdb, 0x99, 0x4c, 0x28, 0x6c, 0x5c, 0xa1, 0x54, 0x31, 0x4f, 0x69, 0xc7, 0x9a, 0x0a, 0x91,
+	0x7c, 0x00, 0xf7, 0x59, 0x18, 0xa2, 0xd0, 0x67, 0x18, 0x4a, 0xd4, 0x8a, 0x76, 0x2d, 0x3a, 0x65,
+	0x25, 0x39, 0x86, 0x01, 0x8b, 0xa2, 0x58, 0xc7, 0x3c, 0x65, 0x89, 0x53, 0x9e, 0x66, 0x5a, 0x64,
+	0x5a, 0x51, 0xb0, 0x47, 0x59, 0x66, 0x36, 0x3b, 0xb3, 0x24, 0x66, 0x0a, 0x15, 0xdd, 0xb4, 0x9e,
+	0x85, 0xe8, 0x33, 0xd8, 0x2b, 0x63, 0x9e, 0x93, 0xb5, 0x03, 0xad, 0x4c, 0xa6, 0x39, 0xea, 0x66,
+	0xb9, 0x00, 0x5b, 0x73, 0x65, 0xd8, 0xfc, 0xbf, 0xbb, 0x30, 0x08, 0x70, 0x1c, 0x2b, 0x8d, 0x72,
+	0x91, 0xdb, 0x82, 0xcb, 0x46, 0x0d, 0x97, 0xcd, 0x5a, 0x2e, 0x5b, 0x25, 0x2e, 0xfb, 0xd0, 0x0e,
+	0x33, 0xa5, 0xf9, 0xc4, 0x72, 0xdc, 0x09, 0x72, 0x89, 0x1c, 0x42, 0x9b, 0x9f, 0xff, 0x86, 0xa1,
+	0xbe, 0x89, 0xdf, 0xdc, 0xcd, 0x20, 0x64, 0x4c, 0x26, 0xa2, 0x6d, 0x33, 0x15, 0x62, 0x85, 0xf5,
+	0x8d, 0x1b, 0x58, 0xef, 0x2c, 0xb0, 0x2e, 0x60, 0x2f, 0x07, 0x63, 0x7a, 0x32, 0x9f, 0xa7, 0x7b,
+	0xd0, 0x1a, 0x6e, 0x1e, 0x7d, 0x3e, 0x9a, 0x35, 0xec, 0x68, 0x09, 0x48, 0xa3, 0x57, 0x35, 0xe1,
+	0xcf, 0x53, 0x2d, 0xa7, 0x41, 0x6d, 0x66, 0xf2, 0x18, 0x76, 0x23, 0x4c, 0x50, 0xe3, 0xd7, 0x78,
+	0xc1, 0x25, 0x06, 0x28, 0x12, 0x16, 0x22, 0x05, 0x7b, 0xaf, 0x3a, 0xd3, 0x7c, 0x65, 0x6e, 0x56,
+	0x2a, 0x33, 0x1e, 0xa7, 0x5c, 0xe2, 0xb3, 0xd7, 0x2c, 0x1d, 0xa3, 0xa2, 0x5b, 0xf6, 0xfa, 0x65,
+	0x65, 0xb5, 0x7e, 0xef, 0xdf, 0xb2, 0x7e, 0x7b, 0x2b, 0xd7, 0xef, 0x76, 0xa9, 0x7e, 0x0d, 0xf2,
+	0xf1, 0x44, 0x70, 0xa9, 0x5f, 0x44, 0x74, 0xc7, 0x21, 0x5f, 0xc8, 0xe4, 0x27, 0xe8, 0xb9, 0x72,
+	0xf8, 0x21, 0x9e, 0x20, 0x37, 0xdb, 0xbc, 0x65, 0x8b, 0xe1, 0xc9, 0x0a, 0x98, 0x3f, 0x2b, 0x05,
+	0x06, 0x0b, 0x89, 0xc8, 0x97, 0xe0, 0xd5, 0xe0, 0x78, 0x82, 0x17, 0x71, 0x8a, 0x11, 0x25, 0xf6,
+	0xf6, 0xd7, 0x78, 0x90, 0x4f, 0xe0, 0x81, 0xca, 0xc7, 0xe4, 0x2b, 0x26, 0x75, 0xcc, 0x92, 0x1f,
+	0x59, 0x92, 0xa1, 0xa2, 0xbb, 0x36, 0xb4, 0xde, 0x68, 0xaa, 0x5d, 0xe2, 0x84, 0x6b, 0xa4, 0x7b,
+	0xae, 0xda, 0x9d, 0x44, 0x1e, 0xc1, 0x8e, 0x74, 0xf9, 0x4f, 0xd3, 0x82, 0xa7, 0x07, 0x16, 0xa7,
+	0x8a, 0x9e, 0x7c, 0x68, 0x9e, 0x0c, 0xcd, 0xe2, 0xf4, 0x34, 0x3d, 0xb1, 0xe7, 0xa3, 0x7d, 0x9b,
+	0x6b, 0x41, 0xeb, 0x3d, 0x82, 0xbd, 0xba, 0xba, 0x33, 0xdd, 0x99, 0xc9, 0x54, 0xd1, 0x86, 0xcd,
+	0x6f, 0xd7, 0x5e, 0x02, 0xbd, 0x32, 0x5e, 0xb6, 0x2f, 0x25, 0x32, 0x5d, 0x74, 0x76, 0x2e, 0x19,
+	0x7d, 0x26, 0x22, 0xa6, 0x8b, 0xee, 0xce, 0x25, 0xa3, 0x77, 0x68, 0x15, 0xfd, 0xed, 0x24, 0xb3,
+	0x9b, 0x44, 0x16, 0xe5, 0x13, 0xdc, 0xae, 0xbd, 0xdf, 0x1b, 0xf0, 0x70, 0x69, 0x4b, 0x98, 0xc1,
+	0x75, 0x89, 0xd3, 0x62, 0x70, 0x5d, 0xe2, 0x94, 0xbc, 0x84, 0x7b, 0x57, 0x06, 0xbf, 0x7c, 0x66,
+	0x3d, 0xbd, 0x63, 0xc7, 0x05, 0x2e, 0xcb, 0x67, 0xcd, 0xe3, 0x86, 0xff, 0x57, 0x0b, 0x68, 0x35,
+	0x76, 0xe9, 0xe8, 0x74, 0x2f, 0x58, 0x73, 0xf6, 0x82, 0xfd, 0x37, 0x9d, 0x5a, 0xab, 0x4d, 0xa7,
+	0x3e, 0xb4, 0x95, 0x66, 0xe7, 0x09, 0x16, 0x63, 0xce, 0x49, 0xa6, 0x2f, 0xdc, 0xca, 0xbc, 0x63,
+	0xb6, 0x2f, 0x72, 0x91, 0xbc, 0x59, 0x32, 0x75, 0xda, 0x76, 0xea, 0x7c, 0x71, 0x2d, 0x06, 0xee,
+	0x1e, 0xb7, 0x1d, 0x3b, 0xb7, 0xaa, 0x98, 0x3f, 0x6e, 0xc9, 0xe1, 0x77, 0x65, 0x0e, 0x8f, 0xef,
+	0x7a, 0xfe, 0x79, 0x12, 0x11, 0xf6, 0x17, 0x63, 0xf3, 0x79, 0x53, 0xbc, 0x4e, 0x55, 0x26, 0x9f,
+	0xc0, 0x06, 0xcf, 0x47, 0xd6, 0x0d, 0x2f, 0x60, 0xe1, 0x77, 0xf4, 0xe7, 0x3a, 0x6c, 0x17, 0xf9,
+	0x5f, 0xf2, 0x34, 0xd6, 0x5c, 0x92, 0x9f, 0x61, 0x7b, 0xe1, 0x97, 0x44, 0xde, 0x9b, 0xbb, 0x52,
+	0xfd, 0x5f, 0xcb, 0xf3, 0xaf, 0x73, 0x71, 0x97, 0xf6, 0xd7, 0xc8, 0x57, 0xd0, 0x7e, 0x91, 0x5e,
+	0xf1, 0x4b, 0x24, 0x74, 0xce, 0xdf, 0xa9, 0x8a, 0x4c, 0x0f, 0x6b, 0x2c, 0xb3, 0x04, 0xdf, 0xc2,
+	0xd6, 0x99, 0x96, 0xc8, 0x26, 0xff, 0x2b, 0xcd, 0xe3, 0x06, 0xf9, 0x1e, 0xb6, 0xe6, 0xff, 0x16,
+	0x64, 0xbf, 0xc4, 0x5a, 0xe5, 0xa3, 0xe7, 0xbd, 0xbb, 0xd4, 0x3e, 0x3b, 0xdb, 0x2f, 0xb0, 0xb3,
+	0xc8, 0x19, 0xf1, 0x6f, 0x6e, 0x68, 0xef, 0xfd, 0x15, 0x0a, 0xc6, 0x5f, 0x23, 0xbf, 0xc2, 0x60,
+	0x49, 0x49, 0x90, 0x8f, 0xae, 0xc9, 0x50, 0x2e, 0x1b, 0xaf, 0x5f, 0xa9, 0x89, 0xe7, 0xe6, 0xe7,
+	0xed, 0xaf, 0x9d, 0xb7, 0xad, 0xe6, 0xe3, 0x7f, 0x07, 0x00, 0xc4, 0x62, 0x9c, 0xe8, 0xb6, 0x0b,
 	0x00, 0x00,
 }
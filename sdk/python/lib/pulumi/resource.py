@@ -45,14 +45,21 @@ class CustomTimeouts:
     delete is the optional delete timout represented as a string e.g. 5m, 40s, 1d.
     """
 
+    read: str
+    """
+    read is the optional read timout represented as a string e.g. 5m, 40s, 1d.
+    """
+
     def __init__(self,
                  create: Optional[str] = None,
                  update: Optional[str] = None,
-                 delete: Optional[str] = None) -> None:
+                 delete: Optional[str] = None,
+                 read: Optional[str] = None) -> None:
 
         self.create = create
         self.update = update
         self.delete = delete
+        self.read = read
 
 
 def inherited_child_alias(
@@ -29,6 +29,11 @@ class AnalyzerStub(object):
         request_serializer=analyzer__pb2.AnalyzeStackRequest.SerializeToString,
         response_deserializer=analyzer__pb2.AnalyzeResponse.FromString,
         )
+    self.Remediate = channel.unary_unary(
+        '/pulumirpc.Analyzer/Remediate',
+        request_serializer=analyzer__pb2.AnalyzeRequest.SerializeToString,
+        response_deserializer=analyzer__pb2.RemediateResponse.FromString,
+        )
     self.GetAnalyzerInfo = channel.unary_unary(
         '/pulumirpc.Analyzer/GetAnalyzerInfo',
         request_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
@@ -65,6 +70,15 @@ class AnalyzerServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def Remediate(self, request, context):
+    """Remediate is given the opportunity to transform a single resource's inputs before they are
+    checked by its provider. It returns the remediations that were applied, if any, each of which
+    carries the full set of transformed properties.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
   def GetAnalyzerInfo(self, request, context):
     """GetAnalyzerInfo returns metadata about the analyzer (e.g., list of policies contained).
     """
@@ -92,6 +106,11 @@ def add_AnalyzerServicer_to_server(servicer, server):
           request_deserializer=analyzer__pb2.AnalyzeStackRequest.FromString,
           response_serializer=analyzer__pb2.AnalyzeResponse.SerializeToString,
       ),
+      'Remediate': grpc.unary_unary_rpc_method_handler(
+          servicer.Remediate,
+          request_deserializer=analyzer__pb2.AnalyzeRequest.FromString,
+          response_serializer=analyzer__pb2.RemediateResponse.SerializeToString,
+      ),
       'GetAnalyzerInfo': grpc.unary_unary_rpc_method_handler(
           servicer.GetAnalyzerInfo,
           request_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
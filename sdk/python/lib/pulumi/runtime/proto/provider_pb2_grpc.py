@@ -17,6 +17,11 @@ class ResourceProviderStub(object):
     Args:
       channel: A grpc.Channel.
     """
+    self.GetSchema = channel.unary_unary(
+        '/pulumirpc.ResourceProvider/GetSchema',
+        request_serializer=provider__pb2.GetSchemaRequest.SerializeToString,
+        response_deserializer=provider__pb2.GetSchemaResponse.FromString,
+        )
     self.CheckConfig = channel.unary_unary(
         '/pulumirpc.ResourceProvider/CheckConfig',
         request_serializer=provider__pb2.CheckRequest.SerializeToString,
@@ -72,6 +77,11 @@ class ResourceProviderStub(object):
         request_serializer=provider__pb2.DeleteRequest.SerializeToString,
         response_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
         )
+    self.Construct = channel.unary_unary(
+        '/pulumirpc.ResourceProvider/Construct',
+        request_serializer=provider__pb2.ConstructRequest.SerializeToString,
+        response_deserializer=provider__pb2.ConstructResponse.FromString,
+        )
     self.Cancel = channel.unary_unary(
         '/pulumirpc.ResourceProvider/Cancel',
         request_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
@@ -89,6 +99,13 @@ class ResourceProviderServicer(object):
   within a single package.  It is driven by the overall planning engine in response to resource diffs.
   """
 
+  def GetSchema(self, request, context):
+    """GetSchema fetches the schema for this resource provider.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
   def CheckConfig(self, request, context):
     """CheckConfig validates the configuration for this resource provider.
     """
@@ -173,6 +190,13 @@ class ResourceProviderServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def Construct(self, request, context):
+    """Construct creates a new instance of the provided component resource and returns its state.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
   def Cancel(self, request, context):
     """Cancel signals the provider to abort all outstanding resource operations.
     """
@@ -190,6 +214,11 @@ class ResourceProviderServicer(object):
 
 def add_ResourceProviderServicer_to_server(servicer, server):
   rpc_method_handlers = {
+      'GetSchema': grpc.unary_unary_rpc_method_handler(
+          servicer.GetSchema,
+          request_deserializer=provider__pb2.GetSchemaRequest.FromString,
+          response_serializer=provider__pb2.GetSchemaResponse.SerializeToString,
+      ),
       'CheckConfig': grpc.unary_unary_rpc_method_handler(
           servicer.CheckConfig,
           request_deserializer=provider__pb2.CheckRequest.FromString,
@@ -245,6 +274,11 @@ def add_ResourceProviderServicer_to_server(servicer, server):
           request_deserializer=provider__pb2.DeleteRequest.FromString,
           response_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
       ),
+      'Construct': grpc.unary_unary_rpc_method_handler(
+          servicer.Construct,
+          request_deserializer=provider__pb2.ConstructRequest.FromString,
+          response_serializer=provider__pb2.ConstructResponse.SerializeToString,
+      ),
       'Cancel': grpc.unary_unary_rpc_method_handler(
           servicer.Cancel,
           request_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,